@@ -5,16 +5,37 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	addressRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/address/repository/postgres"
+	addressUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/address/usecase"
 	grpcServer "github.com/diki-haryadi/ecommerce-saga/internal/features/auth/delivery/grpc"
 	authRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/auth/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/auth/usecase"
+	cartRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/repository/postgres"
+	orderGrpc "github.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc"
+	orderPb "github.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc/proto"
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
+	orderUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/order/usecase"
+	promotionRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	promotionUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
+	saga "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	taxEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/entity"
+	taxRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/repository/postgres"
+	taxUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/infrastructure/cache/redis"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/jwt"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/internal/shared/config"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/idempotency"
 )
 
 func main() {
@@ -39,8 +60,32 @@ func main() {
 	// Initialize usecases
 	authUsecase := usecase.NewAuthUsecase(userRepo, jwtService)
 
+	// Order creation replays its first response to calls retried with the same
+	// idempotency key. The interceptor runs after authentication, so keys are
+	// scoped to the calling user. This server serves no payments; they are
+	// only made idempotent over HTTP.
+	idempotencyTTL := cfg.IdempotencyTTL
+	if idempotencyTTL <= 0 {
+		idempotencyTTL = 24 * time.Hour
+	}
+	idempotent := idempotency.NewInterceptor(
+		idempotencyStore(cfg, db),
+		idempotencyTTL,
+		orderPb.OrderService_CreateOrder_FullMethodName,
+	)
+
+	// Only admins search the orders of all users
+	admin := grpcServer.NewAdminInterceptor(userRepo, orderPb.OrderService_SearchOrders_FullMethodName)
+
 	// Create gRPC server
-	server := grpcServer.NewServer(authUsecase)
+	server := grpcServer.NewServer(authUsecase, admin.Unary(), idempotent.Unary())
+
+	// Register the order service
+	orders, err := newOrderUsecase(cfg, db)
+	if err != nil {
+		log.Fatalf("Failed to initialize orders: %v", err)
+	}
+	orderPb.RegisterOrderServiceServer(server, orderGrpc.NewOrderServer(orders))
 
 	// Handle graceful shutdown
 	sigChan := make(chan os.Signal, 1)
@@ -58,3 +103,56 @@ func main() {
 		log.Fatalf("Failed to start gRPC server: %v", err)
 	}
 }
+
+// newOrderUsecase builds the order usecase the way the API server does
+func newOrderUsecase(cfg *config.Config, db *gorm.DB) (*orderUsecase.OrderUsecase, error) {
+	var rates fx.Provider = fx.NewDBProvider(db)
+	if cfg.FXRatesFile != "" {
+		fileRates, err := fx.NewFileProvider(cfg.FXRatesFile)
+		if err != nil {
+			return nil, err
+		}
+		rates = fileRates
+	}
+
+	currency, err := money.ParseCurrency(cfg.BaseCurrency)
+	if err != nil {
+		currency = money.USD
+	}
+	minOrderValue := money.FromMajor(cfg.MinOrderValue, currency, money.RoundHalfUp)
+
+	rounding := taxEntity.RoundingPerLine
+	if taxEntity.Rounding(strings.ToUpper(cfg.TaxRounding)) == taxEntity.RoundingPerOrder {
+		rounding = taxEntity.RoundingPerOrder
+	}
+
+	pricer := promotionUsecase.NewPromotionUsecase(promotionRepo.NewPromotionRepository(db), minOrderValue, rates)
+	taxes := taxUsecase.NewRuleCalculator(taxRepo.NewRuleRepository(db), cfg.TaxPricesIncludeTax, rounding)
+	addresses := addressUsecase.NewAddressUsecase(addressRepo.NewAddressRepository(db))
+	sagas := saga.NewSagaOrchestrator(
+		sagaRepo.NewSagaRepository(db),
+		definition.NewRegistry(),
+		5*time.Minute, // Step timeout
+		3,             // Max retries
+	)
+
+	return orderUsecase.NewOrderUsecase(
+		orderRepo.NewOrderRepository(db),
+		cartRepo.NewCartRepository(db),
+		pricer,
+		rates,
+		taxes,
+		addresses,
+		sagas,
+	), nil
+}
+
+// idempotencyStore returns the configured idempotency key store, the
+// idempotency_keys table unless redis is configured
+func idempotencyStore(cfg *config.Config, db *gorm.DB) idempotency.Store {
+	if strings.EqualFold(cfg.IdempotencyStore, "redis") {
+		cache := redis.NewRedisCache(fmt.Sprintf("%s:%s", cfg.Redis.Host, cfg.Redis.Port), cfg.Redis.Password, cfg.Redis.DB)
+		return idempotency.NewRedisStore(cache)
+	}
+	return idempotency.NewPostgresStore(db)
+}
@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
 	saga "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
 	"github.com/diki-haryadi/ecommerce-saga/internal/shared/messaging"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
 )
 
 func main() {
@@ -30,29 +36,38 @@ func main() {
 		log.Fatalf("Failed to initialize database: %v", err)
 	}
 
-	sagaRepo := repository.NewPostgresSagaRepository(db)
+	sagaRepository := sagaRepo.NewSagaRepository(db)
+	outboxRepo := outbox.NewRepository(db)
 
-	// Run migrations
-	if err := runMigrations(sagaRepo); err != nil {
+	// Run migrations, including the inbox table used by the workers
+	if err := runMigrations(db, sagaRepository.Migrations(), outboxRepo.Migrations(), inbox.NewRepository(db).Migrations()); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
 	// Create saga orchestrator
 	orchestrator := saga.NewSagaOrchestrator(
-		sagaRepo,
-		messageBroker,
+		sagaRepository,
+		definition.NewRegistry(),
 		5*time.Minute, // Step timeout
 		3,             // Max retries
 	)
 
-	// Create context that listens for termination signals
-	ctx, cancel := context.WithCancel(context.Background())
+	// Create context that listens for termination signals. Saga changes made
+	// by this process are recorded in the event log as made by the orchestrator.
+	ctx, cancel := context.WithCancel(repository.WithActor(context.Background(), repository.ActorOrchestrator))
 	defer cancel()
 
 	// Set up signal handling
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
+	// Publish step commands written to the outbox
+	relay := outbox.NewRelay(outboxRepo, messageBroker, 500*time.Millisecond, 100)
+	go relay.Run(ctx)
+
+	// Expose metrics such as the step timeouts found by the sweeper
+	go serveMetrics(getEnvOrDefault("METRICS_ADDR", ":9091"))
+
 	// Start processing sagas
 	go func() {
 		for {
@@ -116,22 +131,32 @@ func initDatabase() (*gorm.DB, error) {
 	return db, nil
 }
 
-func runMigrations(repo *repository.PostgresSagaRepository) error {
-	db, err := repo.DB().DB()
+func runMigrations(gormDB *gorm.DB, migrations ...[]string) error {
+	db, err := gormDB.DB()
 	if err != nil {
 		return fmt.Errorf("failed to get database instance: %w", err)
 	}
 
 	// Run migrations
-	for _, migration := range repo.Migrations() {
-		if _, err := db.Exec(migration); err != nil {
-			return fmt.Errorf("failed to run migration: %w", err)
+	for _, set := range migrations {
+		for _, migration := range set {
+			if _, err := db.Exec(migration); err != nil {
+				return fmt.Errorf("failed to run migration: %w", err)
+			}
 		}
 	}
 
 	return nil
 }
 
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Printf("Metrics server stopped: %v", err)
+	}
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
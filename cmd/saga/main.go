@@ -1,23 +1,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	cartClient "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/delivery/grpc/client"
+	inventoryPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/repository/postgres"
+	inventoryUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/usecase"
 	orderClient "github.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc/client"
 	orderPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
 	paymentClient "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/delivery/grpc/client"
 	paymentPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/repository/postgres"
+	promotionPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	promotionUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
 	grpcServer "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/grpc"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
 	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/internal/shared/config"
 )
 
@@ -54,16 +62,43 @@ func main() {
 	sagaRepository := sagaRepo.NewSagaRepository(db)
 	orderRepository := orderPostgres.NewOrderRepository(db)
 	paymentRepository := paymentPostgres.NewPaymentRepository(db)
+	inventory := inventoryUsecase.NewInventoryUsecase(inventoryPostgres.NewInventoryRepository(db))
+	// The saga only reserves coupon uses of priced orders, so no minimum order value applies
+	promotions := promotionUsecase.NewPromotionUsecase(promotionPostgres.NewPromotionRepository(db), money.Money{}, nil)
 
 	// Initialize usecase with all dependencies
 	sagaUsecase := usecase.NewSagaUsecase(
 		sagaRepository,
 		orderRepository,
 		paymentRepository,
+		inventory,
+		promotions,
 		orderGrpcClient,
 		paymentGrpcClient,
 		cartGrpcClient,
 	)
+	if cfg.Saga.Retry.MaxAttempts > 0 {
+		sagaUsecase.Registry().SetDefaultRetryPolicy(definition.RetryPolicy{
+			MaxAttempts:     cfg.Saga.Retry.MaxAttempts,
+			InitialInterval: cfg.Saga.Retry.InitialInterval,
+			MaxInterval:     cfg.Saga.Retry.MaxInterval,
+		})
+	}
+
+	if cfg.Saga.Recovery.LeaseTTL > 0 {
+		sagaUsecase.SetLeaseTTL(cfg.Saga.Recovery.LeaseTTL)
+	}
+
+	// Resume sagas interrupted by a previous shutdown or crash, then keep
+	// picking up sagas abandoned by other instances
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recoveryInterval := cfg.Saga.Recovery.Interval
+	if recoveryInterval <= 0 {
+		recoveryInterval = 30 * time.Second
+	}
+	go usecase.NewSagaRecovery(sagaUsecase, recoveryInterval).Run(ctx)
 
 	// Create gRPC server
 	grpcServer := grpcServer.NewServer(sagaUsecase)
@@ -75,6 +110,7 @@ func main() {
 	go func() {
 		<-sigChan
 		fmt.Println("\nShutting down Saga gRPC server...")
+		cancel()
 		grpcServer.Stop()
 
 		// Close gRPC client connections
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -14,21 +15,41 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	inventoryPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/repository/postgres"
+	inventoryUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/usecase"
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	orderPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/statemachine"
+	orderUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/order/usecase"
+	paymentEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/entity"
+	promotionPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	promotionUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
+	returnEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/entity"
+	returnRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/repository"
+	returnPostgres "github.com/diki-haryadi/ecommerce-saga/internal/features/returns/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
 	saga "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+	paymentProvider "github.com/diki-haryadi/ecommerce-saga/internal/pkg/payment/provider"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
 	"github.com/diki-haryadi/ecommerce-saga/internal/shared/messaging"
 )
 
-type StepMessage struct {
-	SagaID  uuid.UUID       `json:"saga_id"`
-	OrderID uuid.UUID       `json:"order_id"`
-	Step    entity.SagaStep `json:"step"`
-}
-
 type Worker struct {
 	messageBroker    messaging.MessageBroker
 	sagaOrchestrator *saga.SagaOrchestrator
+	sagaRepo         repository.SagaRepository
+	registry         *definition.Registry
+	inbox            *inbox.Repository
+	orderRepo        orderRepo.OrderRepository
+	orders           *statemachine.OrderStateMachine
+	inventory        *inventoryUsecase.InventoryUsecase
+	promotions       *promotionUsecase.PromotionUsecase
+	returns          returnRepo.ReturnRepository
+	payments         paymentProvider.PaymentProvider
 	db               *gorm.DB
 }
 
@@ -48,23 +69,40 @@ func main() {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
-	// Subscribe to saga step topics
-	topics := []string{
-		"saga.CREATE_ORDER",
-		"saga.PROCESS_PAYMENT",
-		"saga.UPDATE_INVENTORY",
-		"saga.compensation.CREATE_ORDER",
-		"saga.compensation.PROCESS_PAYMENT",
-		"saga.compensation.UPDATE_INVENTORY",
-	}
+	// Subscribe to the step and compensation topics of every registered saga
+	for _, step := range worker.registry.Steps() {
+		topic := definition.StepTopic(step.Name)
+		if err := worker.messageBroker.Subscribe(topic, worker.createStepHandler(topic, step.Action, false)); err != nil {
+			log.Fatalf("Failed to subscribe to topic %s: %v", topic, err)
+		}
 
-	// Start workers for each topic
-	for _, topic := range topics {
-		if err := worker.messageBroker.Subscribe(topic, worker.createStepHandler(topic)); err != nil {
+		if !step.HasCompensation() {
+			continue
+		}
+		topic = definition.CompensationTopic(step.Name)
+		if err := worker.messageBroker.Subscribe(topic, worker.createStepHandler(topic, step.Compensation, true)); err != nil {
 			log.Fatalf("Failed to subscribe to topic %s: %v", topic, err)
 		}
 	}
 
+	// Give the stock of expired cart holds back
+	holdSweepInterval, err := time.ParseDuration(getEnvOrDefault("HOLD_SWEEP_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("Invalid HOLD_SWEEP_INTERVAL: %v", err)
+	}
+	go inventoryUsecase.NewHoldSweeper(worker.inventory, holdSweepInterval).Run(ctx)
+
+	// Cancel orders that were not paid within the payment window
+	orderExpiryWindow, err := time.ParseDuration(getEnvOrDefault("ORDER_EXPIRY_WINDOW", "30m"))
+	if err != nil {
+		log.Fatalf("Invalid ORDER_EXPIRY_WINDOW: %v", err)
+	}
+	orderExpiryInterval, err := time.ParseDuration(getEnvOrDefault("ORDER_EXPIRY_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("Invalid ORDER_EXPIRY_INTERVAL: %v", err)
+	}
+	go orderUsecase.NewOrderExpirer(worker.db, worker.orderRepo, worker.sagaOrchestrator, worker.inventory, orderExpiryWindow, orderExpiryInterval).Run(ctx)
+
 	// Wait for termination signal
 	<-sigChan
 	log.Println("Shutting down worker...")
@@ -81,44 +119,65 @@ func main() {
 	}
 }
 
-func (w *Worker) createStepHandler(topic string) messaging.MessageHandler {
+// createStepHandler wraps a step handler as a message handler. The handler
+// runs through the inbox at most once per saga and command topic, and its
+// outcome is reported to the orchestrator, which moves the saga forward,
+// retries or compensates. Only step failures that no retry can resolve are
+// recorded; a compensation that failed is always run again, as the
+// orchestrator retries it.
+func (w *Worker) createStepHandler(topic string, handler definition.StepHandler, compensation bool) messaging.MessageHandler {
+	final := func(err error) bool {
+		return !definition.IsRetryable(err)
+	}
+	if compensation {
+		final = nil
+	}
+
 	return func(ctx context.Context, message []byte) error {
 		// Parse message
-		var stepMsg StepMessage
-		if err := json.Unmarshal(message, &stepMsg); err != nil {
+		var cmd definition.StepCommand
+		if err := json.Unmarshal(message, &cmd); err != nil {
 			return fmt.Errorf("failed to unmarshal message: %w", err)
 		}
 
 		// Log step processing
-		log.Printf("Processing step %s for saga %s", stepMsg.Step.Name, stepMsg.SagaID)
-
-		// Process step based on topic
-		switch topic {
-		case "saga.CREATE_ORDER":
-			return w.handleCreateOrder(ctx, stepMsg)
-		case "saga.PROCESS_PAYMENT":
-			return w.handleProcessPayment(ctx, stepMsg)
-		case "saga.UPDATE_INVENTORY":
-			return w.handleUpdateInventory(ctx, stepMsg)
-		case "saga.compensation.CREATE_ORDER":
-			return w.handleCreateOrderCompensation(ctx, stepMsg)
-		case "saga.compensation.PROCESS_PAYMENT":
-			return w.handleProcessPaymentCompensation(ctx, stepMsg)
-		case "saga.compensation.UPDATE_INVENTORY":
-			return w.handleUpdateInventoryCompensation(ctx, stepMsg)
-		default:
-			return fmt.Errorf("unknown topic: %s", topic)
+		log.Printf("Processing step %s for saga %s", cmd.Step.Name, cmd.SagaID)
+
+		key := inbox.Key{MessageID: cmd.MessageID, SagaID: cmd.SagaID, Step: topic}
+		duplicate, err := w.inbox.Handle(ctx, key, func(ctx context.Context) error {
+			return handler(ctx, &cmd)
+		}, final)
+		if duplicate {
+			log.Printf("Step %s for saga %s already handled, reporting recorded outcome", cmd.Step.Name, cmd.SagaID)
+		}
+		if errors.Is(err, inbox.ErrHandlerFailed) {
+			// The recorded failure was final
+			err = definition.Permanent(err)
 		}
+
+		status := entity.StepStatusSuccess
+		switch {
+		case compensation && err != nil:
+			log.Printf("Compensation of step %s for saga %s failed: %v", cmd.Step.Name, cmd.SagaID, err)
+			status = entity.StepStatusCompensationFailed
+		case compensation:
+			status = entity.StepStatusCompensated
+		case err != nil:
+			log.Printf("Step %s for saga %s failed: %v", cmd.Step.Name, cmd.SagaID, err)
+			status = entity.StepStatusFailed
+		}
+
+		ctx = repository.WithActor(ctx, repository.ActorWorker)
+		if err := w.sagaOrchestrator.ProcessStepResult(ctx, cmd.SagaID, cmd.Step, status, err); err != nil {
+			return fmt.Errorf("failed to update saga step: %w", err)
+		}
+		return nil
 	}
 }
 
-func (w *Worker) handleCreateOrder(ctx context.Context, msg StepMessage) error {
-	// Start a transaction
-	tx := w.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
-	}
-	defer tx.Rollback()
+func (w *Worker) handleCreateOrder(ctx context.Context, msg *definition.StepCommand) error {
+	// Side effects are committed together with the inbox record
+	tx := inbox.Tx(ctx, w.db)
 
 	// Create order logic
 	order := struct {
@@ -135,147 +194,274 @@ func (w *Worker) handleCreateOrder(ctx context.Context, msg StepMessage) error {
 		return fmt.Errorf("failed to create order: %w", err)
 	}
 
-	// Update saga step status
-	if err := w.sagaOrchestrator.ProcessStepResult(ctx, msg.SagaID, msg.Step, entity.StepStatusSuccess, nil); err != nil {
-		return fmt.Errorf("failed to update saga step: %w", err)
+	pricedOrder, err := w.orderRepo.GetByID(ctx, msg.OrderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// The coupon uses join the inbox transaction carried by ctx
+	discounts := saga.DiscountLines(pricedOrder.Discounts)
+	if err := w.promotions.ReserveRedemptions(ctx, pricedOrder.ID, pricedOrder.UserID, discounts); err != nil {
+		return saga.PromotionError(fmt.Errorf("failed to reserve coupon uses: %w", err))
+	}
+
+	// The order moves to CONFIRMED in the inbox transaction as well
+	if err := saga.ConfirmOrder(ctx, w.orders, pricedOrder); err != nil {
+		return fmt.Errorf("failed to confirm order: %w", err)
 	}
 
-	return tx.Commit().Error
+	return nil
 }
 
-func (w *Worker) handleProcessPayment(ctx context.Context, msg StepMessage) error {
-	// Start a transaction
-	tx := w.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
+func (w *Worker) handleProcessPayment(ctx context.Context, msg *definition.StepCommand) error {
+	// Side effects are committed together with the inbox record
+	tx := inbox.Tx(ctx, w.db)
+
+	// The order is charged its total in the currency it was placed in
+	order, err := w.orderRepo.GetByID(ctx, msg.OrderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
 	}
-	defer tx.Rollback()
 
 	// Process payment logic
 	payment := struct {
-		ID      uuid.UUID `gorm:"type:uuid;primary_key"`
-		OrderID uuid.UUID `gorm:"type:uuid"`
-		Status  string    `gorm:"type:varchar(50)"`
-		Amount  float64   `gorm:"type:decimal(10,2)"`
+		ID      uuid.UUID   `gorm:"type:uuid;primary_key"`
+		OrderID uuid.UUID   `gorm:"type:uuid"`
+		Status  string      `gorm:"type:varchar(50)"`
+		Amount  money.Money `gorm:"embedded"`
 	}{
 		ID:      uuid.New(),
 		OrderID: msg.OrderID,
 		Status:  "COMPLETED",
-		Amount:  100.00, // Example amount
+		Amount:  order.TotalAmount,
 	}
 
 	if err := tx.Create(&payment).Error; err != nil {
 		return fmt.Errorf("failed to process payment: %w", err)
 	}
 
-	// Update saga step status
-	if err := w.sagaOrchestrator.ProcessStepResult(ctx, msg.SagaID, msg.Step, entity.StepStatusSuccess, nil); err != nil {
-		return fmt.Errorf("failed to update saga step: %w", err)
+	return nil
+}
+
+func (w *Worker) handleUpdateInventory(ctx context.Context, msg *definition.StepCommand) error {
+	order, err := w.orderRepo.GetByID(ctx, msg.OrderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// The reservation joins the inbox transaction carried by ctx
+	if _, err := w.inventory.ReserveStock(ctx, order.ID, order.UserID, saga.ReservationItems(order.Items)); err != nil {
+		return saga.InventoryError(fmt.Errorf("failed to reserve inventory: %w", err))
 	}
 
-	return tx.Commit().Error
+	return nil
 }
 
-func (w *Worker) handleUpdateInventory(ctx context.Context, msg StepMessage) error {
-	// Start a transaction
-	tx := w.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
+func (w *Worker) handleCreateOrderCompensation(ctx context.Context, msg *definition.StepCommand) error {
+	// Side effects are committed together with the inbox record
+	if err := w.promotions.ReleaseRedemptions(ctx, msg.OrderID); err != nil {
+		return fmt.Errorf("failed to release coupon uses: %w", err)
 	}
-	defer tx.Rollback()
 
-	// Update inventory logic
-	inventory := struct {
-		ID       uuid.UUID `gorm:"type:uuid;primary_key"`
-		OrderID  uuid.UUID `gorm:"type:uuid"`
-		Status   string    `gorm:"type:varchar(50)"`
-		Quantity int       `gorm:"type:integer"`
-	}{
-		ID:       uuid.New(),
-		OrderID:  msg.OrderID,
-		Status:   "RESERVED",
-		Quantity: 1, // Example quantity
+	sagaEntity, err := w.sagaRepo.GetByID(ctx, msg.SagaID)
+	if err != nil {
+		return fmt.Errorf("failed to get saga: %w", err)
 	}
+	if err := saga.CancelFailedOrder(ctx, w.orders, msg.OrderID, sagaEntity); err != nil {
+		return fmt.Errorf("failed to cancel order: %w", err)
+	}
+
+	return nil
+}
 
-	if err := tx.Create(&inventory).Error; err != nil {
-		return fmt.Errorf("failed to update inventory: %w", err)
+func (w *Worker) handleProcessPaymentCompensation(ctx context.Context, msg *definition.StepCommand) error {
+	// Side effects are committed together with the inbox record, so the refund
+	// is recorded only if the provider accepts it
+	tx := inbox.Tx(ctx, w.db)
+
+	var payment paymentEntity.Payment
+	err := tx.Where("order_id = ? AND status = ?", msg.OrderID, paymentEntity.PaymentStatusSuccess).
+		Order("created_at DESC").
+		First(&payment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Nothing was charged, or the payment is already refunded
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get payment: %w", err)
 	}
 
-	// Update saga step status
-	if err := w.sagaOrchestrator.ProcessStepResult(ctx, msg.SagaID, msg.Step, entity.StepStatusSuccess, nil); err != nil {
-		return fmt.Errorf("failed to update saga step: %w", err)
+	payment.UpdateStatus(paymentEntity.PaymentStatusRefunded)
+	if err := tx.Save(&payment).Error; err != nil {
+		return fmt.Errorf("failed to record refund: %w", err)
+	}
+	if payment.ProviderTransactionID == "" {
+		return nil
+	}
+	if err := w.payments.RefundPayment(ctx, payment.ProviderTransactionID, payment.Amount); err != nil {
+		return fmt.Errorf("failed to refund payment: %w", err)
 	}
 
-	return tx.Commit().Error
+	return nil
 }
 
-func (w *Worker) handleCreateOrderCompensation(ctx context.Context, msg StepMessage) error {
-	// Start a transaction
-	tx := w.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
+func (w *Worker) handleUpdateInventoryCompensation(ctx context.Context, msg *definition.StepCommand) error {
+	// The release joins the inbox transaction carried by ctx. Stock already
+	// sold to an order cancelled before shipping is given back too.
+	if err := w.inventory.CancelStock(ctx, msg.OrderID); err != nil {
+		return fmt.Errorf("failed to release inventory: %w", err)
 	}
-	defer tx.Rollback()
 
-	// Cancel order logic
-	if err := tx.Model(struct{ OrderID uuid.UUID }{}).
-		Where("order_id = ?", msg.OrderID).
-		Update("status", "CANCELLED").Error; err != nil {
-		return fmt.Errorf("failed to cancel order: %w", err)
+	return nil
+}
+
+// handleOrderPaymentCompleted commits the stock and the coupon uses reserved for the order of a completed saga
+// and moves the order on to PROCESSING
+func (w *Worker) handleOrderPaymentCompleted(ctx context.Context, sagaEntity *entity.Saga) error {
+	if err := w.inventory.CommitStock(ctx, sagaEntity.OrderID); err != nil {
+		return fmt.Errorf("failed to commit inventory: %w", err)
+	}
+	if err := w.promotions.CommitRedemptions(ctx, sagaEntity.OrderID); err != nil {
+		return fmt.Errorf("failed to commit coupon uses: %w", err)
 	}
+	if err := saga.ProcessOrder(ctx, w.orders, sagaEntity.OrderID); err != nil {
+		return fmt.Errorf("failed to process order: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Worker) handleAcceptReturn(ctx context.Context, msg *definition.StepCommand) error {
+	ret, err := w.getReturn(ctx, msg.Step)
+	if err != nil {
+		return err
+	}
+
+	// Only a return an admin approved is restocked and refunded
+	if ret.Status != returnEntity.ReturnStatusApproved {
+		return definition.Permanent(fmt.Errorf("return %s is %s, not approved", ret.ID, ret.Status))
+	}
+
+	return nil
+}
 
-	// Update saga step status
-	if err := w.sagaOrchestrator.ProcessStepResult(ctx, msg.SagaID, msg.Step, entity.StepStatusCompensated, nil); err != nil {
-		return fmt.Errorf("failed to update saga step: %w", err)
+func (w *Worker) handleRestockReturn(ctx context.Context, msg *definition.StepCommand) error {
+	ret, err := w.getReturn(ctx, msg.Step)
+	if err != nil {
+		return err
 	}
 
-	return tx.Commit().Error
+	// The restock joins the inbox transaction carried by ctx
+	if err := w.inventory.RestockItems(ctx, saga.ReturnItems(ret.Items)); err != nil {
+		return saga.InventoryError(fmt.Errorf("failed to restock returned items: %w", err))
+	}
+
+	return nil
 }
 
-func (w *Worker) handleProcessPaymentCompensation(ctx context.Context, msg StepMessage) error {
-	// Start a transaction
-	tx := w.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
+func (w *Worker) handleRefundReturn(ctx context.Context, msg *definition.StepCommand) error {
+	ret, err := w.getReturn(ctx, msg.Step)
+	if err != nil {
+		return err
 	}
-	defer tx.Rollback()
 
-	// Refund payment logic
-	if err := tx.Model(struct{ OrderID uuid.UUID }{}).
-		Where("order_id = ?", msg.OrderID).
-		Update("status", "REFUNDED").Error; err != nil {
+	// The refund is recorded in the inbox transaction, which is rolled back
+	// if the provider turns it down
+	refunded, err := w.returns.MarkRefunded(ctx, ret.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record refund: %w", err)
+	}
+	if !refunded || !ret.RefundAmount.IsPositive() {
+		return nil
+	}
+
+	var payment paymentEntity.Payment
+	err = inbox.Tx(ctx, w.db).
+		Where("order_id = ? AND status = ?", ret.OrderID, paymentEntity.PaymentStatusSuccess).
+		Order("created_at DESC").
+		First(&payment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return definition.Permanent(fmt.Errorf("no successful payment for order %s", ret.OrderID))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	if err := w.payments.RefundPayment(ctx, payment.ProviderTransactionID, ret.RefundAmount); err != nil {
 		return fmt.Errorf("failed to refund payment: %w", err)
 	}
 
-	// Update saga step status
-	if err := w.sagaOrchestrator.ProcessStepResult(ctx, msg.SagaID, msg.Step, entity.StepStatusCompensated, nil); err != nil {
-		return fmt.Errorf("failed to update saga step: %w", err)
+	return nil
+}
+
+func (w *Worker) handleAcceptReturnCompensation(ctx context.Context, msg *definition.StepCommand) error {
+	ret, err := w.getReturn(ctx, msg.Step)
+	if err != nil {
+		return err
 	}
 
-	return tx.Commit().Error
+	sagaEntity, err := w.sagaRepo.GetByID(ctx, msg.SagaID)
+	if err != nil {
+		return fmt.Errorf("failed to get saga: %w", err)
+	}
+
+	ret.Status = returnEntity.ReturnStatusFailed
+	ret.FailureReason = sagaEntity.Error
+	ret.UpdatedAt = time.Now()
+	err = w.returns.UpdateStatus(ctx, ret, returnEntity.ReturnStatusApproved)
+	if err != nil && !errors.Is(err, returnRepo.ErrStatusChanged) {
+		return fmt.Errorf("failed to fail return: %w", err)
+	}
+
+	return nil
 }
 
-func (w *Worker) handleUpdateInventoryCompensation(ctx context.Context, msg StepMessage) error {
-	// Start a transaction
-	tx := w.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
+func (w *Worker) handleRestockReturnCompensation(ctx context.Context, msg *definition.StepCommand) error {
+	ret, err := w.getReturn(ctx, msg.Step)
+	if err != nil {
+		return err
 	}
-	defer tx.Rollback()
 
-	// Release inventory logic
-	if err := tx.Model(struct{ OrderID uuid.UUID }{}).
-		Where("order_id = ?", msg.OrderID).
-		Update("status", "RELEASED").Error; err != nil {
-		return fmt.Errorf("failed to release inventory: %w", err)
+	// The unstock joins the inbox transaction carried by ctx
+	if err := w.inventory.UnstockItems(ctx, saga.ReturnItems(ret.Items)); err != nil {
+		return fmt.Errorf("failed to unstock returned items: %w", err)
+	}
+
+	return nil
+}
+
+// handleOrderReturnCompleted records the items of the return of a completed saga as returned on the order
+func (w *Worker) handleOrderReturnCompleted(ctx context.Context, sagaEntity *entity.Saga) error {
+	if len(sagaEntity.Steps) == 0 {
+		return fmt.Errorf("saga %s has no steps", sagaEntity.ID)
+	}
+	payload, err := definition.ParseReturnPayload(sagaEntity.Steps[0])
+	if err != nil {
+		return err
+	}
+
+	if err := w.returns.Complete(ctx, payload.ReturnID); err != nil {
+		return fmt.Errorf("failed to complete return: %w", err)
 	}
 
-	// Update saga step status
-	if err := w.sagaOrchestrator.ProcessStepResult(ctx, msg.SagaID, msg.Step, entity.StepStatusCompensated, nil); err != nil {
-		return fmt.Errorf("failed to update saga step: %w", err)
+	return nil
+}
+
+// getReturn loads the return an order-return saga step works on
+func (w *Worker) getReturn(ctx context.Context, step entity.SagaStep) (*returnEntity.Return, error) {
+	payload, err := definition.ParseReturnPayload(step)
+	if err != nil {
+		return nil, err
 	}
 
-	return tx.Commit().Error
+	ret, err := w.returns.GetByID(ctx, payload.ReturnID)
+	if errors.Is(err, returnRepo.ErrReturnNotFound) {
+		return nil, definition.Permanent(err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get return: %w", err)
+	}
+	return ret, nil
 }
 
 func initWorker() (*Worker, error) {
@@ -296,19 +482,52 @@ func initWorker() (*Worker, error) {
 	}
 
 	// Initialize saga orchestrator
-	sagaRepo := repository.NewPostgresSagaRepository(db)
+	registry := definition.NewRegistry()
+	sagaRepository := sagaRepo.NewSagaRepository(db)
 	sagaOrchestrator := saga.NewSagaOrchestrator(
-		sagaRepo,
-		messageBroker,
+		sagaRepository,
+		registry,
 		5*time.Minute, // Step timeout
 		3,             // Max retries
 	)
 
-	return &Worker{
+	// Returns and cancelled orders are refunded through the provider the
+	// payments were made with
+	payments, err := paymentProvider.NewPaymentProvider(getEnvOrDefault("PAYMENT_PROVIDER", "stripe"), paymentProvider.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize payment provider: %w", err)
+	}
+
+	orderRepository := orderPostgres.NewOrderRepository(db)
+	worker := &Worker{
 		messageBroker:    messageBroker,
 		sagaOrchestrator: sagaOrchestrator,
+		sagaRepo:         sagaRepository,
+		registry:         registry,
+		inbox:            inbox.NewRepository(db),
+		orderRepo:        orderRepository,
+		orders:           statemachine.NewOrderStateMachine(orderRepository),
+		inventory:        inventoryUsecase.NewInventoryUsecase(inventoryPostgres.NewInventoryRepository(db)),
+		promotions:       promotionUsecase.NewPromotionUsecase(promotionPostgres.NewPromotionRepository(db), money.Money{}, nil),
+		returns:          returnPostgres.NewReturnRepository(db),
+		payments:         payments,
 		db:               db,
-	}, nil
+	}
+
+	// Bind the step handlers executed by this worker
+	registry.Bind(entity.StepCreateOrder, worker.handleCreateOrder, worker.handleCreateOrderCompensation)
+	registry.Bind(entity.StepProcessPayment, worker.handleProcessPayment, worker.handleProcessPaymentCompensation)
+	registry.Bind(entity.StepUpdateInventory, worker.handleUpdateInventory, worker.handleUpdateInventoryCompensation)
+	registry.OnComplete(entity.SagaTypeOrderPayment, worker.handleOrderPaymentCompleted)
+	registry.Bind(entity.StepAcceptReturn, worker.handleAcceptReturn, worker.handleAcceptReturnCompensation)
+	registry.Bind(entity.StepRestockReturn, worker.handleRestockReturn, worker.handleRestockReturnCompensation)
+	registry.Bind(entity.StepRefundReturn, worker.handleRefundReturn, nil)
+	registry.OnComplete(entity.SagaTypeOrderReturn, worker.handleOrderReturnCompleted)
+	if err := registry.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid saga registry: %w", err)
+	}
+
+	return worker, nil
 }
 
 func initDatabase() (*gorm.DB, error) {
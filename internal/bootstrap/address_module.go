@@ -0,0 +1,37 @@
+package bootstrap
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/delivery/http"
+	addressRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/address/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/usecase"
+)
+
+// AddressModule implements the FeatureModule interface for Address feature
+type AddressModule struct {
+	db             *gorm.DB
+	addressUseCase *usecase.AddressUsecase
+}
+
+// NewAddressModule creates a new instance of AddressModule
+func NewAddressModule(db *gorm.DB) *AddressModule {
+	return &AddressModule{
+		db: db,
+	}
+}
+
+// Initialize sets up the address module
+func (m *AddressModule) Initialize() error {
+	addressRepo := addressRepo.NewAddressRepository(m.db)
+	m.addressUseCase = usecase.NewAddressUsecase(addressRepo)
+
+	return nil
+}
+
+// RegisterRoutes registers the address routes
+func (m *AddressModule) RegisterRoutes(router fiber.Router) {
+	handler := http.NewAddressHandler(m.addressUseCase)
+	http.RegisterRoutes(router, handler)
+}
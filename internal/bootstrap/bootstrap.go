@@ -1,10 +1,21 @@
 package bootstrap
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
+	authHttp "github.com/diki-haryadi/ecommerce-saga/internal/features/auth/delivery/http"
+	authRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/auth/repository/postgres"
+	taxEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/infrastructure/cache/redis"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/eventbus"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/idempotency"
 )
 
 // AppBootstrap represents the application bootstrap facade
@@ -33,8 +44,13 @@ func NewAppBootstrap(db *gorm.DB, app *fiber.App, config map[string]interface{})
 
 // Bootstrap initializes all features and registers their routes
 func (b *AppBootstrap) Bootstrap(apiGroup fiber.Router) error {
+	rates, err := b.rates()
+	if err != nil {
+		return err
+	}
+
 	// Initialize feature modules using factory
-	modules := b.createFeatureModules()
+	modules := b.createFeatureModules(rates, b.idempotency(), b.adminMiddleware())
 
 	// Initialize and register each module
 	for _, module := range modules {
@@ -48,15 +64,90 @@ func (b *AppBootstrap) Bootstrap(apiGroup fiber.Router) error {
 }
 
 // createFeatureModules creates all feature modules using factory pattern
-func (b *AppBootstrap) createFeatureModules() []FeatureModule {
+func (b *AppBootstrap) createFeatureModules(rates fx.Provider, idempotent, admin fiber.Handler) []FeatureModule {
 	return []FeatureModule{
 		NewAuthModule(b.DB, b.Config),
-		NewCartModule(b.DB, b.Config),
+		NewProductModule(b.DB, b.baseCurrency(), admin),
+		NewCartModule(b.DB, b.Config, b.minOrderValue(), rates),
 		NewOrderModule(b.DB, &Config{
 			MaxOrderItems: b.Config["max_order_items"].(int),
-			MinOrderValue: b.Config["min_order_value"].(float64),
-		}, b.EventBus),
-		NewPaymentModule(b.DB, b.Config, b.EventBus),
+			MinOrderValue: b.minOrderValue(),
+			TaxInclusive:  b.taxInclusive(),
+			TaxRounding:   b.taxRounding(),
+		}, rates, b.EventBus, idempotent, admin),
+		NewPaymentModule(b.DB, b.Config, b.EventBus, idempotent),
+		NewInventoryModule(b.DB),
+		NewPromotionModule(b.DB, b.minOrderValue(), rates, admin),
+		NewAddressModule(b.DB),
+		NewFulfillmentModule(b.DB, admin),
+		NewReturnsModule(b.DB, admin),
 		// Add other feature modules here
 	}
 }
+
+// minOrderValue returns the configured minimum order value, which is given in
+// the base currency
+func (b *AppBootstrap) minOrderValue() money.Money {
+	minOrderValue, _ := b.Config["min_order_value"].(float64)
+	return money.FromMajor(minOrderValue, b.baseCurrency(), money.RoundHalfUp)
+}
+
+// baseCurrency returns the currency products are priced in, US dollars unless
+// configured otherwise
+func (b *AppBootstrap) baseCurrency() money.Currency {
+	code, _ := b.Config["base_currency"].(string)
+	currency, err := money.ParseCurrency(code)
+	if err != nil {
+		return money.USD
+	}
+	return currency
+}
+
+// taxInclusive tells whether catalog prices include tax
+func (b *AppBootstrap) taxInclusive() bool {
+	inclusive, _ := b.Config["tax_prices_include_tax"].(bool)
+	return inclusive
+}
+
+// taxRounding returns when taxes are rounded, per line unless configured per order
+func (b *AppBootstrap) taxRounding() taxEntity.Rounding {
+	rounding, _ := b.Config["tax_rounding"].(string)
+	if taxEntity.Rounding(strings.ToUpper(rounding)) == taxEntity.RoundingPerOrder {
+		return taxEntity.RoundingPerOrder
+	}
+	return taxEntity.RoundingPerLine
+}
+
+// rates returns the exchange rates of the configured rates file, or of the
+// fx_rates table when there is none
+func (b *AppBootstrap) rates() (fx.Provider, error) {
+	if path, _ := b.Config["fx_rates_file"].(string); path != "" {
+		return fx.NewFileProvider(path)
+	}
+	return fx.NewDBProvider(b.DB), nil
+}
+
+// adminMiddleware returns the middleware that lets only authenticated admins
+// through
+func (b *AppBootstrap) adminMiddleware() fiber.Handler {
+	return authHttp.AdminMiddleware([]byte(b.Config["jwt_secret"].(string)), authRepo.NewUserRepository(b.DB))
+}
+
+// idempotency returns the Idempotency-Key middleware. Keys are kept in the
+// idempotency_keys table, or in Redis when idempotency_store is "redis", for
+// idempotency_ttl, 24 hours unless configured otherwise.
+func (b *AppBootstrap) idempotency() fiber.Handler {
+	ttl, err := time.ParseDuration(fmt.Sprint(b.Config["idempotency_ttl"]))
+	if err != nil || ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+
+	if store, _ := b.Config["idempotency_store"].(string); strings.EqualFold(store, "redis") {
+		redisConfig, _ := b.Config["redis"].(map[string]interface{})
+		db, _ := redisConfig["db"].(int)
+		password, _ := redisConfig["password"].(string)
+		cache := redis.NewRedisCache(fmt.Sprintf("%v:%v", redisConfig["host"], redisConfig["port"]), password, db)
+		return idempotency.Middleware(idempotency.NewRedisStore(cache), ttl)
+	}
+	return idempotency.Middleware(idempotency.NewPostgresStore(b.DB), ttl)
+}
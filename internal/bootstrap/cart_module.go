@@ -8,27 +8,47 @@ import (
 
 	cartRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/usecase"
+	inventoryRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/repository/postgres"
+	inventoryUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/usecase"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/service"
+	promotionRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	promotionUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // CartModule implements the FeatureModule interface for Cart feature
 type CartModule struct {
 	db          *gorm.DB
 	config      *CartConfig
+	rates       fx.Provider
 	cartUseCase *usecase.CartUsecase
 }
 
+// CartConfig configures the cart. Cart items are held in stock for
+// ReservationTTL after the last cart activity; zero disables holds.
+// MinOrderValue is the least any coupon needs the cart to be worth, in the
+// base currency.
 type CartConfig struct {
-	CartExpiry time.Duration
+	CartExpiry     time.Duration
+	ReservationTTL time.Duration
+	MinOrderValue  money.Money
 }
 
 // NewCartModule creates a new instance of CartModule
-func NewCartModule(db *gorm.DB, config map[string]interface{}) *CartModule {
+func NewCartModule(db *gorm.DB, config map[string]interface{}, minOrderValue money.Money, rates fx.Provider) *CartModule {
+	cartConfig := &CartConfig{
+		CartExpiry:    time.Duration(config["cart_expiry_hours"].(float64)) * time.Hour,
+		MinOrderValue: minOrderValue,
+	}
+	if ttl, ok := config["cart_reservation_ttl_minutes"].(float64); ok {
+		cartConfig.ReservationTTL = time.Duration(ttl * float64(time.Minute))
+	}
+
 	return &CartModule{
-		db: db,
-		config: &CartConfig{
-			CartExpiry: time.Duration(config["cart_expiry_hours"].(float64)) * time.Hour,
-		},
+		db:     db,
+		config: cartConfig,
+		rates:  rates,
 	}
 }
 
@@ -38,11 +58,21 @@ func (m *CartModule) Initialize() error {
 	cartRepo := cartRepo.NewCartRepository(m.db)
 	productService := service.NewProductService(m.db)
 
+	var stockReserver usecase.StockReserver
+	if m.config.ReservationTTL > 0 {
+		stockReserver = inventoryUsecase.NewInventoryUsecase(inventoryRepo.NewInventoryRepository(m.db))
+	}
+	pricer := promotionUsecase.NewPromotionUsecase(promotionRepo.NewPromotionRepository(m.db), m.config.MinOrderValue, m.rates)
+
 	// Initialize cart usecase with dependencies
 	m.cartUseCase = usecase.NewCartUsecase(
 		cartRepo,
 		productService,
 		m.config.CartExpiry,
+		stockReserver,
+		m.config.ReservationTTL,
+		pricer,
+		m.rates,
 	)
 
 	return nil
@@ -0,0 +1,44 @@
+package bootstrap
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/delivery/http"
+	shipmentRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/usecase"
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
+)
+
+// FulfillmentModule implements the FeatureModule interface for Fulfillment feature
+type FulfillmentModule struct {
+	db                 *gorm.DB
+	admin              fiber.Handler
+	fulfillmentUseCase *usecase.FulfillmentUsecase
+}
+
+// NewFulfillmentModule creates a new instance of FulfillmentModule. Shipments
+// are created and tracked through admin.
+func NewFulfillmentModule(db *gorm.DB, admin fiber.Handler) *FulfillmentModule {
+	return &FulfillmentModule{
+		db:    db,
+		admin: admin,
+	}
+}
+
+// Initialize sets up the fulfillment module
+func (m *FulfillmentModule) Initialize() error {
+	shipmentRepo := shipmentRepo.NewShipmentRepository(m.db)
+	orderRepo := orderRepo.NewOrderRepository(m.db)
+
+	// Shipments move their orders to SHIPPED and DELIVERED
+	m.fulfillmentUseCase = usecase.NewFulfillmentUsecase(shipmentRepo, orderRepo)
+
+	return nil
+}
+
+// RegisterRoutes registers the fulfillment routes
+func (m *FulfillmentModule) RegisterRoutes(router fiber.Router) {
+	handler := http.NewFulfillmentHandler(m.fulfillmentUseCase)
+	http.RegisterRoutes(router, handler, m.admin)
+}
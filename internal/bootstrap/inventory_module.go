@@ -0,0 +1,37 @@
+package bootstrap
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/delivery/http"
+	inventoryRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/usecase"
+)
+
+// InventoryModule implements the FeatureModule interface for Inventory feature
+type InventoryModule struct {
+	db               *gorm.DB
+	inventoryUseCase *usecase.InventoryUsecase
+}
+
+// NewInventoryModule creates a new instance of InventoryModule
+func NewInventoryModule(db *gorm.DB) *InventoryModule {
+	return &InventoryModule{
+		db: db,
+	}
+}
+
+// Initialize sets up the inventory module
+func (m *InventoryModule) Initialize() error {
+	inventoryRepo := inventoryRepo.NewInventoryRepository(m.db)
+	m.inventoryUseCase = usecase.NewInventoryUsecase(inventoryRepo)
+
+	return nil
+}
+
+// RegisterRoutes registers the inventory routes
+func (m *InventoryModule) RegisterRoutes(router fiber.Router) {
+	handler := http.NewInventoryHandler(m.inventoryUseCase)
+	http.RegisterRoutes(router, handler)
+}
@@ -1,36 +1,62 @@
 package bootstrap
 
 import (
+	"time"
+
 	usecase2 "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/usecase"
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
+	addressRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/address/repository/postgres"
+	addressUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/address/usecase"
 	cartRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/http"
 	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/usecase"
+	promotionRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	promotionUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
+	saga "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	taxEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/entity"
+	taxRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/repository/postgres"
+	taxUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/usecase"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/eventbus"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // OrderModule implements the FeatureModule interface for Order feature
 type OrderModule struct {
 	db           *gorm.DB
 	config       *Config
+	rates        fx.Provider
 	eventBus     *eventbus.EventBus
+	idempotency  fiber.Handler
+	admin        fiber.Handler
 	orderUseCase usecase2.Usecase
 }
 
+// Config configures the order module. Catalog prices include tax when
+// TaxInclusive is set; taxes are rounded per line or per order as
+// TaxRounding says.
 type Config struct {
 	MaxOrderItems int
-	MinOrderValue float64
+	MinOrderValue money.Money
+	TaxInclusive  bool
+	TaxRounding   taxEntity.Rounding
 }
 
-// NewOrderModule creates a new instance of OrderModule
-func NewOrderModule(db *gorm.DB, config *Config, eventBus *eventbus.EventBus) *OrderModule {
+// NewOrderModule creates a new instance of OrderModule. The orders of all
+// users are searched through admin.
+func NewOrderModule(db *gorm.DB, config *Config, rates fx.Provider, eventBus *eventbus.EventBus, idempotency, admin fiber.Handler) *OrderModule {
 	return &OrderModule{
-		db:       db,
-		config:   config,
-		eventBus: eventBus,
+		db:          db,
+		config:      config,
+		rates:       rates,
+		eventBus:    eventBus,
+		idempotency: idempotency,
+		admin:       admin,
 	}
 }
 
@@ -39,9 +65,28 @@ func (m *OrderModule) Initialize() error {
 	// Initialize repositories
 	orderRepo := orderRepo.NewOrderRepository(m.db)
 	cartRepo := cartRepo.NewCartRepository(m.db)
+	promotionRepo := promotionRepo.NewPromotionRepository(m.db)
+
+	// Orders are priced with the coupon applied to the cart
+	pricer := promotionUsecase.NewPromotionUsecase(promotionRepo, m.config.MinOrderValue, m.rates)
+
+	// Orders are taxed at the rules of the country they are shipped to
+	taxes := taxUsecase.NewRuleCalculator(taxRepo.NewRuleRepository(m.db), m.config.TaxInclusive, m.config.TaxRounding)
+
+	// Orders may be shipped to an address of the user's address book
+	addresses := addressUsecase.NewAddressUsecase(addressRepo.NewAddressRepository(m.db))
+
+	// Cancelled orders are refunded and their stock released by compensating
+	// their order-payment saga
+	sagas := saga.NewSagaOrchestrator(
+		sagaRepo.NewSagaRepository(m.db),
+		definition.NewRegistry(),
+		5*time.Minute, // Step timeout
+		3,             // Max retries
+	)
 
 	// Initialize order usecase with dependencies
-	m.orderUseCase = usecase.NewOrderUsecase(orderRepo, cartRepo)
+	m.orderUseCase = usecase.NewOrderUsecase(orderRepo, cartRepo, pricer, m.rates, taxes, addresses, sagas)
 
 	return nil
 }
@@ -49,5 +94,5 @@ func (m *OrderModule) Initialize() error {
 // RegisterRoutes registers the order routes
 func (m *OrderModule) RegisterRoutes(router fiber.Router) {
 	handler := http.NewOrderHandler(m.orderUseCase)
-	http.RegisterRoutes(router, handler, nil) // nil for authMiddleware since it should be handled at a higher level
+	http.RegisterRoutes(router, handler, nil, m.admin, m.idempotency) // nil for authMiddleware since it should be handled at a higher level
 }
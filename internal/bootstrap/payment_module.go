@@ -20,6 +20,7 @@ type PaymentModule struct {
 	db             *gorm.DB
 	config         *PaymentConfig
 	eventBus       *eventbus.EventBus
+	idempotency    fiber.Handler
 	paymentUseCase usecase2.Usecase
 }
 
@@ -33,7 +34,7 @@ type PaymentConfig struct {
 }
 
 // NewPaymentModule creates a new instance of PaymentModule
-func NewPaymentModule(db *gorm.DB, config map[string]interface{}, eventBus *eventbus.EventBus) *PaymentModule {
+func NewPaymentModule(db *gorm.DB, config map[string]interface{}, eventBus *eventbus.EventBus, idempotency fiber.Handler) *PaymentModule {
 	return &PaymentModule{
 		db: db,
 		config: &PaymentConfig{
@@ -44,7 +45,8 @@ func NewPaymentModule(db *gorm.DB, config map[string]interface{}, eventBus *even
 			RetryAttempts:   int(config["payment_retry_attempts"].(float64)),
 			WebhookEndpoint: config["payment_webhook_endpoint"].(string),
 		},
-		eventBus: eventBus,
+		eventBus:    eventBus,
+		idempotency: idempotency,
 	}
 }
 
@@ -82,5 +84,5 @@ func (m *PaymentModule) Initialize() error {
 
 // RegisterRoutes registers the payment routes
 func (m *PaymentModule) RegisterRoutes(router fiber.Router) {
-	paymentHttp.RegisterRoutes(router, m.paymentUseCase)
+	paymentHttp.RegisterRoutes(router, m.paymentUseCase, m.idempotency)
 }
@@ -0,0 +1,45 @@
+package bootstrap
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/delivery/http"
+	productRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/product/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// ProductModule implements the FeatureModule interface for Product catalog feature
+type ProductModule struct {
+	db             *gorm.DB
+	baseCurrency   money.Currency
+	admin          fiber.Handler
+	productUseCase *usecase.ProductUsecase
+}
+
+// NewProductModule creates a new instance of ProductModule. The catalog is
+// changed through admin.
+func NewProductModule(db *gorm.DB, baseCurrency money.Currency, admin fiber.Handler) *ProductModule {
+	return &ProductModule{
+		db:           db,
+		baseCurrency: baseCurrency,
+		admin:        admin,
+	}
+}
+
+// Initialize sets up the product module
+func (m *ProductModule) Initialize() error {
+	categoryRepo := productRepo.NewCategoryRepository(m.db)
+	productRepo := productRepo.NewProductRepository(m.db)
+
+	m.productUseCase = usecase.NewProductUsecase(productRepo, categoryRepo, m.baseCurrency)
+
+	return nil
+}
+
+// RegisterRoutes registers the product routes
+func (m *ProductModule) RegisterRoutes(router fiber.Router) {
+	handler := http.NewProductHandler(m.productUseCase)
+	http.RegisterRoutes(router, handler, m.admin)
+}
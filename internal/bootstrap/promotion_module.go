@@ -0,0 +1,47 @@
+package bootstrap
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/delivery/http"
+	promotionRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// PromotionModule implements the FeatureModule interface for Promotion feature
+type PromotionModule struct {
+	db               *gorm.DB
+	minOrderValue    money.Money
+	rates            fx.Provider
+	admin            fiber.Handler
+	promotionUseCase *usecase.PromotionUsecase
+}
+
+// NewPromotionModule creates a new instance of PromotionModule. Promotions
+// are created through admin.
+func NewPromotionModule(db *gorm.DB, minOrderValue money.Money, rates fx.Provider, admin fiber.Handler) *PromotionModule {
+	return &PromotionModule{
+		db:            db,
+		minOrderValue: minOrderValue,
+		rates:         rates,
+		admin:         admin,
+	}
+}
+
+// Initialize sets up the promotion module
+func (m *PromotionModule) Initialize() error {
+	promotionRepo := promotionRepo.NewPromotionRepository(m.db)
+
+	m.promotionUseCase = usecase.NewPromotionUsecase(promotionRepo, m.minOrderValue, m.rates)
+
+	return nil
+}
+
+// RegisterRoutes registers the promotion routes
+func (m *PromotionModule) RegisterRoutes(router fiber.Router) {
+	handler := http.NewPromotionHandler(m.promotionUseCase)
+	http.RegisterRoutes(router, handler, m.admin)
+}
@@ -0,0 +1,56 @@
+package bootstrap
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/delivery/http"
+	returnRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/returns/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
+	saga "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+)
+
+// ReturnsModule implements the FeatureModule interface for Returns feature
+type ReturnsModule struct {
+	db            *gorm.DB
+	admin         fiber.Handler
+	returnUseCase *usecase.ReturnUsecase
+}
+
+// NewReturnsModule creates a new instance of ReturnsModule. Returns are
+// approved and rejected through admin.
+func NewReturnsModule(db *gorm.DB, admin fiber.Handler) *ReturnsModule {
+	return &ReturnsModule{
+		db:    db,
+		admin: admin,
+	}
+}
+
+// Initialize sets up the returns module
+func (m *ReturnsModule) Initialize() error {
+	returnRepo := returnRepo.NewReturnRepository(m.db)
+	orderRepo := orderRepo.NewOrderRepository(m.db)
+
+	// Approved returns are restocked and refunded by the workers through the
+	// order-return saga
+	sagas := saga.NewSagaOrchestrator(
+		sagaRepo.NewSagaRepository(m.db),
+		definition.NewRegistry(),
+		5*time.Minute, // Step timeout
+		3,             // Max retries
+	)
+	m.returnUseCase = usecase.NewReturnUsecase(returnRepo, orderRepo, sagas)
+
+	return nil
+}
+
+// RegisterRoutes registers the returns routes
+func (m *ReturnsModule) RegisterRoutes(router fiber.Router) {
+	handler := http.NewReturnHandler(m.returnUseCase)
+	http.RegisterRoutes(router, handler, m.admin)
+}
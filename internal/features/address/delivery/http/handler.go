@@ -0,0 +1,147 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/dto/request"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
+	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+)
+
+type AddressHandler struct {
+	addressUsecase usecase.Usecase
+	errorHandler   errors.ErrorHandler
+}
+
+func NewAddressHandler(addressUsecase usecase.Usecase) *AddressHandler {
+	return &AddressHandler{
+		addressUsecase: addressUsecase,
+		errorHandler:   errors.NewErrorHandler(),
+	}
+}
+
+// CreateAddress handles POST /addresses request
+func (h *AddressHandler) CreateAddress(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	var req request.AddressRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	resp, err := h.addressUsecase.CreateAddress(c.Context(), userID, toInput(req))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.Created(c, "Address created successfully", resp)
+}
+
+// GetAddress handles GET /addresses/:id request
+func (h *AddressHandler) GetAddress(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	addressID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid address ID"))
+	}
+
+	resp, err := h.addressUsecase.GetAddress(c.Context(), userID, addressID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Address retrieved successfully", resp)
+}
+
+// ListAddresses handles GET /addresses request
+func (h *AddressHandler) ListAddresses(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	resp, err := h.addressUsecase.ListAddresses(c.Context(), userID)
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+
+	return httpresponse.OK(c, "Addresses retrieved successfully", resp)
+}
+
+// UpdateAddress handles PUT /addresses/:id request
+func (h *AddressHandler) UpdateAddress(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	addressID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid address ID"))
+	}
+
+	var req request.AddressRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	resp, err := h.addressUsecase.UpdateAddress(c.Context(), userID, addressID, toInput(req))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Address updated successfully", resp)
+}
+
+// DeleteAddress handles DELETE /addresses/:id request
+func (h *AddressHandler) DeleteAddress(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	addressID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid address ID"))
+	}
+
+	if err := h.addressUsecase.DeleteAddress(c.Context(), userID, addressID); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Address deleted successfully", nil)
+}
+
+func (h *AddressHandler) handleError(c *fiber.Ctx, err error) error {
+	switch err {
+	case usecase.ErrNotFound:
+		return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+	case usecase.ErrInvalidAddress:
+		return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+	default:
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+}
+
+func toInput(req request.AddressRequest) usecase.AddressInput {
+	return usecase.AddressInput{
+		Recipient:  req.Recipient,
+		Phone:      req.Phone,
+		Line1:      req.Line1,
+		Line2:      req.Line2,
+		City:       req.City,
+		Region:     req.Region,
+		PostalCode: req.PostalCode,
+		Country:    req.Country,
+		IsDefault:  req.IsDefault,
+	}
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRoutes registers all address book routes
+func RegisterRoutes(router fiber.Router, handler *AddressHandler) {
+	addresses := router.Group("/addresses")
+
+	addresses.Get("", handler.ListAddresses)
+	addresses.Post("", handler.CreateAddress)
+	addresses.Get("/:id", handler.GetAddress)
+	addresses.Put("/:id", handler.UpdateAddress)
+	addresses.Delete("/:id", handler.DeleteAddress)
+}
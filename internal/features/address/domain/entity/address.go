@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Address represents a shipping address in a user's address book. Country is
+// an ISO 3166 alpha-2 code.
+type Address struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	UserID     uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+	Recipient  string    `json:"recipient" gorm:"type:varchar(255);not null"`
+	Phone      string    `json:"phone" gorm:"type:varchar(50)"`
+	Line1      string    `json:"line1" gorm:"type:varchar(255);not null"`
+	Line2      string    `json:"line2" gorm:"type:varchar(255)"`
+	City       string    `json:"city" gorm:"type:varchar(100);not null"`
+	Region     string    `json:"region" gorm:"type:varchar(50)"`
+	PostalCode string    `json:"postal_code" gorm:"type:varchar(20)"`
+	Country    string    `json:"country" gorm:"type:varchar(2);not null"`
+	IsDefault  bool      `json:"is_default" gorm:"not null;default:false"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// NewAddress creates a new address of a user
+func NewAddress(userID uuid.UUID) *Address {
+	return &Address{
+		ID:        uuid.New(),
+		UserID:    userID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Normalize trims the fields and upper-cases the country and region codes
+func (a *Address) Normalize() {
+	a.Recipient = strings.TrimSpace(a.Recipient)
+	a.Phone = strings.TrimSpace(a.Phone)
+	a.Line1 = strings.TrimSpace(a.Line1)
+	a.Line2 = strings.TrimSpace(a.Line2)
+	a.City = strings.TrimSpace(a.City)
+	a.Region = strings.ToUpper(strings.TrimSpace(a.Region))
+	a.PostalCode = strings.TrimSpace(a.PostalCode)
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+}
+
+// IsComplete checks if the address has everything a carrier needs
+func (a *Address) IsComplete() bool {
+	return a.Recipient != "" && a.Line1 != "" && a.City != "" && len(a.Country) == 2
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/entity"
+)
+
+var (
+	ErrAddressNotFound = errors.New("address not found")
+)
+
+// AddressRepository defines the interface for address book persistence
+type AddressRepository interface {
+	// Create saves a new address, making it the user's only default if it is one
+	Create(ctx context.Context, address *entity.Address) error
+
+	// GetByID retrieves an address by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Address, error)
+
+	// ListByUserID retrieves the addresses of a user, the default first
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Address, error)
+
+	// Update updates an address, making it the user's only default if it is one
+	Update(ctx context.Context, address *entity.Address) error
+
+	// Delete removes an address
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Usecase defines the address book business logic interface
+type Usecase interface {
+	CreateAddress(ctx context.Context, userID uuid.UUID, input AddressInput) (*AddressResponse, error)
+	GetAddress(ctx context.Context, userID, addressID uuid.UUID) (*AddressResponse, error)
+	ListAddresses(ctx context.Context, userID uuid.UUID) ([]*AddressResponse, error)
+	UpdateAddress(ctx context.Context, userID, addressID uuid.UUID, input AddressInput) (*AddressResponse, error)
+	DeleteAddress(ctx context.Context, userID, addressID uuid.UUID) error
+}
+
+// AddressInput is the content of an address. Country is an ISO 3166 alpha-2
+// code and Region the code of a state or province within it.
+type AddressInput struct {
+	Recipient  string
+	Phone      string
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	Country    string
+	IsDefault  bool
+}
+
+type AddressResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserID     uuid.UUID `json:"user_id"`
+	Recipient  string    `json:"recipient"`
+	Phone      string    `json:"phone,omitempty"`
+	Line1      string    `json:"line1"`
+	Line2      string    `json:"line2,omitempty"`
+	City       string    `json:"city"`
+	Region     string    `json:"region,omitempty"`
+	PostalCode string    `json:"postal_code,omitempty"`
+	Country    string    `json:"country"`
+	IsDefault  bool      `json:"is_default"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Common errors
+var (
+	ErrNotFound       = NewError("address not found")
+	ErrInvalidAddress = NewError("recipient, street, city and a two-letter country are required")
+)
+
+// Error represents an address error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new address error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
@@ -0,0 +1,15 @@
+package request
+
+// AddressRequest represents the request to create or update an address.
+// Country is an ISO 3166 alpha-2 code.
+type AddressRequest struct {
+	Recipient  string `json:"recipient" validate:"required"`
+	Phone      string `json:"phone"`
+	Line1      string `json:"line1" validate:"required"`
+	Line2      string `json:"line2"`
+	City       string `json:"city" validate:"required"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country" validate:"required,len=2"`
+	IsDefault  bool   `json:"is_default"`
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/repository"
+)
+
+// AddressRepository implements the repository.AddressRepository interface
+type AddressRepository struct {
+	db *gorm.DB
+}
+
+// NewAddressRepository creates a new PostgreSQL address repository
+func NewAddressRepository(db *gorm.DB) repository.AddressRepository {
+	return &AddressRepository{
+		db: db,
+	}
+}
+
+// Create saves a new address
+func (r *AddressRepository) Create(ctx context.Context, address *entity.Address) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := clearDefault(tx, address); err != nil {
+			return err
+		}
+		return tx.Create(address).Error
+	})
+}
+
+// GetByID retrieves an address by its ID
+func (r *AddressRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Address, error) {
+	var address entity.Address
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&address).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// ListByUserID retrieves the addresses of a user, the default first
+func (r *AddressRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Address, error) {
+	var addresses []*entity.Address
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("is_default DESC, created_at DESC").
+		Find(&addresses).Error
+	if err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// Update updates an address
+func (r *AddressRepository) Update(ctx context.Context, address *entity.Address) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := clearDefault(tx, address); err != nil {
+			return err
+		}
+		result := tx.Save(address)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrAddressNotFound
+		}
+		return nil
+	})
+}
+
+// Delete removes an address
+func (r *AddressRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Address{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrAddressNotFound
+	}
+	return nil
+}
+
+// clearDefault unsets the other defaults of the user when address becomes one
+func clearDefault(tx *gorm.DB, address *entity.Address) error {
+	if !address.IsDefault {
+		return nil
+	}
+	return tx.Model(&entity.Address{}).
+		Where("user_id = ? AND id <> ? AND is_default", address.UserID, address.ID).
+		Update("is_default", false).Error
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/usecase"
+)
+
+type AddressUsecase struct {
+	addressRepo repository.AddressRepository
+}
+
+// NewAddressUsecase creates a new address book usecase
+func NewAddressUsecase(addressRepo repository.AddressRepository) *AddressUsecase {
+	return &AddressUsecase{
+		addressRepo: addressRepo,
+	}
+}
+
+// CreateAddress adds an address to the user's address book. The first
+// address of a user becomes their default.
+func (u *AddressUsecase) CreateAddress(ctx context.Context, userID uuid.UUID, input usecase.AddressInput) (*usecase.AddressResponse, error) {
+	address := entity.NewAddress(userID)
+	applyInput(address, input)
+	if !address.IsComplete() {
+		return nil, usecase.ErrInvalidAddress
+	}
+
+	existing, err := u.addressRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		address.IsDefault = true
+	}
+
+	if err := u.addressRepo.Create(ctx, address); err != nil {
+		return nil, err
+	}
+	return convertAddress(address), nil
+}
+
+// GetAddress retrieves an address of the user
+func (u *AddressUsecase) GetAddress(ctx context.Context, userID, addressID uuid.UUID) (*usecase.AddressResponse, error) {
+	address, err := u.getAddress(ctx, userID, addressID)
+	if err != nil {
+		return nil, err
+	}
+	return convertAddress(address), nil
+}
+
+// ListAddresses retrieves the address book of the user, the default first
+func (u *AddressUsecase) ListAddresses(ctx context.Context, userID uuid.UUID) ([]*usecase.AddressResponse, error) {
+	addresses, err := u.addressRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*usecase.AddressResponse, len(addresses))
+	for i, address := range addresses {
+		result[i] = convertAddress(address)
+	}
+	return result, nil
+}
+
+// UpdateAddress replaces the content of an address of the user. Orders keep
+// the address they were placed with.
+func (u *AddressUsecase) UpdateAddress(ctx context.Context, userID, addressID uuid.UUID, input usecase.AddressInput) (*usecase.AddressResponse, error) {
+	address, err := u.getAddress(ctx, userID, addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The default only moves to another address
+	isDefault := address.IsDefault
+	applyInput(address, input)
+	address.IsDefault = address.IsDefault || isDefault
+	if !address.IsComplete() {
+		return nil, usecase.ErrInvalidAddress
+	}
+	address.UpdatedAt = time.Now()
+
+	if err := u.addressRepo.Update(ctx, address); err != nil {
+		return nil, convertError(err)
+	}
+	return convertAddress(address), nil
+}
+
+// DeleteAddress removes an address of the user
+func (u *AddressUsecase) DeleteAddress(ctx context.Context, userID, addressID uuid.UUID) error {
+	if _, err := u.getAddress(ctx, userID, addressID); err != nil {
+		return err
+	}
+	return convertError(u.addressRepo.Delete(ctx, addressID))
+}
+
+// getAddress retrieves an address, which must belong to the user
+func (u *AddressUsecase) getAddress(ctx context.Context, userID, addressID uuid.UUID) (*entity.Address, error) {
+	address, err := u.addressRepo.GetByID(ctx, addressID)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if address.UserID != userID {
+		return nil, usecase.ErrNotFound
+	}
+	return address, nil
+}
+
+func applyInput(address *entity.Address, input usecase.AddressInput) {
+	address.Recipient = input.Recipient
+	address.Phone = input.Phone
+	address.Line1 = input.Line1
+	address.Line2 = input.Line2
+	address.City = input.City
+	address.Region = input.Region
+	address.PostalCode = input.PostalCode
+	address.Country = input.Country
+	address.IsDefault = input.IsDefault
+	address.Normalize()
+}
+
+func convertAddress(address *entity.Address) *usecase.AddressResponse {
+	return &usecase.AddressResponse{
+		ID:         address.ID,
+		UserID:     address.UserID,
+		Recipient:  address.Recipient,
+		Phone:      address.Phone,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
+		IsDefault:  address.IsDefault,
+		CreatedAt:  address.CreatedAt,
+		UpdatedAt:  address.UpdatedAt,
+	}
+}
+
+func convertError(err error) error {
+	if errors.Is(err, repository.ErrAddressNotFound) {
+		return usecase.ErrNotFound
+	}
+	return err
+}
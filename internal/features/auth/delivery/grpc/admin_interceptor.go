@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/auth/repository"
+)
+
+// AdminInterceptor lets only admins call the methods it guards. It runs after
+// the AuthInterceptor, which puts the user ID of the caller in the context.
+type AdminInterceptor struct {
+	users repository.UserRepository
+	// List of methods that require the admin role
+	adminMethods map[string]bool
+}
+
+// NewAdminInterceptor creates a new admin interceptor guarding the given full method names
+func NewAdminInterceptor(users repository.UserRepository, methods ...string) *AdminInterceptor {
+	adminMethods := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		adminMethods[method] = true
+	}
+
+	return &AdminInterceptor{
+		users:        users,
+		adminMethods: adminMethods,
+	}
+}
+
+// Unary returns a unary server interceptor for the admin role
+func (i *AdminInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !i.adminMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if err := i.authorize(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authorize checks that the caller is an admin. The role is read from the
+// user on every call, so a demoted admin loses access before their token
+// expires.
+func (i *AdminInterceptor) authorize(ctx context.Context) error {
+	value, _ := ctx.Value("user_id").(string)
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	user, err := i.users.GetByID(ctx, userID)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to get user")
+	}
+	if user == nil || !user.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "admin access required")
+	}
+	return nil
+}
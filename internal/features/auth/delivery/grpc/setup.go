@@ -19,14 +19,15 @@ type Server struct {
 	authUsecase usecase.AuthUsecase
 }
 
-// NewServer creates a new gRPC server
-func NewServer(authUsecase usecase.AuthUsecase) *Server {
+// NewServer creates a new gRPC server. The given unary interceptors run after
+// authentication, so they see the user ID of the caller.
+func NewServer(authUsecase usecase.AuthUsecase, interceptors ...grpc.UnaryServerInterceptor) *Server {
 	// Create auth interceptor
 	interceptor := NewAuthInterceptor(authUsecase)
 
 	// Create gRPC server with interceptors
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(interceptor.Unary()),
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{interceptor.Unary()}, interceptors...)...),
 		grpc.StreamInterceptor(interceptor.Stream()),
 	)
 
@@ -47,6 +48,12 @@ func NewServer(authUsecase usecase.AuthUsecase) *Server {
 	}
 }
 
+// RegisterService registers a further service on the server, which makes it
+// a grpc.ServiceRegistrar for the generated Register functions
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	s.server.RegisterService(desc, impl)
+}
+
 // Start starts the gRPC server
 func (s *Server) Start(port int) error {
 	addr := fmt.Sprintf(":%d", port)
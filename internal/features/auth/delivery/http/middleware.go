@@ -1,37 +1,53 @@
 package http
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/auth/dto/response"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/auth/repository"
 	"github.com/diki-haryadi/ecommerce-saga/internal/shared/utils"
 )
 
 // AuthMiddleware handles JWT authentication
 func AuthMiddleware(secret []byte) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		authHeader := c.Get("Authorization")
-		if authHeader == "" {
+		claims, err := authenticate(c, secret)
+		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(response.ErrorResponse{
-				Error: "No authorization header",
+				Error: err.Error(),
 			})
 		}
 
-		// Extract token from Bearer header
-		tokenParts := strings.Split(authHeader, " ")
-		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		// Set user ID in context
+		c.Locals("user_id", claims.UserID.String())
+		return c.Next()
+	}
+}
+
+// AdminMiddleware handles JWT authentication and lets only admins through.
+// The role is read from the user on every request, so a demoted admin loses
+// access before their token expires.
+func AdminMiddleware(secret []byte, users repository.UserRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims, err := authenticate(c, secret)
+		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(response.ErrorResponse{
-				Error: "Invalid authorization header format",
+				Error: err.Error(),
 			})
 		}
 
-		// Validate token
-		claims, err := utils.ValidateToken(tokenParts[1], secret)
+		user, err := users.GetByID(c.Context(), claims.UserID)
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(response.ErrorResponse{
-				Error: err.Error(),
+			return c.Status(fiber.StatusInternalServerError).JSON(response.ErrorResponse{
+				Error: "Failed to get user",
+			})
+		}
+		if user == nil || !user.IsAdmin() {
+			return c.Status(fiber.StatusForbidden).JSON(response.ErrorResponse{
+				Error: "Admin access required",
 			})
 		}
 
@@ -40,3 +56,20 @@ func AuthMiddleware(secret []byte) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// authenticate validates the bearer token of the request
+func authenticate(c *fiber.Ctx, secret []byte) (*utils.Claims, error) {
+	authHeader := c.Get("Authorization")
+	if authHeader == "" {
+		return nil, errors.New("No authorization header")
+	}
+
+	// Extract token from Bearer header
+	tokenParts := strings.Split(authHeader, " ")
+	if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		return nil, errors.New("Invalid authorization header format")
+	}
+
+	// Validate token
+	return utils.ValidateToken(tokenParts[1], secret)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/auth/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/auth/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/utils"
+)
+
+type memoryUserRepository struct {
+	repository.UserRepository
+	users map[uuid.UUID]*entity.User
+}
+
+func (r *memoryUserRepository) GetByID(_ context.Context, id uuid.UUID) (*entity.User, error) {
+	return r.users[id], nil
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	secret := []byte("secret")
+	admin := &entity.User{ID: uuid.New(), Role: entity.RoleAdmin}
+	customer := &entity.User{ID: uuid.New(), Role: entity.RoleCustomer}
+	users := &memoryUserRepository{users: map[uuid.UUID]*entity.User{
+		admin.ID:    admin,
+		customer.ID: customer,
+	}}
+
+	app := fiber.New()
+	app.Post("/products", AdminMiddleware(secret, users), func(c *fiber.Ctx) error {
+		return c.SendString(c.Locals("user_id").(string))
+	})
+
+	send := func(userID uuid.UUID) int {
+		req := httptest.NewRequest(fiber.MethodPost, "/products", nil)
+		if userID != uuid.Nil {
+			token, err := utils.GenerateToken(userID, secret, time.Hour)
+			require.NoError(t, err)
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, fiber.StatusOK, send(admin.ID))
+	assert.Equal(t, fiber.StatusForbidden, send(customer.ID))
+	assert.Equal(t, fiber.StatusForbidden, send(uuid.New()))
+	assert.Equal(t, fiber.StatusUnauthorized, send(uuid.Nil))
+
+	// The role is read on every request
+	admin.Role = entity.RoleCustomer
+	assert.Equal(t, fiber.StatusForbidden, send(admin.ID))
+}
@@ -7,12 +7,24 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Role is what a user is allowed to do
+type Role string
+
+const (
+	// RoleCustomer is the role of every registered user
+	RoleCustomer Role = "customer"
+	// RoleAdmin manages the catalog, promotions, fulfillment and returns, and
+	// searches the orders of all users. Admins are promoted in the database.
+	RoleAdmin Role = "admin"
+)
+
 // User represents a user in the system
 type User struct {
 	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
 	Email        string    `json:"email" gorm:"unique;not null" validate:"required,email"`
 	PasswordHash string    `json:"-" gorm:"not null"`
 	RefreshToken string    `json:"-" gorm:"type:text"`
+	Role         Role      `json:"role" gorm:"type:varchar(20);not null;default:customer"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -27,9 +39,15 @@ func NewUser(email, password string) (*User, error) {
 	return &User{
 		Email:        email,
 		PasswordHash: string(hashedPassword),
+		Role:         RoleCustomer,
 	}, nil
 }
 
+// IsAdmin checks if the user has the admin role
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
 // ValidatePassword checks if the provided password matches the user's password hash
 func (u *User) ValidatePassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
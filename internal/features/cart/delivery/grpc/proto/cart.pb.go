@@ -21,19 +21,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Money is an amount in the minor units of an ISO 4217 currency
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[0]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +98,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[0]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +111,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{0}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CartItem) GetId() string {
@@ -82,11 +135,11 @@ func (x *CartItem) GetQuantity() int32 {
 	return 0
 }
 
-func (x *CartItem) GetPrice() float64 {
+func (x *CartItem) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 type Cart struct {
@@ -94,14 +147,14 @@ type Cart struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Items         []*CartItem            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	Total         float64                `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	Total         *Money                 `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Cart) Reset() {
 	*x = Cart{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[1]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -113,7 +166,7 @@ func (x *Cart) String() string {
 func (*Cart) ProtoMessage() {}
 
 func (x *Cart) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[1]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,7 +179,7 @@ func (x *Cart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cart.ProtoReflect.Descriptor instead.
 func (*Cart) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{1}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Cart) GetId() string {
@@ -150,11 +203,11 @@ func (x *Cart) GetItems() []*CartItem {
 	return nil
 }
 
-func (x *Cart) GetTotal() float64 {
+func (x *Cart) GetTotal() *Money {
 	if x != nil {
 		return x.Total
 	}
-	return 0
+	return nil
 }
 
 type AddItemRequest struct {
@@ -168,7 +221,7 @@ type AddItemRequest struct {
 
 func (x *AddItemRequest) Reset() {
 	*x = AddItemRequest{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[2]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -180,7 +233,7 @@ func (x *AddItemRequest) String() string {
 func (*AddItemRequest) ProtoMessage() {}
 
 func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[2]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,7 +246,7 @@ func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddItemRequest.ProtoReflect.Descriptor instead.
 func (*AddItemRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{2}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AddItemRequest) GetUserId() string {
@@ -228,7 +281,7 @@ type AddItemResponse struct {
 
 func (x *AddItemResponse) Reset() {
 	*x = AddItemResponse{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[3]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +293,7 @@ func (x *AddItemResponse) String() string {
 func (*AddItemResponse) ProtoMessage() {}
 
 func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[3]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +306,7 @@ func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddItemResponse.ProtoReflect.Descriptor instead.
 func (*AddItemResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{3}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AddItemResponse) GetSuccess() bool {
@@ -287,7 +340,7 @@ type RemoveItemRequest struct {
 
 func (x *RemoveItemRequest) Reset() {
 	*x = RemoveItemRequest{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[4]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +352,7 @@ func (x *RemoveItemRequest) String() string {
 func (*RemoveItemRequest) ProtoMessage() {}
 
 func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[4]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +365,7 @@ func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
 func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{4}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RemoveItemRequest) GetUserId() string {
@@ -339,7 +392,7 @@ type RemoveItemResponse struct {
 
 func (x *RemoveItemResponse) Reset() {
 	*x = RemoveItemResponse{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[5]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +404,7 @@ func (x *RemoveItemResponse) String() string {
 func (*RemoveItemResponse) ProtoMessage() {}
 
 func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[5]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +417,7 @@ func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveItemResponse.ProtoReflect.Descriptor instead.
 func (*RemoveItemResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{5}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RemoveItemResponse) GetSuccess() bool {
@@ -392,7 +445,7 @@ type UpdateItemRequest struct {
 
 func (x *UpdateItemRequest) Reset() {
 	*x = UpdateItemRequest{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[6]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +457,7 @@ func (x *UpdateItemRequest) String() string {
 func (*UpdateItemRequest) ProtoMessage() {}
 
 func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[6]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +470,7 @@ func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{6}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateItemRequest) GetUserId() string {
@@ -452,7 +505,7 @@ type UpdateItemResponse struct {
 
 func (x *UpdateItemResponse) Reset() {
 	*x = UpdateItemResponse{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[7]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +517,7 @@ func (x *UpdateItemResponse) String() string {
 func (*UpdateItemResponse) ProtoMessage() {}
 
 func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[7]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +530,7 @@ func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
 func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{7}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateItemResponse) GetSuccess() bool {
@@ -510,7 +563,7 @@ type GetCartRequest struct {
 
 func (x *GetCartRequest) Reset() {
 	*x = GetCartRequest{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[8]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +575,7 @@ func (x *GetCartRequest) String() string {
 func (*GetCartRequest) ProtoMessage() {}
 
 func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[8]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +588,7 @@ func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
 func (*GetCartRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{8}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCartRequest) GetUserId() string {
@@ -554,7 +607,7 @@ type GetCartResponse struct {
 
 func (x *GetCartResponse) Reset() {
 	*x = GetCartResponse{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[9]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +619,7 @@ func (x *GetCartResponse) String() string {
 func (*GetCartResponse) ProtoMessage() {}
 
 func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[9]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +632,7 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
 func (*GetCartResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{9}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetCartResponse) GetCart() *Cart {
@@ -598,7 +651,7 @@ type ClearCartRequest struct {
 
 func (x *ClearCartRequest) Reset() {
 	*x = ClearCartRequest{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[10]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +663,7 @@ func (x *ClearCartRequest) String() string {
 func (*ClearCartRequest) ProtoMessage() {}
 
 func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[10]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +676,7 @@ func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
 func (*ClearCartRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{10}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ClearCartRequest) GetUserId() string {
@@ -643,7 +696,7 @@ type ClearCartResponse struct {
 
 func (x *ClearCartResponse) Reset() {
 	*x = ClearCartResponse{}
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[11]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +708,7 @@ func (x *ClearCartResponse) String() string {
 func (*ClearCartResponse) ProtoMessage() {}
 
 func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[11]
+	mi := &file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +721,7 @@ func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
 func (*ClearCartResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{11}
+	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ClearCartResponse) GetSuccess() bool {
@@ -689,18 +742,21 @@ var File_internal_features_cart_delivery_grpc_proto_cart_proto protoreflect.File
 
 const file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDesc = "" +
 	"\n" +
-	"5internal/features/cart/delivery/grpc/proto/cart.proto\x12\x04cart\"k\n" +
+	"5internal/features/cart/delivery/grpc/proto/cart.proto\x12\x04cart\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"~\n" +
 	"\bCartItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\"k\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12!\n" +
+	"\x05price\x18\x05 \x01(\v2\v.cart.MoneyR\x05priceJ\x04\b\x04\x10\x05\"~\n" +
 	"\x04Cart\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
-	"\x05items\x18\x03 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\x01R\x05total\"d\n" +
+	"\x05items\x18\x03 \x03(\v2\x0e.cart.CartItemR\x05items\x12!\n" +
+	"\x05total\x18\x05 \x01(\v2\v.cart.MoneyR\x05totalJ\x04\b\x04\x10\x05\"d\n" +
 	"\x0eAddItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
@@ -759,41 +815,44 @@ func file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescGZIP() []
 	return file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDescData
 }
 
-var file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_features_cart_delivery_grpc_proto_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_internal_features_cart_delivery_grpc_proto_cart_proto_goTypes = []any{
-	(*CartItem)(nil),           // 0: cart.CartItem
-	(*Cart)(nil),               // 1: cart.Cart
-	(*AddItemRequest)(nil),     // 2: cart.AddItemRequest
-	(*AddItemResponse)(nil),    // 3: cart.AddItemResponse
-	(*RemoveItemRequest)(nil),  // 4: cart.RemoveItemRequest
-	(*RemoveItemResponse)(nil), // 5: cart.RemoveItemResponse
-	(*UpdateItemRequest)(nil),  // 6: cart.UpdateItemRequest
-	(*UpdateItemResponse)(nil), // 7: cart.UpdateItemResponse
-	(*GetCartRequest)(nil),     // 8: cart.GetCartRequest
-	(*GetCartResponse)(nil),    // 9: cart.GetCartResponse
-	(*ClearCartRequest)(nil),   // 10: cart.ClearCartRequest
-	(*ClearCartResponse)(nil),  // 11: cart.ClearCartResponse
+	(*Money)(nil),              // 0: cart.Money
+	(*CartItem)(nil),           // 1: cart.CartItem
+	(*Cart)(nil),               // 2: cart.Cart
+	(*AddItemRequest)(nil),     // 3: cart.AddItemRequest
+	(*AddItemResponse)(nil),    // 4: cart.AddItemResponse
+	(*RemoveItemRequest)(nil),  // 5: cart.RemoveItemRequest
+	(*RemoveItemResponse)(nil), // 6: cart.RemoveItemResponse
+	(*UpdateItemRequest)(nil),  // 7: cart.UpdateItemRequest
+	(*UpdateItemResponse)(nil), // 8: cart.UpdateItemResponse
+	(*GetCartRequest)(nil),     // 9: cart.GetCartRequest
+	(*GetCartResponse)(nil),    // 10: cart.GetCartResponse
+	(*ClearCartRequest)(nil),   // 11: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),  // 12: cart.ClearCartResponse
 }
 var file_internal_features_cart_delivery_grpc_proto_cart_proto_depIdxs = []int32{
-	0,  // 0: cart.Cart.items:type_name -> cart.CartItem
-	1,  // 1: cart.AddItemResponse.cart:type_name -> cart.Cart
-	1,  // 2: cart.UpdateItemResponse.cart:type_name -> cart.Cart
-	1,  // 3: cart.GetCartResponse.cart:type_name -> cart.Cart
-	2,  // 4: cart.CartService.AddItem:input_type -> cart.AddItemRequest
-	4,  // 5: cart.CartService.RemoveItem:input_type -> cart.RemoveItemRequest
-	6,  // 6: cart.CartService.UpdateItem:input_type -> cart.UpdateItemRequest
-	8,  // 7: cart.CartService.GetCart:input_type -> cart.GetCartRequest
-	10, // 8: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
-	3,  // 9: cart.CartService.AddItem:output_type -> cart.AddItemResponse
-	5,  // 10: cart.CartService.RemoveItem:output_type -> cart.RemoveItemResponse
-	7,  // 11: cart.CartService.UpdateItem:output_type -> cart.UpdateItemResponse
-	9,  // 12: cart.CartService.GetCart:output_type -> cart.GetCartResponse
-	11, // 13: cart.CartService.ClearCart:output_type -> cart.ClearCartResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 0: cart.CartItem.price:type_name -> cart.Money
+	1,  // 1: cart.Cart.items:type_name -> cart.CartItem
+	0,  // 2: cart.Cart.total:type_name -> cart.Money
+	2,  // 3: cart.AddItemResponse.cart:type_name -> cart.Cart
+	2,  // 4: cart.UpdateItemResponse.cart:type_name -> cart.Cart
+	2,  // 5: cart.GetCartResponse.cart:type_name -> cart.Cart
+	3,  // 6: cart.CartService.AddItem:input_type -> cart.AddItemRequest
+	5,  // 7: cart.CartService.RemoveItem:input_type -> cart.RemoveItemRequest
+	7,  // 8: cart.CartService.UpdateItem:input_type -> cart.UpdateItemRequest
+	9,  // 9: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	11, // 10: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
+	4,  // 11: cart.CartService.AddItem:output_type -> cart.AddItemResponse
+	6,  // 12: cart.CartService.RemoveItem:output_type -> cart.RemoveItemResponse
+	8,  // 13: cart.CartService.UpdateItem:output_type -> cart.UpdateItemResponse
+	10, // 14: cart.CartService.GetCart:output_type -> cart.GetCartResponse
+	12, // 15: cart.CartService.ClearCart:output_type -> cart.ClearCartResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_internal_features_cart_delivery_grpc_proto_cart_proto_init() }
@@ -807,7 +866,7 @@ func file_internal_features_cart_delivery_grpc_proto_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDesc), len(file_internal_features_cart_delivery_grpc_proto_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
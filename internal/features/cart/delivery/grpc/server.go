@@ -9,6 +9,7 @@ import (
 
 	cartdomain "github.com/diki-haryadi/ecommerce-saga/internal/features/cart"
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type CartServer struct {
@@ -34,7 +35,7 @@ func toProtoCart(cart *cartdomain.Cart) *pb.Cart {
 			Id:        item.ID.String(),
 			ProductId: item.ProductID.String(),
 			Quantity:  item.Quantity,
-			Price:     item.Price,
+			Price:     toProtoMoney(item.Price),
 		}
 	}
 
@@ -42,7 +43,15 @@ func toProtoCart(cart *cartdomain.Cart) *pb.Cart {
 		Id:     cart.ID.String(),
 		UserId: cart.UserID.String(),
 		Items:  items,
-		Total:  cart.Total,
+		Total:  toProtoMoney(cart.Total),
+	}
+}
+
+// toProtoMoney converts an amount to a protobuf amount
+func toProtoMoney(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/dto/request"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/usecase"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
 	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
 )
@@ -61,6 +62,10 @@ func (h *CartHandler) AddItem(c *fiber.Ctx) error {
 			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
 		case usecase.ErrProductNotFound:
 			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		case usecase.ErrOutOfStock:
+			return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
+		case usecase.ErrCurrencyMismatch, usecase.ErrCurrencyUnavailable:
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
 		default:
 			return h.errorHandler.Handle(c, errors.NewInternalError(err))
 		}
@@ -90,6 +95,8 @@ func (h *CartHandler) UpdateItem(c *fiber.Ctx) error {
 			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
 		case usecase.ErrItemNotFound:
 			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		case usecase.ErrOutOfStock:
+			return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
 		default:
 			return h.errorHandler.Handle(c, errors.NewInternalError(err))
 		}
@@ -142,3 +149,79 @@ func (h *CartHandler) ClearCart(c *fiber.Ctx) error {
 
 	return httpresponse.OK(c, "Cart cleared successfully", nil)
 }
+
+// ApplyCoupon handles POST /cart/coupon request
+func (h *CartHandler) ApplyCoupon(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	var req request.ApplyCouponRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	resp, err := h.cartUsecase.ApplyCoupon(c.Context(), userID, &req)
+	if err != nil {
+		// The coupon does not apply to the cart
+		if _, ok := err.(*promotion.Error); ok {
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+		}
+		switch err {
+		case usecase.ErrCartNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		case usecase.ErrCartExpired, usecase.ErrNoCoupons:
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Coupon applied successfully", resp)
+}
+
+// SetCurrency handles PUT /cart/currency request
+func (h *CartHandler) SetCurrency(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	var req request.SetCurrencyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	resp, err := h.cartUsecase.SetCurrency(c.Context(), userID, &req)
+	if err != nil {
+		switch err {
+		case usecase.ErrCartExpired, usecase.ErrInvalidCurrency, usecase.ErrCurrencyUnavailable:
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Cart currency updated successfully", resp)
+}
+
+// RemoveCoupon handles DELETE /cart/coupon request
+func (h *CartHandler) RemoveCoupon(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	resp, err := h.cartUsecase.RemoveCoupon(c.Context(), userID)
+	if err != nil {
+		switch err {
+		case usecase.ErrCartNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Coupon removed successfully", resp)
+}
@@ -13,5 +13,8 @@ func RegisterRoutes(router fiber.Router, handler *CartHandler, authMiddleware fi
 	cart.Post("/items", handler.AddItem)
 	cart.Put("/items/:id", handler.UpdateItem)
 	cart.Delete("/items/:id", handler.RemoveItem)
+	cart.Post("/coupon", handler.ApplyCoupon)
+	cart.Delete("/coupon", handler.RemoveCoupon)
+	cart.Put("/currency", handler.SetCurrency)
 	cart.Delete("", handler.ClearCart)
 }
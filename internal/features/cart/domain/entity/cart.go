@@ -4,26 +4,33 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // CartItem represents an item in the cart
 type CartItem struct {
-	CartID    uuid.UUID `json:"cart_id" bson:"cart_id"`
-	ProductID uuid.UUID `json:"product_id" bson:"product_id"`
-	Name      string    `json:"name" bson:"name"`
-	Price     float64   `json:"price" bson:"price"`
-	Quantity  int       `json:"quantity" bson:"quantity"`
+	CartID      uuid.UUID   `json:"cart_id" bson:"cart_id"`
+	ProductID   uuid.UUID   `json:"product_id" bson:"product_id"`
+	Name        string      `json:"name" bson:"name"`
+	Price       money.Money `json:"price" bson:"price" gorm:"embedded;embeddedPrefix:price_"`
+	TaxCategory string      `json:"tax_category,omitempty" bson:"tax_category,omitempty" gorm:"type:varchar(50)"`
+	Quantity    int         `json:"quantity" bson:"quantity"`
 }
 
-// Cart represents a user's shopping cart
+// Cart represents a user's shopping cart. Items keep the price of the catalog;
+// Currency is the one the shopper chose to pay in, empty for the catalog's.
 type Cart struct {
-	ID        uuid.UUID  `json:"id" bson:"_id"`
-	UserID    uuid.UUID  `json:"user_id" bson:"user_id"`
-	Items     []CartItem `json:"items" bson:"items"`
-	Total     float64    `json:"total" bson:"total"`
-	ExpiresAt time.Time  `json:"expires_at" bson:"expires_at"`
-	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at" bson:"updated_at"`
+	ID         uuid.UUID      `json:"id" bson:"_id"`
+	UserID     uuid.UUID      `json:"user_id" bson:"user_id"`
+	Items      []CartItem     `json:"items" bson:"items"`
+	Total      money.Money    `json:"total" bson:"total" gorm:"embedded;embeddedPrefix:total_"`
+	Currency   money.Currency `json:"currency,omitempty" bson:"currency,omitempty" gorm:"type:varchar(3)"`
+	CouponCode string         `json:"coupon_code,omitempty" bson:"coupon_code,omitempty"`
+	ExpiresAt  time.Time      `json:"expires_at" bson:"expires_at"`
+	CreatedAt  time.Time      `json:"created_at" bson:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at" bson:"updated_at"`
 }
 
 // NewCart creates a new cart for a user
@@ -33,24 +40,34 @@ func NewCart(userID uuid.UUID, expiry time.Duration) *Cart {
 		ID:        uuid.New(),
 		UserID:    userID,
 		Items:     make([]CartItem, 0),
-		Total:     0,
 		ExpiresAt: now.Add(expiry),
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 }
 
-// AddItem adds a new item to the cart or updates its quantity if it already exists
-func (c *Cart) AddItem(item CartItem) {
+// Subtotal returns the price of the item times its quantity
+func (i CartItem) Subtotal() money.Money {
+	return i.Price.Mul(int64(i.Quantity))
+}
+
+// AddItem adds a new item to the cart or updates its quantity if it already
+// exists. All items of a cart are priced in the same currency.
+func (c *Cart) AddItem(item CartItem) error {
+	if len(c.Items) > 0 && item.Price.Currency != c.Items[0].Price.Currency {
+		return money.ErrCurrencyMismatch
+	}
+
 	for i, existingItem := range c.Items {
 		if existingItem.ProductID == item.ProductID {
 			c.Items[i].Quantity += item.Quantity
 			c.calculateTotal()
-			return
+			return nil
 		}
 	}
 	c.Items = append(c.Items, item)
 	c.calculateTotal()
+	return nil
 }
 
 // RemoveItem removes an item from the cart
@@ -84,7 +101,27 @@ func (c *Cart) UpdateItemQuantity(productID uuid.UUID, quantity int) bool {
 // Clear removes all items from the cart
 func (c *Cart) Clear() {
 	c.Items = make([]CartItem, 0)
-	c.Total = 0
+	c.Total = money.Money{}
+}
+
+// Convert returns a copy of the cart with its items priced at rate. Unit
+// prices are rounded before they are multiplied, as the order charges them.
+func (c *Cart) Convert(rate fx.Rate) (*Cart, error) {
+	converted := *c
+	converted.Items = make([]CartItem, len(c.Items))
+
+	var total money.Money
+	for i, item := range c.Items {
+		price, err := rate.Convert(item.Price, money.RoundHalfUp)
+		if err != nil {
+			return nil, err
+		}
+		item.Price = price
+		converted.Items[i] = item
+		total, _ = total.Add(item.Subtotal())
+	}
+	converted.Total = total
+	return &converted, nil
 }
 
 // IsExpired checks if the cart has expired
@@ -94,9 +131,10 @@ func (c *Cart) IsExpired() bool {
 
 // calculateTotal recalculates the total price of all items in the cart
 func (c *Cart) calculateTotal() {
-	var total float64
+	var total money.Money
 	for _, item := range c.Items {
-		total += item.Price * float64(item.Quantity)
+		// AddItem keeps the items in one currency
+		total, _ = total.Add(item.Subtotal())
 	}
 	c.Total = total
 	c.UpdatedAt = time.Now()
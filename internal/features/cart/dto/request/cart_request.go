@@ -18,3 +18,13 @@ type UpdateItemRequest struct {
 type RemoveItemRequest struct {
 	ProductID uuid.UUID `json:"product_id" validate:"required"`
 }
+
+// ApplyCouponRequest represents the request to apply a coupon to the cart
+type ApplyCouponRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// SetCurrencyRequest represents the request to set the currency of the cart
+type SetCurrencyRequest struct {
+	Currency string `json:"currency" validate:"required,len=3"`
+}
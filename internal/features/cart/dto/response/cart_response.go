@@ -6,26 +6,42 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // CartItemResponse represents a cart item in responses
 type CartItemResponse struct {
-	ProductID uuid.UUID `json:"product_id"`
-	Name      string    `json:"name"`
-	Price     float64   `json:"price"`
-	Quantity  int       `json:"quantity"`
-	Subtotal  float64   `json:"subtotal"`
+	ProductID uuid.UUID   `json:"product_id"`
+	Name      string      `json:"name"`
+	Price     money.Money `json:"price"`
+	Quantity  int         `json:"quantity"`
+	Subtotal  money.Money `json:"subtotal"`
 }
 
-// CartResponse represents a cart in responses
+// DiscountResponse represents a discount taken off the cart in responses
+type DiscountResponse struct {
+	PromotionID uuid.UUID   `json:"promotion_id"`
+	Code        string      `json:"code"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+}
+
+// CartResponse represents a cart in responses, priced in Currency. Total is
+// the Subtotal of the items less the Discounts; CouponError tells why the
+// applied coupon no longer discounts the cart.
 type CartResponse struct {
-	ID        uuid.UUID          `json:"id"`
-	UserID    uuid.UUID          `json:"user_id"`
-	Items     []CartItemResponse `json:"items"`
-	Total     float64            `json:"total"`
-	ExpiresAt time.Time          `json:"expires_at"`
-	CreatedAt time.Time          `json:"created_at"`
-	UpdatedAt time.Time          `json:"updated_at"`
+	ID          uuid.UUID          `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	Currency    money.Currency     `json:"currency,omitempty"`
+	Items       []CartItemResponse `json:"items"`
+	Subtotal    money.Money        `json:"subtotal"`
+	CouponCode  string             `json:"coupon_code,omitempty"`
+	CouponError string             `json:"coupon_error,omitempty"`
+	Discounts   []DiscountResponse `json:"discounts"`
+	Total       money.Money        `json:"total"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
 }
 
 // NewCartResponse creates a new cart response from a cart entity
@@ -37,18 +53,27 @@ func NewCartResponse(cart *entity.Cart) *CartResponse {
 			Name:      item.Name,
 			Price:     item.Price,
 			Quantity:  item.Quantity,
-			Subtotal:  item.Price * float64(item.Quantity),
+			Subtotal:  item.Subtotal(),
 		}
 	}
 
+	currency := cart.Currency
+	if currency == "" {
+		currency = cart.Total.Currency
+	}
+
 	return &CartResponse{
-		ID:        cart.ID,
-		UserID:    cart.UserID,
-		Items:     items,
-		Total:     cart.Total,
-		ExpiresAt: cart.ExpiresAt,
-		CreatedAt: cart.CreatedAt,
-		UpdatedAt: cart.UpdatedAt,
+		ID:         cart.ID,
+		UserID:     cart.UserID,
+		Currency:   currency,
+		Items:      items,
+		Subtotal:   cart.Total,
+		CouponCode: cart.CouponCode,
+		Discounts:  make([]DiscountResponse, 0),
+		Total:      cart.Total,
+		ExpiresAt:  cart.ExpiresAt,
+		CreatedAt:  cart.CreatedAt,
+		UpdatedAt:  cart.UpdatedAt,
 	}
 }
 
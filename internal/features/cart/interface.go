@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // Usecase defines the cart business logic interface
@@ -17,18 +19,18 @@ type Usecase interface {
 
 // Cart represents a shopping cart
 type Cart struct {
-	ID     uuid.UUID  `json:"id"`
-	UserID uuid.UUID  `json:"user_id"`
-	Items  []CartItem `json:"items"`
-	Total  float64    `json:"total"`
+	ID     uuid.UUID   `json:"id"`
+	UserID uuid.UUID   `json:"user_id"`
+	Items  []CartItem  `json:"items"`
+	Total  money.Money `json:"total"`
 }
 
 // CartItem represents an item in a shopping cart
 type CartItem struct {
-	ID        uuid.UUID `json:"id"`
-	ProductID uuid.UUID `json:"product_id"`
-	Quantity  int32     `json:"quantity"`
-	Price     float64   `json:"price"`
+	ID        uuid.UUID   `json:"id"`
+	ProductID uuid.UUID   `json:"product_id"`
+	Quantity  int32       `json:"quantity"`
+	Price     money.Money `json:"price"`
 }
 
 // Common errors
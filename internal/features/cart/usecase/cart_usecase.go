@@ -11,14 +11,23 @@ import (
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/dto/request"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/dto/response"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/repository"
+	inventory "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 var (
-	ErrCartNotFound    = errors.New("cart not found")
-	ErrItemNotFound    = errors.New("item not found in cart")
-	ErrCartExpired     = errors.New("cart has expired")
-	ErrProductNotFound = errors.New("product not found")
-	ErrInvalidQuantity = errors.New("invalid quantity")
+	ErrCartNotFound        = errors.New("cart not found")
+	ErrItemNotFound        = errors.New("item not found in cart")
+	ErrCartExpired         = errors.New("cart has expired")
+	ErrProductNotFound     = errors.New("product not found")
+	ErrInvalidQuantity     = errors.New("invalid quantity")
+	ErrOutOfStock          = errors.New("product out of stock")
+	ErrNoCoupons           = errors.New("coupons are not available")
+	ErrCurrencyMismatch    = errors.New("product is priced in another currency than the cart")
+	ErrInvalidCurrency     = errors.New("invalid currency")
+	ErrCurrencyUnavailable = errors.New("currency is not available")
 )
 
 type ProductService interface {
@@ -26,23 +35,50 @@ type ProductService interface {
 }
 
 type Product struct {
-	ID    uuid.UUID
-	Name  string
-	Price float64
-	Stock int
+	ID          uuid.UUID
+	Name        string
+	Price       money.Money
+	TaxCategory string
+	Stock       int
+}
+
+// StockReserver holds stock for the items of a cart, so that it is still there
+// at checkout. The order's inventory step turns the holds into its reservation.
+type StockReserver interface {
+	HoldStock(ctx context.Context, cartID, userID, productID uuid.UUID, quantity int, expiresAt time.Time) error
+	ReleaseHold(ctx context.Context, cartID, productID uuid.UUID) error
+	ReleaseHolds(ctx context.Context, cartID uuid.UUID) error
+	ExtendHolds(ctx context.Context, cartID uuid.UUID, expiresAt time.Time) error
+}
+
+// Pricer prices cart items with a coupon applied
+type Pricer interface {
+	PriceItems(ctx context.Context, userID uuid.UUID, code string, items []promotion.LineItem) (*promotion.Breakdown, error)
 }
 
 type CartUsecase struct {
 	cartRepo       repository.CartRepository
 	productService ProductService
 	cartExpiry     time.Duration
+	stockReserver  StockReserver
+	holdTTL        time.Duration
+	pricer         Pricer
+	rates          fx.Provider
 }
 
-func NewCartUsecase(cartRepo repository.CartRepository, productService ProductService, cartExpiry time.Duration) *CartUsecase {
+// NewCartUsecase creates a new cart usecase. Items are held in stock for
+// holdTTL after the last cart activity when stockReserver is set, and
+// coupons can be applied when pricer is set. Carts are priced in the
+// shopper's currency at the rates of rates.
+func NewCartUsecase(cartRepo repository.CartRepository, productService ProductService, cartExpiry time.Duration, stockReserver StockReserver, holdTTL time.Duration, pricer Pricer, rates fx.Provider) *CartUsecase {
 	return &CartUsecase{
 		cartRepo:       cartRepo,
 		productService: productService,
 		cartExpiry:     cartExpiry,
+		stockReserver:  stockReserver,
+		holdTTL:        holdTTL,
+		pricer:         pricer,
+		rates:          rates,
 	}
 }
 
@@ -59,9 +95,11 @@ func (u *CartUsecase) GetCart(ctx context.Context, userID uuid.UUID) (*response.
 		}
 	} else if cart.IsExpired() {
 		return nil, ErrCartExpired
+	} else if err := u.extendHolds(ctx, cart); err != nil {
+		return nil, err
 	}
 
-	return response.NewCartResponse(cart), nil
+	return u.cartResponse(ctx, cart)
 }
 
 func (u *CartUsecase) AddItem(ctx context.Context, userID uuid.UUID, req *request.AddItemRequest) (*response.CartResponse, error) {
@@ -83,19 +121,34 @@ func (u *CartUsecase) AddItem(ctx context.Context, userID uuid.UUID, req *reques
 	}
 
 	// Add item to cart
-	cart.AddItem(entity.CartItem{
-		ProductID: product.ID,
-		Name:      product.Name,
-		Price:     product.Price,
-		Quantity:  req.Quantity,
-	})
+	if err := cart.AddItem(entity.CartItem{
+		ProductID:   product.ID,
+		Name:        product.Name,
+		Price:       product.Price,
+		TaxCategory: product.TaxCategory,
+		Quantity:    req.Quantity,
+	}); err != nil {
+		if errors.Is(err, money.ErrCurrencyMismatch) {
+			return nil, ErrCurrencyMismatch
+		}
+		return nil, err
+	}
+
+	// Hold the new quantity before the cart shows it
+	if err := u.holdItem(ctx, cart, product.ID); err != nil {
+		return nil, err
+	}
 
 	// Save cart
 	if err := u.cartRepo.Update(ctx, cart); err != nil {
 		return nil, err
 	}
 
-	return response.NewCartResponse(cart), nil
+	if err := u.extendHolds(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return u.cartResponse(ctx, cart)
 }
 
 func (u *CartUsecase) UpdateItem(ctx context.Context, userID uuid.UUID, req *request.UpdateItemRequest) (*response.CartResponse, error) {
@@ -118,12 +171,20 @@ func (u *CartUsecase) UpdateItem(ctx context.Context, userID uuid.UUID, req *req
 		return nil, ErrItemNotFound
 	}
 
+	if err := u.holdItem(ctx, cart, req.ProductID); err != nil {
+		return nil, err
+	}
+
 	// Save cart
 	if err := u.cartRepo.Update(ctx, cart); err != nil {
 		return nil, err
 	}
 
-	return response.NewCartResponse(cart), nil
+	if err := u.extendHolds(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return u.cartResponse(ctx, cart)
 }
 
 func (u *CartUsecase) RemoveItem(ctx context.Context, userID uuid.UUID, req *request.RemoveItemRequest) (*response.CartResponse, error) {
@@ -149,7 +210,15 @@ func (u *CartUsecase) RemoveItem(ctx context.Context, userID uuid.UUID, req *req
 		return nil, err
 	}
 
-	return response.NewCartResponse(cart), nil
+	// Give the stock held for the item back
+	if err := u.holdItem(ctx, cart, req.ProductID); err != nil {
+		return nil, err
+	}
+	if err := u.extendHolds(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return u.cartResponse(ctx, cart)
 }
 
 func (u *CartUsecase) ClearCart(ctx context.Context, userID uuid.UUID) error {
@@ -162,10 +231,178 @@ func (u *CartUsecase) ClearCart(ctx context.Context, userID uuid.UUID) error {
 		return nil
 	}
 
+	// Give the stock held for the cart back
+	if u.stockReserver != nil {
+		if err := u.stockReserver.ReleaseHolds(ctx, cart.ID); err != nil {
+			return err
+		}
+	}
+
 	// Delete cart
 	return u.cartRepo.Delete(ctx, cart.ID)
 }
 
+// ApplyCoupon applies a coupon code to the cart. The code is checked against
+// the cart's items now and priced again on every read and at checkout.
+func (u *CartUsecase) ApplyCoupon(ctx context.Context, userID uuid.UUID, req *request.ApplyCouponRequest) (*response.CartResponse, error) {
+	if u.pricer == nil {
+		return nil, ErrNoCoupons
+	}
+
+	// Get cart
+	cart, err := u.cartRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil {
+		return nil, ErrCartNotFound
+	}
+
+	// Check if cart is expired
+	if cart.IsExpired() {
+		return nil, ErrCartExpired
+	}
+
+	priced, err := u.convertCart(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+	breakdown, err := u.pricer.PriceItems(ctx, userID, req.Code, lineItems(priced))
+	if err != nil {
+		return nil, err
+	}
+	if len(breakdown.Discounts) == 0 {
+		return nil, promotion.ErrCouponNotApplicable
+	}
+
+	// Save the code as the promotion spells it
+	cart.CouponCode = breakdown.Discounts[0].Code
+	cart.UpdatedAt = time.Now()
+	if err := u.cartRepo.Update(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return u.cartResponse(ctx, cart)
+}
+
+// RemoveCoupon removes the coupon from the cart
+func (u *CartUsecase) RemoveCoupon(ctx context.Context, userID uuid.UUID) (*response.CartResponse, error) {
+	// Get cart
+	cart, err := u.cartRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil {
+		return nil, ErrCartNotFound
+	}
+
+	cart.CouponCode = ""
+	cart.UpdatedAt = time.Now()
+	if err := u.cartRepo.Update(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return u.cartResponse(ctx, cart)
+}
+
+// SetCurrency sets the currency the cart is priced and paid in
+func (u *CartUsecase) SetCurrency(ctx context.Context, userID uuid.UUID, req *request.SetCurrencyRequest) (*response.CartResponse, error) {
+	currency, err := money.ParseCurrency(req.Currency)
+	if err != nil {
+		return nil, ErrInvalidCurrency
+	}
+
+	cart, err := u.getOrCreateCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if cart is expired
+	if cart.IsExpired() {
+		return nil, ErrCartExpired
+	}
+
+	cart.Currency = currency
+	cart.UpdatedAt = time.Now()
+
+	// Refuse a currency there is no rate for before saving it
+	if _, err := u.convertCart(ctx, cart); err != nil {
+		return nil, err
+	}
+	if err := u.cartRepo.Update(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	return u.cartResponse(ctx, cart)
+}
+
+// convertCart returns the cart priced in its currency
+func (u *CartUsecase) convertCart(ctx context.Context, cart *entity.Cart) (*entity.Cart, error) {
+	if cart.Currency == "" || len(cart.Items) == 0 {
+		return cart, nil
+	}
+
+	rate, err := fx.Lookup(ctx, u.rates, cart.Items[0].Price.Currency, cart.Currency)
+	if errors.Is(err, fx.ErrRateNotFound) {
+		return nil, ErrCurrencyUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cart.Convert(rate)
+}
+
+// cartResponse builds the cart response in the cart's currency with the
+// discounts of the cart's coupon. A coupon that no longer applies stays on
+// the cart, so it is reported instead of failing the request.
+func (u *CartUsecase) cartResponse(ctx context.Context, cart *entity.Cart) (*response.CartResponse, error) {
+	cart, err := u.convertCart(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.NewCartResponse(cart)
+	if u.pricer == nil || cart.CouponCode == "" {
+		return resp, nil
+	}
+
+	breakdown, err := u.pricer.PriceItems(ctx, cart.UserID, cart.CouponCode, lineItems(cart))
+	if err != nil {
+		var couponErr *promotion.Error
+		if errors.As(err, &couponErr) {
+			resp.CouponError = couponErr.Error()
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	for _, discount := range breakdown.Discounts {
+		resp.Discounts = append(resp.Discounts, response.DiscountResponse{
+			PromotionID: discount.PromotionID,
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      discount.Amount,
+		})
+	}
+	resp.Subtotal = breakdown.Subtotal
+	resp.Total = breakdown.Total
+
+	return resp, nil
+}
+
+// lineItems returns the items of the cart to price
+func lineItems(cart *entity.Cart) []promotion.LineItem {
+	items := make([]promotion.LineItem, len(cart.Items))
+	for i, item := range cart.Items {
+		items[i] = promotion.LineItem{
+			ProductID: item.ProductID,
+			Price:     item.Price,
+			Quantity:  item.Quantity,
+		}
+	}
+	return items
+}
+
 func (u *CartUsecase) getOrCreateCart(ctx context.Context, userID uuid.UUID) (*entity.Cart, error) {
 	cart, err := u.cartRepo.GetByUserID(ctx, userID)
 	if err != nil {
@@ -181,3 +418,45 @@ func (u *CartUsecase) getOrCreateCart(ctx context.Context, userID uuid.UUID) (*e
 
 	return cart, nil
 }
+
+// holdItem holds the stock of the cart's quantity of a product, or gives it
+// back if the product is no longer in the cart
+func (u *CartUsecase) holdItem(ctx context.Context, cart *entity.Cart, productID uuid.UUID) error {
+	if u.stockReserver == nil {
+		return nil
+	}
+
+	quantity := 0
+	for _, item := range cart.Items {
+		if item.ProductID == productID {
+			quantity = item.Quantity
+		}
+	}
+	if quantity <= 0 {
+		return u.stockReserver.ReleaseHold(ctx, cart.ID, productID)
+	}
+
+	err := u.stockReserver.HoldStock(ctx, cart.ID, cart.UserID, productID, quantity, u.holdExpiry(cart))
+	if errors.Is(err, inventory.ErrInsufficientStock) {
+		return ErrOutOfStock
+	}
+	return err
+}
+
+// extendHolds keeps the stock held for the cart on cart activity
+func (u *CartUsecase) extendHolds(ctx context.Context, cart *entity.Cart) error {
+	if u.stockReserver == nil {
+		return nil
+	}
+	return u.stockReserver.ExtendHolds(ctx, cart.ID, u.holdExpiry(cart))
+}
+
+// holdExpiry returns when the holds of the cart lapse: holdTTL from now, but
+// never after the cart itself expires
+func (u *CartUsecase) holdExpiry(cart *entity.Cart) time.Time {
+	expiresAt := time.Now().Add(u.holdTTL)
+	if cart.ExpiresAt.Before(expiresAt) {
+		return cart.ExpiresAt
+	}
+	return expiresAt
+}
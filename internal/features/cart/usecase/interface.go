@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // CartItem represents a single item in the cart
@@ -14,8 +15,8 @@ type CartItem struct {
 	ID        uuid.UUID
 	ProductID uuid.UUID
 	Quantity  int32
-	Price     float64
-	Subtotal  float64
+	Price     money.Money
+	Subtotal  money.Money
 }
 
 // Cart represents a shopping cart
@@ -23,7 +24,7 @@ type Cart struct {
 	ID        uuid.UUID
 	UserID    uuid.UUID
 	Items     []*CartItem
-	Total     float64
+	Total     money.Money
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/dto/request"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
+	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+)
+
+type FulfillmentHandler struct {
+	fulfillmentUsecase usecase.Usecase
+	errorHandler       errors.ErrorHandler
+}
+
+func NewFulfillmentHandler(fulfillmentUsecase usecase.Usecase) *FulfillmentHandler {
+	return &FulfillmentHandler{
+		fulfillmentUsecase: fulfillmentUsecase,
+		errorHandler:       errors.NewErrorHandler(),
+	}
+}
+
+// CreateShipment handles POST /fulfillment/orders/:id/shipments request
+func (h *FulfillmentHandler) CreateShipment(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order ID"))
+	}
+
+	var req request.CreateShipmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	items := make([]usecase.ShipmentItem, len(req.Items))
+	for i, item := range req.Items {
+		orderItemID, err := uuid.Parse(item.OrderItemID)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order item ID"))
+		}
+		items[i] = usecase.ShipmentItem{
+			OrderItemID: orderItemID,
+			Quantity:    item.Quantity,
+		}
+	}
+
+	resp, err := h.fulfillmentUsecase.CreateShipment(c.Context(), orderID, usecase.ShipmentInput{
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		Items:          items,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrOrderNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		case usecase.ErrOrderNotShippable, usecase.ErrNothingToShip:
+			return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
+		case usecase.ErrInvalidCarrier, usecase.ErrInvalidItem, usecase.ErrInvalidQuantity:
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.Created(c, "Shipment created successfully", resp)
+}
+
+// RecordEvent handles POST /fulfillment/shipments/:id/events request
+func (h *FulfillmentHandler) RecordEvent(c *fiber.Ctx) error {
+	shipmentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid shipment ID"))
+	}
+
+	var req request.ShipmentEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	resp, err := h.fulfillmentUsecase.RecordEvent(c.Context(), shipmentID, usecase.EventInput{
+		Status:      req.Status,
+		Location:    req.Location,
+		Description: req.Description,
+		OccurredAt:  req.OccurredAt,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrNotFound, usecase.ErrOrderNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		case usecase.ErrInvalidStatus:
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+		case usecase.ErrStatusTransition:
+			return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Shipment event recorded successfully", resp)
+}
+
+// GetShipment handles GET /fulfillment/shipments/:id request
+func (h *FulfillmentHandler) GetShipment(c *fiber.Ctx) error {
+	shipmentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid shipment ID"))
+	}
+
+	resp, err := h.fulfillmentUsecase.GetShipment(c.Context(), shipmentID)
+	if err != nil {
+		switch err {
+		case usecase.ErrNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Shipment retrieved successfully", resp)
+}
+
+// ListShipments handles GET /fulfillment/orders/:id/shipments request
+func (h *FulfillmentHandler) ListShipments(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order ID"))
+	}
+
+	resp, err := h.fulfillmentUsecase.ListShipments(c.Context(), orderID)
+	if err != nil {
+		switch err {
+		case usecase.ErrOrderNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Shipments retrieved successfully", resp)
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRoutes registers all fulfillment routes. Shipments are created and
+// their carrier events recorded by admins only, since the events move orders
+// to SHIPPED and DELIVERED.
+func RegisterRoutes(router fiber.Router, handler *FulfillmentHandler, adminMiddleware fiber.Handler) {
+	fulfillment := router.Group("/fulfillment")
+
+	fulfillment.Get("/orders/:id/shipments", handler.ListShipments)
+	fulfillment.Post("/orders/:id/shipments", adminMiddleware, handler.CreateShipment)
+	fulfillment.Get("/shipments/:id", handler.GetShipment)
+	fulfillment.Post("/shipments/:id/events", adminMiddleware, handler.RecordEvent)
+}
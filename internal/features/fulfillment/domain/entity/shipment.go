@@ -0,0 +1,154 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShipmentStatus represents the status of a shipment
+type ShipmentStatus string
+
+const (
+	// ShipmentStatusPending is a shipment packed but not yet handed to the carrier
+	ShipmentStatusPending ShipmentStatus = "PENDING"
+	// ShipmentStatusShipped is a shipment picked up by the carrier
+	ShipmentStatusShipped ShipmentStatus = "SHIPPED"
+	// ShipmentStatusInTransit is a shipment scanned on its way
+	ShipmentStatusInTransit ShipmentStatus = "IN_TRANSIT"
+	// ShipmentStatusDelivered is a shipment handed to the recipient
+	ShipmentStatusDelivered ShipmentStatus = "DELIVERED"
+)
+
+// progress orders the statuses a shipment goes through
+var progress = map[ShipmentStatus]int{
+	ShipmentStatusPending:   0,
+	ShipmentStatusShipped:   1,
+	ShipmentStatusInTransit: 2,
+	ShipmentStatusDelivered: 3,
+}
+
+// IsValid checks if the status is a known shipment status
+func (s ShipmentStatus) IsValid() bool {
+	_, ok := progress[s]
+	return ok
+}
+
+// ShipmentItem is a quantity of an order item sent in a shipment
+type ShipmentItem struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ShipmentID  uuid.UUID `json:"shipment_id" gorm:"type:uuid;not null"`
+	OrderItemID uuid.UUID `json:"order_item_id" gorm:"type:uuid;not null"`
+	Quantity    int       `json:"quantity" gorm:"not null"`
+}
+
+// ShipmentEvent is a tracking update of a shipment
+type ShipmentEvent struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ShipmentID  uuid.UUID      `json:"shipment_id" gorm:"type:uuid;not null"`
+	Status      ShipmentStatus `json:"status" gorm:"type:varchar(50);not null"`
+	Location    string         `json:"location"`
+	Description string         `json:"description"`
+	OccurredAt  time.Time      `json:"occurred_at" gorm:"not null"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// Shipment is a parcel sent for an order. An order may be sent in several
+// shipments, each with some of its items.
+type Shipment struct {
+	ID             uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID        uuid.UUID       `json:"order_id" gorm:"type:uuid;not null"`
+	Carrier        string          `json:"carrier" gorm:"type:varchar(100);not null"`
+	TrackingNumber string          `json:"tracking_number" gorm:"type:varchar(100);not null"`
+	Status         ShipmentStatus  `json:"status" gorm:"type:varchar(50);not null"`
+	Items          []ShipmentItem  `json:"items" gorm:"foreignKey:ShipmentID"`
+	Events         []ShipmentEvent `json:"events" gorm:"foreignKey:ShipmentID"`
+	ShippedAt      *time.Time      `json:"shipped_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// NewShipment creates a new pending shipment of items of an order
+func NewShipment(orderID uuid.UUID, carrier, trackingNumber string, items []ShipmentItem) *Shipment {
+	shipment := &Shipment{
+		ID:             uuid.New(),
+		OrderID:        orderID,
+		Carrier:        carrier,
+		TrackingNumber: trackingNumber,
+		Status:         ShipmentStatusPending,
+		Items:          items,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	for i := range shipment.Items {
+		shipment.Items[i].ID = uuid.New()
+		shipment.Items[i].ShipmentID = shipment.ID
+	}
+	return shipment
+}
+
+// IsShipped checks if the shipment has left
+func (s *Shipment) IsShipped() bool {
+	return progress[s.Status] >= progress[ShipmentStatusShipped]
+}
+
+// CanTransitionTo checks if the shipment can move to the given status.
+// Shipments only move forward, though carriers may skip a status, and may
+// report being in transit more than once.
+func (s *Shipment) CanTransitionTo(status ShipmentStatus) bool {
+	if !status.IsValid() {
+		return false
+	}
+	if s.Status == ShipmentStatusInTransit && status == ShipmentStatusInTransit {
+		return true
+	}
+	return progress[status] > progress[s.Status]
+}
+
+// Apply records the event and moves the shipment to its status
+func (s *Shipment) Apply(event ShipmentEvent) {
+	event.ShipmentID = s.ID
+	s.Events = append(s.Events, event)
+	s.Status = event.Status
+
+	occurredAt := event.OccurredAt
+	if s.IsShipped() && s.ShippedAt == nil {
+		s.ShippedAt = &occurredAt
+	}
+	if s.Status == ShipmentStatusDelivered {
+		s.DeliveredAt = &occurredAt
+	}
+	s.UpdatedAt = time.Now()
+}
+
+// Remaining returns the quantity of every ordered item that no shipment
+// holds yet. It is negative for an item shipped more times than ordered.
+func Remaining(ordered map[uuid.UUID]int, shipments []*Shipment) map[uuid.UUID]int {
+	remaining := make(map[uuid.UUID]int, len(ordered))
+	for itemID, quantity := range ordered {
+		remaining[itemID] = quantity
+	}
+	for _, shipment := range shipments {
+		for _, item := range shipment.Items {
+			remaining[item.OrderItemID] -= item.Quantity
+		}
+	}
+	return remaining
+}
+
+// IsDelivered checks if every ordered item has arrived
+func IsDelivered(ordered map[uuid.UUID]int, shipments []*Shipment) bool {
+	var delivered []*Shipment
+	for _, shipment := range shipments {
+		if shipment.Status == ShipmentStatusDelivered {
+			delivered = append(delivered, shipment)
+		}
+	}
+	for _, quantity := range Remaining(ordered, delivered) {
+		if quantity > 0 {
+			return false
+		}
+	}
+	return true
+}
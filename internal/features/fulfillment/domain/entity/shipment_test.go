@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipment_Apply(t *testing.T) {
+	shipment := NewShipment(uuid.New(), "UPS", "1Z999", nil)
+	assert.False(t, shipment.IsShipped())
+	assert.False(t, shipment.CanTransitionTo(ShipmentStatusPending))
+	assert.False(t, shipment.CanTransitionTo("LOST"))
+
+	// Carriers may skip straight to in transit
+	pickedUp := time.Now().Add(-time.Hour)
+	assert.True(t, shipment.CanTransitionTo(ShipmentStatusInTransit))
+	shipment.Apply(ShipmentEvent{Status: ShipmentStatusInTransit, OccurredAt: pickedUp})
+	assert.True(t, shipment.IsShipped())
+	assert.Equal(t, pickedUp, *shipment.ShippedAt)
+
+	assert.True(t, shipment.CanTransitionTo(ShipmentStatusInTransit))
+	assert.False(t, shipment.CanTransitionTo(ShipmentStatusShipped))
+
+	delivered := time.Now()
+	shipment.Apply(ShipmentEvent{Status: ShipmentStatusDelivered, OccurredAt: delivered})
+	assert.Equal(t, pickedUp, *shipment.ShippedAt)
+	assert.Equal(t, delivered, *shipment.DeliveredAt)
+	assert.Len(t, shipment.Events, 2)
+	assert.False(t, shipment.CanTransitionTo(ShipmentStatusDelivered))
+}
+
+func TestRemaining(t *testing.T) {
+	mug, tee := uuid.New(), uuid.New()
+	ordered := map[uuid.UUID]int{mug: 3, tee: 1}
+
+	first := NewShipment(uuid.New(), "UPS", "1", []ShipmentItem{{OrderItemID: mug, Quantity: 2}})
+	second := NewShipment(uuid.New(), "UPS", "2", []ShipmentItem{{OrderItemID: mug, Quantity: 1}, {OrderItemID: tee, Quantity: 1}})
+
+	assert.Equal(t, map[uuid.UUID]int{mug: 1, tee: 1}, Remaining(ordered, []*Shipment{first}))
+	assert.Equal(t, map[uuid.UUID]int{mug: 0, tee: 0}, Remaining(ordered, []*Shipment{first, second}))
+
+	// Delivered once every item has arrived, whichever the shipment
+	first.Apply(ShipmentEvent{Status: ShipmentStatusDelivered, OccurredAt: time.Now()})
+	assert.False(t, IsDelivered(ordered, []*Shipment{first, second}))
+	second.Apply(ShipmentEvent{Status: ShipmentStatusDelivered, OccurredAt: time.Now()})
+	assert.True(t, IsDelivered(ordered, []*Shipment{first, second}))
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/entity"
+)
+
+var (
+	ErrShipmentNotFound = errors.New("shipment not found")
+	ErrOverShipped      = errors.New("shipments exceed the ordered quantity")
+	ErrStatusChanged    = errors.New("shipment status changed")
+)
+
+// ShipmentRepository defines the interface for shipment persistence
+type ShipmentRepository interface {
+	// Create saves a new shipment, failing with ErrOverShipped if the
+	// shipments of its order would then hold more of an item than ordered,
+	// the quantity of every order item
+	Create(ctx context.Context, shipment *entity.Shipment, ordered map[uuid.UUID]int) error
+
+	// GetByID retrieves a shipment with its items and events
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Shipment, error)
+
+	// ListByOrderID retrieves the shipments of an order with their items and
+	// events, oldest first
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entity.Shipment, error)
+
+	// AddEvent saves the last event of the shipment with its new status,
+	// failing with ErrStatusChanged if the shipment was no longer in status
+	// from
+	AddEvent(ctx context.Context, shipment *entity.Shipment, from entity.ShipmentStatus) error
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Usecase defines the fulfillment business logic interface
+type Usecase interface {
+	CreateShipment(ctx context.Context, orderID uuid.UUID, input ShipmentInput) (*ShipmentResponse, error)
+	RecordEvent(ctx context.Context, shipmentID uuid.UUID, input EventInput) (*ShipmentResponse, error)
+	GetShipment(ctx context.Context, shipmentID uuid.UUID) (*ShipmentResponse, error)
+	ListShipments(ctx context.Context, orderID uuid.UUID) ([]*ShipmentResponse, error)
+}
+
+// ShipmentInput describes a parcel handed to a carrier. A shipment without
+// items holds everything of the order not shipped yet.
+type ShipmentInput struct {
+	Carrier        string
+	TrackingNumber string
+	Items          []ShipmentItem
+}
+
+// EventInput is a tracking update of a shipment. OccurredAt defaults to now.
+type EventInput struct {
+	Status      string
+	Location    string
+	Description string
+	OccurredAt  *time.Time
+}
+
+type ShipmentItem struct {
+	OrderItemID uuid.UUID `json:"order_item_id"`
+	Quantity    int       `json:"quantity"`
+}
+
+type ShipmentEvent struct {
+	Status      string    `json:"status"`
+	Location    string    `json:"location,omitempty"`
+	Description string    `json:"description,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+type ShipmentResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	OrderID        uuid.UUID       `json:"order_id"`
+	Carrier        string          `json:"carrier"`
+	TrackingNumber string          `json:"tracking_number"`
+	Status         string          `json:"status"`
+	Items          []ShipmentItem  `json:"items"`
+	Events         []ShipmentEvent `json:"events"`
+	ShippedAt      *time.Time      `json:"shipped_at,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Common errors
+var (
+	ErrNotFound          = NewError("shipment not found")
+	ErrOrderNotFound     = NewError("order not found")
+	ErrOrderNotShippable = NewError("order is not ready to ship")
+	ErrInvalidCarrier    = NewError("carrier and tracking number are required")
+	ErrInvalidItem       = NewError("item is not part of the order")
+	ErrInvalidQuantity   = NewError("quantity exceeds what is left to ship")
+	ErrNothingToShip     = NewError("every item of the order is already shipped")
+	ErrInvalidStatus     = NewError("invalid shipment status")
+	ErrStatusTransition  = NewError("invalid shipment status transition")
+)
+
+// Error represents a fulfillment error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new fulfillment error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
@@ -0,0 +1,25 @@
+package request
+
+import "time"
+
+// CreateShipmentRequest represents the request to ship items of an order. A
+// shipment without items holds everything not shipped yet.
+type CreateShipmentRequest struct {
+	Carrier        string                `json:"carrier" validate:"required"`
+	TrackingNumber string                `json:"tracking_number" validate:"required"`
+	Items          []ShipmentItemRequest `json:"items" validate:"dive"`
+}
+
+// ShipmentItemRequest represents a quantity of an order item to ship
+type ShipmentItemRequest struct {
+	OrderItemID string `json:"order_item_id" validate:"required,uuid"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+}
+
+// ShipmentEventRequest represents a tracking update of a shipment
+type ShipmentEventRequest struct {
+	Status      string     `json:"status" validate:"required,oneof=SHIPPED IN_TRANSIT DELIVERED"`
+	Location    string     `json:"location"`
+	Description string     `json:"description"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/repository"
+)
+
+// ShipmentRepository implements the repository.ShipmentRepository interface
+type ShipmentRepository struct {
+	db *gorm.DB
+}
+
+// NewShipmentRepository creates a new PostgreSQL shipment repository
+func NewShipmentRepository(db *gorm.DB) repository.ShipmentRepository {
+	return &ShipmentRepository{
+		db: db,
+	}
+}
+
+// Create saves a new shipment within the ordered quantities
+func (r *ShipmentRepository) Create(ctx context.Context, shipment *entity.Shipment, ordered map[uuid.UUID]int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize shipments of the same order so that two of them never
+		// take the same units
+		if err := lockOrder(tx, shipment.OrderID); err != nil {
+			return err
+		}
+
+		existing, err := listByOrderID(tx, shipment.OrderID)
+		if err != nil {
+			return err
+		}
+		for _, quantity := range entity.Remaining(ordered, append(existing, shipment)) {
+			if quantity < 0 {
+				return repository.ErrOverShipped
+			}
+		}
+
+		return tx.Create(shipment).Error
+	})
+}
+
+// GetByID retrieves a shipment with its items and events
+func (r *ShipmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Shipment, error) {
+	var shipment entity.Shipment
+	err := preload(r.db.WithContext(ctx)).First(&shipment, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrShipmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+// ListByOrderID retrieves the shipments of an order, oldest first
+func (r *ShipmentRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entity.Shipment, error) {
+	return listByOrderID(r.db.WithContext(ctx), orderID)
+}
+
+// AddEvent saves the last event of the shipment with its new status
+func (r *ShipmentRepository) AddEvent(ctx context.Context, shipment *entity.Shipment, from entity.ShipmentStatus) error {
+	if len(shipment.Events) == 0 {
+		return nil
+	}
+	event := shipment.Events[len(shipment.Events)-1]
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Shipment{}).
+			Where("id = ? AND status = ?", shipment.ID, from).
+			Updates(map[string]interface{}{
+				"status":       shipment.Status,
+				"shipped_at":   shipment.ShippedAt,
+				"delivered_at": shipment.DeliveredAt,
+				"updated_at":   shipment.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrStatusChanged
+		}
+		return tx.Create(&event).Error
+	})
+}
+
+func preload(tx *gorm.DB) *gorm.DB {
+	return tx.
+		Preload("Items").
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("occurred_at ASC, created_at ASC")
+		})
+}
+
+func listByOrderID(tx *gorm.DB, orderID uuid.UUID) ([]*entity.Shipment, error) {
+	var shipments []*entity.Shipment
+	err := preload(tx).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&shipments).Error
+	if err != nil {
+		return nil, err
+	}
+	return shipments, nil
+}
+
+func lockOrder(tx *gorm.DB, orderID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "shipments:"+orderID.String()).Error
+}
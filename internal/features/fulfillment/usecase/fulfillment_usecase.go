@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/fulfillment/domain/usecase"
+	orderEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/statemachine"
+)
+
+type FulfillmentUsecase struct {
+	shipmentRepo repository.ShipmentRepository
+	orderRepo    orderRepo.OrderRepository
+	states       *statemachine.OrderStateMachine
+}
+
+// NewFulfillmentUsecase creates a new fulfillment usecase. Orders move to
+// SHIPPED and DELIVERED as their shipments do.
+func NewFulfillmentUsecase(shipmentRepo repository.ShipmentRepository, orderRepo orderRepo.OrderRepository) *FulfillmentUsecase {
+	return &FulfillmentUsecase{
+		shipmentRepo: shipmentRepo,
+		orderRepo:    orderRepo,
+		states:       statemachine.NewOrderStateMachine(orderRepo),
+	}
+}
+
+// CreateShipment packs items of an order that is being processed or already
+// partly shipped
+func (u *FulfillmentUsecase) CreateShipment(ctx context.Context, orderID uuid.UUID, input usecase.ShipmentInput) (*usecase.ShipmentResponse, error) {
+	carrier := strings.TrimSpace(input.Carrier)
+	trackingNumber := strings.TrimSpace(input.TrackingNumber)
+	if carrier == "" || trackingNumber == "" {
+		return nil, usecase.ErrInvalidCarrier
+	}
+
+	order, err := u.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != orderEntity.OrderStatusProcessing && order.Status != orderEntity.OrderStatusShipped {
+		return nil, usecase.ErrOrderNotShippable
+	}
+
+	shipments, err := u.shipmentRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	ordered := orderedQuantities(order)
+	items, err := shipmentItems(input.Items, entity.Remaining(ordered, shipments))
+	if err != nil {
+		return nil, err
+	}
+
+	shipment := entity.NewShipment(orderID, carrier, trackingNumber, items)
+	if err := u.shipmentRepo.Create(ctx, shipment, ordered); err != nil {
+		if errors.Is(err, repository.ErrOverShipped) {
+			return nil, usecase.ErrInvalidQuantity
+		}
+		return nil, err
+	}
+
+	return convertShipment(shipment), nil
+}
+
+// RecordEvent applies a tracking update to a shipment and moves its order
+// along: to SHIPPED once a shipment has left, and to DELIVERED once every
+// item has arrived
+func (u *FulfillmentUsecase) RecordEvent(ctx context.Context, shipmentID uuid.UUID, input usecase.EventInput) (*usecase.ShipmentResponse, error) {
+	status := entity.ShipmentStatus(strings.ToUpper(strings.TrimSpace(input.Status)))
+	if !status.IsValid() {
+		return nil, usecase.ErrInvalidStatus
+	}
+
+	shipment, err := u.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrShipmentNotFound) {
+			return nil, usecase.ErrNotFound
+		}
+		return nil, err
+	}
+	if !shipment.CanTransitionTo(status) {
+		return nil, usecase.ErrStatusTransition
+	}
+
+	occurredAt := time.Now()
+	if input.OccurredAt != nil {
+		occurredAt = *input.OccurredAt
+	}
+	from := shipment.Status
+	shipment.Apply(entity.ShipmentEvent{
+		ID:          uuid.New(),
+		Status:      status,
+		Location:    strings.TrimSpace(input.Location),
+		Description: strings.TrimSpace(input.Description),
+		OccurredAt:  occurredAt,
+		CreatedAt:   time.Now(),
+	})
+	if err := u.shipmentRepo.AddEvent(ctx, shipment, from); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return nil, usecase.ErrStatusTransition
+		}
+		return nil, err
+	}
+
+	if err := u.advanceOrder(ctx, shipment.OrderID); err != nil {
+		return nil, err
+	}
+
+	return convertShipment(shipment), nil
+}
+
+// GetShipment retrieves a shipment with its tracking history
+func (u *FulfillmentUsecase) GetShipment(ctx context.Context, shipmentID uuid.UUID) (*usecase.ShipmentResponse, error) {
+	shipment, err := u.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrShipmentNotFound) {
+			return nil, usecase.ErrNotFound
+		}
+		return nil, err
+	}
+	return convertShipment(shipment), nil
+}
+
+// ListShipments retrieves the shipments of an order, oldest first
+func (u *FulfillmentUsecase) ListShipments(ctx context.Context, orderID uuid.UUID) ([]*usecase.ShipmentResponse, error) {
+	if _, err := u.getOrder(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	shipments, err := u.shipmentRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*usecase.ShipmentResponse, len(shipments))
+	for i, shipment := range shipments {
+		result[i] = convertShipment(shipment)
+	}
+	return result, nil
+}
+
+// advanceOrder moves the order to the statuses its shipments have reached,
+// one allowed transition at a time. An order that can no longer move, such
+// as one already delivered, is left alone.
+func (u *FulfillmentUsecase) advanceOrder(ctx context.Context, orderID uuid.UUID) error {
+	order, err := u.getOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	shipments, err := u.shipmentRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	type milestone struct {
+		status orderEntity.OrderStatus
+		reason string
+	}
+	var reached []milestone
+	for _, shipment := range shipments {
+		if shipment.IsShipped() {
+			reached = append(reached, milestone{orderEntity.OrderStatusShipped, "shipment " + shipment.ID.String() + " left with " + shipment.Carrier})
+			break
+		}
+	}
+	if entity.IsDelivered(orderedQuantities(order), shipments) {
+		reached = append(reached, milestone{orderEntity.OrderStatusDelivered, "every item was delivered"})
+	}
+
+	for _, m := range reached {
+		if order.Status == m.status {
+			continue
+		}
+		if !order.CanTransitionTo(m.status) {
+			return nil
+		}
+		err := u.states.Apply(ctx, order, m.status, orderEntity.ActorFulfillment, m.reason)
+		if errors.Is(err, orderRepo.ErrStatusChanged) {
+			// Another update moved the order first
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *FulfillmentUsecase) getOrder(ctx context.Context, orderID uuid.UUID) (*orderEntity.Order, error) {
+	order, err := u.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && order == nil) {
+		return nil, usecase.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// orderedQuantities returns the quantity of every item of the order
+func orderedQuantities(order *orderEntity.Order) map[uuid.UUID]int {
+	ordered := make(map[uuid.UUID]int, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.ID] += item.Quantity
+	}
+	return ordered
+}
+
+// shipmentItems checks the requested items against what is left to ship, or
+// takes everything left if none are requested
+func shipmentItems(requested []usecase.ShipmentItem, remaining map[uuid.UUID]int) ([]entity.ShipmentItem, error) {
+	var items []entity.ShipmentItem
+	if len(requested) == 0 {
+		for itemID, quantity := range remaining {
+			if quantity > 0 {
+				items = append(items, entity.ShipmentItem{OrderItemID: itemID, Quantity: quantity})
+			}
+		}
+		if len(items) == 0 {
+			return nil, usecase.ErrNothingToShip
+		}
+		return items, nil
+	}
+
+	for _, item := range requested {
+		left, ok := remaining[item.OrderItemID]
+		if !ok {
+			return nil, usecase.ErrInvalidItem
+		}
+		if item.Quantity <= 0 || item.Quantity > left {
+			return nil, usecase.ErrInvalidQuantity
+		}
+		remaining[item.OrderItemID] -= item.Quantity
+		items = append(items, entity.ShipmentItem{OrderItemID: item.OrderItemID, Quantity: item.Quantity})
+	}
+	return items, nil
+}
+
+func convertShipment(shipment *entity.Shipment) *usecase.ShipmentResponse {
+	items := make([]usecase.ShipmentItem, len(shipment.Items))
+	for i, item := range shipment.Items {
+		items[i] = usecase.ShipmentItem{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		}
+	}
+
+	events := make([]usecase.ShipmentEvent, len(shipment.Events))
+	for i, event := range shipment.Events {
+		events[i] = usecase.ShipmentEvent{
+			Status:      string(event.Status),
+			Location:    event.Location,
+			Description: event.Description,
+			OccurredAt:  event.OccurredAt,
+		}
+	}
+
+	return &usecase.ShipmentResponse{
+		ID:             shipment.ID,
+		OrderID:        shipment.OrderID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         string(shipment.Status),
+		Items:          items,
+		Events:         events,
+		ShippedAt:      shipment.ShippedAt,
+		DeliveredAt:    shipment.DeliveredAt,
+		CreatedAt:      shipment.CreatedAt,
+		UpdatedAt:      shipment.UpdatedAt,
+	}
+}
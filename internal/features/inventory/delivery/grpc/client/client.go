@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/delivery/grpc/proto"
+)
+
+// InventoryClient represents the gRPC client for inventory service
+type InventoryClient struct {
+	client pb.InventoryServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewInventoryClient creates a new inventory gRPC client
+func NewInventoryClient(address string) (*InventoryClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	client := pb.NewInventoryServiceClient(conn)
+	return &InventoryClient{
+		client: client,
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the client connection
+func (c *InventoryClient) Close() error {
+	return c.conn.Close()
+}
+
+// ReserveStock reserves stock of the items for an order, turning the user's
+// cart holds into the reservation when userID is set
+func (c *InventoryClient) ReserveStock(ctx context.Context, orderID, userID string, items []*pb.ReservationItem) ([]*pb.Reservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	req := &pb.ReserveStockRequest{
+		OrderId: orderID,
+		UserId:  userID,
+		Items:   items,
+	}
+
+	resp, err := c.client.ReserveStock(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve stock: %w", err)
+	}
+
+	return resp.Reservations, nil
+}
+
+// ReleaseStock gives the stock reserved for an order back
+func (c *InventoryClient) ReleaseStock(ctx context.Context, orderID string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.ReleaseStock(ctx, &pb.ReleaseStockRequest{OrderId: orderID})
+	if err != nil {
+		return fmt.Errorf("failed to release stock: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to release stock: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// CommitStock marks the stock reserved for an order as sold
+func (c *InventoryClient) CommitStock(ctx context.Context, orderID string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.CommitStock(ctx, &pb.CommitStockRequest{OrderId: orderID})
+	if err != nil {
+		return fmt.Errorf("failed to commit stock: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to commit stock: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// GetReservations retrieves the reservations of an order
+func (c *InventoryClient) GetReservations(ctx context.Context, orderID string) ([]*pb.Reservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.GetReservations(ctx, &pb.GetReservationsRequest{OrderId: orderID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservations: %w", err)
+	}
+
+	return resp.Reservations, nil
+}
+
+// GetStock retrieves the stock level of a product
+func (c *InventoryClient) GetStock(ctx context.Context, productID string) (*pb.GetStockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	return c.client.GetStock(ctx, &pb.GetStockRequest{ProductId: productID})
+}
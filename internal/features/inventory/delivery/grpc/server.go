@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
+)
+
+type InventoryServer struct {
+	pb.UnimplementedInventoryServiceServer
+	inventoryUsecase usecase.Usecase
+}
+
+func NewInventoryServer(inventoryUsecase usecase.Usecase) *InventoryServer {
+	return &InventoryServer{
+		inventoryUsecase: inventoryUsecase,
+	}
+}
+
+func (s *InventoryServer) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.ReserveStockResponse, error) {
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+	}
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		if userID, err = uuid.Parse(req.UserId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+		}
+	}
+
+	items := make([]usecase.ReservationItem, len(req.Items))
+	for i, item := range req.Items {
+		productID, err := uuid.Parse(item.ProductId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+		}
+		items[i] = usecase.ReservationItem{
+			ProductID: productID,
+			Quantity:  int(item.Quantity),
+		}
+	}
+
+	reservations, err := s.inventoryUsecase.ReserveStock(ctx, orderID, userID, items)
+	if err != nil {
+		switch err {
+		case usecase.ErrNoItems, usecase.ErrInvalidQuantity:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case usecase.ErrProductNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case usecase.ErrInsufficientStock, usecase.ErrReservationReleased:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to reserve stock")
+		}
+	}
+
+	return &pb.ReserveStockResponse{
+		Success:      true,
+		Message:      "Stock reserved successfully",
+		Reservations: convertReservationsToPb(reservations),
+	}, nil
+}
+
+func (s *InventoryServer) ReleaseStock(ctx context.Context, req *pb.ReleaseStockRequest) (*pb.ReleaseStockResponse, error) {
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+	}
+
+	if err := s.inventoryUsecase.ReleaseStock(ctx, orderID); err != nil {
+		switch err {
+		case usecase.ErrReservationCommitted:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to release stock")
+		}
+	}
+
+	return &pb.ReleaseStockResponse{
+		Success: true,
+		Message: "Stock released successfully",
+	}, nil
+}
+
+func (s *InventoryServer) CommitStock(ctx context.Context, req *pb.CommitStockRequest) (*pb.CommitStockResponse, error) {
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+	}
+
+	if err := s.inventoryUsecase.CommitStock(ctx, orderID); err != nil {
+		switch err {
+		case usecase.ErrReservationReleased:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to commit stock")
+		}
+	}
+
+	return &pb.CommitStockResponse{
+		Success: true,
+		Message: "Stock committed successfully",
+	}, nil
+}
+
+func (s *InventoryServer) GetReservations(ctx context.Context, req *pb.GetReservationsRequest) (*pb.GetReservationsResponse, error) {
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+	}
+
+	reservations, err := s.inventoryUsecase.GetReservations(ctx, orderID)
+	if err != nil {
+		switch err {
+		case usecase.ErrReservationNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to get reservations")
+		}
+	}
+
+	return &pb.GetReservationsResponse{
+		Reservations: convertReservationsToPb(reservations),
+	}, nil
+}
+
+func (s *InventoryServer) GetStock(ctx context.Context, req *pb.GetStockRequest) (*pb.GetStockResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+
+	stock, err := s.inventoryUsecase.GetStock(ctx, productID)
+	if err != nil {
+		switch err {
+		case usecase.ErrProductNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to get stock")
+		}
+	}
+
+	return &pb.GetStockResponse{
+		ProductId: stock.ProductID.String(),
+		Available: int32(stock.Available),
+		Reserved:  int32(stock.Reserved),
+		Held:      int32(stock.Held),
+	}, nil
+}
+
+func convertReservationsToPb(reservations []*usecase.ReservationResponse) []*pb.Reservation {
+	pbReservations := make([]*pb.Reservation, len(reservations))
+	for i, reservation := range reservations {
+		pbReservations[i] = &pb.Reservation{
+			Id:        reservation.ID.String(),
+			OrderId:   reservation.OrderID.String(),
+			ProductId: reservation.ProductID.String(),
+			Quantity:  int32(reservation.Quantity),
+			Status:    reservation.Status,
+			CreatedAt: timestamppb.New(reservation.CreatedAt),
+			UpdatedAt: timestamppb.New(reservation.UpdatedAt),
+		}
+	}
+	return pbReservations
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
+	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+)
+
+type InventoryHandler struct {
+	inventoryUsecase usecase.Usecase
+	errorHandler     errors.ErrorHandler
+}
+
+func NewInventoryHandler(inventoryUsecase usecase.Usecase) *InventoryHandler {
+	return &InventoryHandler{
+		inventoryUsecase: inventoryUsecase,
+		errorHandler:     errors.NewErrorHandler(),
+	}
+}
+
+// GetStock handles GET /inventory/products/:id request
+func (h *InventoryHandler) GetStock(c *fiber.Ctx) error {
+	productID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid product ID"))
+	}
+
+	resp, err := h.inventoryUsecase.GetStock(c.Context(), productID)
+	if err != nil {
+		switch err {
+		case usecase.ErrProductNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Stock retrieved successfully", resp)
+}
+
+// GetReservations handles GET /inventory/orders/:id/reservations request
+func (h *InventoryHandler) GetReservations(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order ID"))
+	}
+
+	resp, err := h.inventoryUsecase.GetReservations(c.Context(), orderID)
+	if err != nil {
+		switch err {
+		case usecase.ErrReservationNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Reservations retrieved successfully", resp)
+}
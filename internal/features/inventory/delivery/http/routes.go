@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRoutes registers all inventory-related routes. Stock is only
+// reserved, released and committed by the order-payment saga, so no route
+// changes it.
+func RegisterRoutes(router fiber.Router, handler *InventoryHandler) {
+	inventory := router.Group("/inventory")
+
+	inventory.Get("/products/:id", handler.GetStock)
+	inventory.Get("/orders/:id/reservations", handler.GetReservations)
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Hold is the stock of one product set aside for an item in a cart. Like a
+// reservation, the held quantity is taken from products.stock; it is given
+// back when the item is removed or the hold expires, and turns into the
+// order's reservation when the shopper checks out.
+type Hold struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	CartID    uuid.UUID `json:"cart_id" gorm:"type:uuid;not null"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null"`
+	ProductID uuid.UUID `json:"product_id" gorm:"type:uuid;not null"`
+	Quantity  int       `json:"quantity" gorm:"not null"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the hold table name
+func (Hold) TableName() string {
+	return "inventory_holds"
+}
+
+// NewHold creates a new hold of quantity units of a product until expiresAt
+func NewHold(cartID, userID, productID uuid.UUID, quantity int, expiresAt time.Time) *Hold {
+	return &Hold{
+		ID:        uuid.New(),
+		CartID:    cartID,
+		UserID:    userID,
+		ProductID: productID,
+		Quantity:  quantity,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservationStatus represents the status of a stock reservation
+type ReservationStatus string
+
+const (
+	// ReservationStatusReserved holds stock for an order whose saga is running
+	ReservationStatusReserved ReservationStatus = "RESERVED"
+	// ReservationStatusCommitted marks stock sold to a completed order
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	// ReservationStatusReleased marks stock given back after compensation
+	ReservationStatusReleased ReservationStatus = "RELEASED"
+)
+
+// Reservation is the stock of one product held for an order. The reserved
+// quantity is taken from products.stock when the reservation is created and
+// given back when it is released.
+type Reservation struct {
+	ID        uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID   uuid.UUID         `json:"order_id" gorm:"type:uuid;not null"`
+	ProductID uuid.UUID         `json:"product_id" gorm:"type:uuid;not null"`
+	Quantity  int               `json:"quantity" gorm:"not null"`
+	Status    ReservationStatus `json:"status" gorm:"type:varchar(50);not null"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// TableName returns the reservation table name
+func (Reservation) TableName() string {
+	return "inventory_reservations"
+}
+
+// NewReservation creates a new reservation of quantity units of a product
+func NewReservation(orderID, productID uuid.UUID, quantity int) *Reservation {
+	return &Reservation{
+		ID:        uuid.New(),
+		OrderID:   orderID,
+		ProductID: productID,
+		Quantity:  quantity,
+		Status:    ReservationStatusReserved,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Stock is the stock level of a product
+type Stock struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Available int       `json:"available"`
+	Reserved  int       `json:"reserved"`
+	Held      int       `json:"held"`
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/entity"
+)
+
+var (
+	ErrProductNotFound      = errors.New("product not found")
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrReservationReleased  = errors.New("reservation already released")
+	ErrReservationCommitted = errors.New("reservation already committed")
+)
+
+// StockItem is a product quantity to reserve
+type StockItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+}
+
+// InventoryRepository defines the interface for stock and reservation persistence.
+// products.stock is the available stock; reserving or holding takes from it and
+// releasing gives back.
+type InventoryRepository interface {
+	// Reserve takes the items from stock and records them as reserved for the
+	// order, all or nothing. The user's cart holds on the items are turned into
+	// the reservation, so only the quantity not held is taken from stock.
+	// Reserving an order that is already reserved returns its reservations
+	// without touching the stock.
+	Reserve(ctx context.Context, orderID, userID uuid.UUID, items []StockItem) ([]*entity.Reservation, error)
+
+	// Release gives the stock of the order's open reservations back
+	Release(ctx context.Context, orderID uuid.UUID) error
+
+	// Commit marks the order's open reservations as sold
+	Commit(ctx context.Context, orderID uuid.UUID) error
+
+	// Cancel gives the stock of the order's open and sold reservations back,
+	// for an order cancelled before it shipped
+	Cancel(ctx context.Context, orderID uuid.UUID) error
+
+	// Restock gives returned items back to stock
+	Restock(ctx context.Context, items []StockItem) error
+
+	// Unstock takes restocked items out of stock again, as far as they have
+	// not been sold since
+	Unstock(ctx context.Context, items []StockItem) error
+
+	// GetReservations retrieves the reservations of an order
+	GetReservations(ctx context.Context, orderID uuid.UUID) ([]*entity.Reservation, error)
+
+	// GetStock retrieves the stock level of a product
+	GetStock(ctx context.Context, productID uuid.UUID) (*entity.Stock, error)
+
+	// Hold sets the quantity the cart holds of the product and its expiry,
+	// taking more from stock or giving the surplus back
+	Hold(ctx context.Context, hold *entity.Hold) error
+
+	// ReleaseHold gives the stock the cart holds of the product back
+	ReleaseHold(ctx context.Context, cartID, productID uuid.UUID) error
+
+	// ReleaseHolds gives the stock of all the cart's holds back
+	ReleaseHolds(ctx context.Context, cartID uuid.UUID) error
+
+	// ExtendHolds moves the expiry of the cart's holds to expiresAt
+	ExtendHolds(ctx context.Context, cartID uuid.UUID, expiresAt time.Time) error
+
+	// ReleaseExpiredHolds gives the stock of the holds expired at now back and
+	// returns how many holds were released
+	ReleaseExpiredHolds(ctx context.Context, now time.Time) (int64, error)
+}
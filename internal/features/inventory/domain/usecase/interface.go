@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Usecase defines the inventory business logic interface
+type Usecase interface {
+	ReserveStock(ctx context.Context, orderID, userID uuid.UUID, items []ReservationItem) ([]*ReservationResponse, error)
+	ReleaseStock(ctx context.Context, orderID uuid.UUID) error
+	CommitStock(ctx context.Context, orderID uuid.UUID) error
+	CancelStock(ctx context.Context, orderID uuid.UUID) error
+	RestockItems(ctx context.Context, items []ReservationItem) error
+	UnstockItems(ctx context.Context, items []ReservationItem) error
+	GetReservations(ctx context.Context, orderID uuid.UUID) ([]*ReservationResponse, error)
+	GetStock(ctx context.Context, productID uuid.UUID) (*StockResponse, error)
+	HoldStock(ctx context.Context, cartID, userID, productID uuid.UUID, quantity int, expiresAt time.Time) error
+	ReleaseHold(ctx context.Context, cartID, productID uuid.UUID) error
+	ReleaseHolds(ctx context.Context, cartID uuid.UUID) error
+	ExtendHolds(ctx context.Context, cartID uuid.UUID, expiresAt time.Time) error
+	ReleaseExpiredHolds(ctx context.Context) (int64, error)
+}
+
+// ReservationItem is a product quantity to reserve for an order
+type ReservationItem struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Quantity  int       `json:"quantity"`
+}
+
+type ReservationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	OrderID   uuid.UUID `json:"order_id"`
+	ProductID uuid.UUID `json:"product_id"`
+	Quantity  int       `json:"quantity"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type StockResponse struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Available int       `json:"available"`
+	Reserved  int       `json:"reserved"`
+	Held      int       `json:"held"`
+}
+
+// Common errors
+var (
+	ErrProductNotFound      = NewError("product not found")
+	ErrReservationNotFound  = NewError("reservation not found")
+	ErrInsufficientStock    = NewError("insufficient stock")
+	ErrInvalidQuantity      = NewError("quantity must be positive")
+	ErrNoItems              = NewError("no items to reserve")
+	ErrReservationReleased  = NewError("reservation already released")
+	ErrReservationCommitted = NewError("reservation already committed")
+)
+
+// Error represents an inventory error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new inventory error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
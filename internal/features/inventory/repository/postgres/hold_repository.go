@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/repository"
+)
+
+// Hold sets the quantity the cart holds of the product, taking the difference
+// from stock or giving it back
+func (r *InventoryRepository) Hold(ctx context.Context, hold *entity.Hold) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCart(tx, hold.CartID); err != nil {
+			return err
+		}
+
+		// The row lock waits for a sweep or checkout consuming the hold
+		var existing entity.Hold
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("cart_id = ? AND product_id = ?", hold.CartID, hold.ProductID).
+			Take(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := adjustStock(tx, hold.ProductID, hold.Quantity); err != nil {
+				return err
+			}
+			return tx.Create(hold).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := adjustStock(tx, hold.ProductID, hold.Quantity-existing.Quantity); err != nil {
+			return err
+		}
+		return tx.Model(&existing).Updates(map[string]interface{}{
+			"quantity":   hold.Quantity,
+			"expires_at": hold.ExpiresAt,
+			"updated_at": time.Now(),
+		}).Error
+	})
+}
+
+// ReleaseHold gives the stock the cart holds of the product back
+func (r *InventoryRepository) ReleaseHold(ctx context.Context, cartID, productID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCart(tx, cartID); err != nil {
+			return err
+		}
+		return releaseHolds(tx, "cart_id = ? AND product_id = ?", cartID, productID)
+	})
+}
+
+// ReleaseHolds gives the stock of all the cart's holds back
+func (r *InventoryRepository) ReleaseHolds(ctx context.Context, cartID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCart(tx, cartID); err != nil {
+			return err
+		}
+		return releaseHolds(tx, "cart_id = ?", cartID)
+	})
+}
+
+// ExtendHolds moves the expiry of the cart's holds to expiresAt
+func (r *InventoryRepository) ExtendHolds(ctx context.Context, cartID uuid.UUID, expiresAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.Hold{}).
+		Where("cart_id = ?", cartID).
+		Updates(map[string]interface{}{
+			"expires_at": expiresAt,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// ReleaseExpiredHolds gives the stock of the holds expired at now back
+func (r *InventoryRepository) ReleaseExpiredHolds(ctx context.Context, now time.Time) (int64, error) {
+	var released int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		holds, err := deleteHolds(tx, "expires_at <= ?", now)
+		if err != nil {
+			return err
+		}
+		released = int64(len(holds))
+		return restock(tx, holds)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return released, nil
+}
+
+// lockCart takes a transaction-scoped advisory lock on the cart's holds
+func lockCart(tx *gorm.DB, cartID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "cart:"+cartID.String()).Error
+}
+
+// takeHolds deletes the user's holds on the items and returns the quantity
+// they held per product
+func takeHolds(tx *gorm.DB, userID uuid.UUID, items []repository.StockItem) (map[uuid.UUID]int, error) {
+	held := make(map[uuid.UUID]int)
+	if userID == uuid.Nil {
+		return held, nil
+	}
+
+	productIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+	holds, err := deleteHolds(tx, "user_id = ? AND product_id IN ?", userID, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hold := range holds {
+		held[hold.ProductID] += hold.Quantity
+	}
+	return held, nil
+}
+
+// releaseHolds deletes the matching holds and gives their stock back
+func releaseHolds(tx *gorm.DB, query string, args ...interface{}) error {
+	holds, err := deleteHolds(tx, query, args...)
+	if err != nil {
+		return err
+	}
+	return restock(tx, holds)
+}
+
+// deleteHolds deletes the matching holds and returns them. Deleting is what
+// claims a hold, so its stock is given back or reserved exactly once.
+func deleteHolds(tx *gorm.DB, query string, args ...interface{}) ([]entity.Hold, error) {
+	var holds []entity.Hold
+	if err := tx.Clauses(clause.Returning{}).Where(query, args...).Delete(&holds).Error; err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+// restock gives the stock of the holds back, in product order like Reserve
+func restock(tx *gorm.DB, holds []entity.Hold) error {
+	sort.Slice(holds, func(i, j int) bool {
+		return holds[i].ProductID.String() < holds[j].ProductID.String()
+	})
+	for _, hold := range holds {
+		if err := adjustStock(tx, hold.ProductID, -hold.Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
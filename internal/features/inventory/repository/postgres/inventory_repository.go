@@ -0,0 +1,291 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
+)
+
+// InventoryRepository implements the repository.InventoryRepository interface.
+// Writes join the inbox transaction carried by ctx, if any, so that a step
+// handled by the worker changes the stock exactly once.
+type InventoryRepository struct {
+	db *gorm.DB
+}
+
+// NewInventoryRepository creates a new PostgreSQL inventory repository
+func NewInventoryRepository(db *gorm.DB) repository.InventoryRepository {
+	return &InventoryRepository{
+		db: db,
+	}
+}
+
+// Reserve takes the items from stock and records them as reserved for the order.
+// The user's holds on the items are consumed and only the rest is taken from stock.
+func (r *InventoryRepository) Reserve(ctx context.Context, orderID, userID uuid.UUID, items []repository.StockItem) ([]*entity.Reservation, error) {
+	items = mergeItems(items)
+
+	var reservations []*entity.Reservation
+	err := inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Serialize reservations of the same order so that a redelivered
+		// command never reserves twice
+		if err := lockOrder(tx, orderID); err != nil {
+			return err
+		}
+
+		existing, err := findReservations(tx, orderID)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			if existing[0].Status == entity.ReservationStatusReleased {
+				return repository.ErrReservationReleased
+			}
+			reservations = existing
+			return nil
+		}
+
+		held, err := takeHolds(tx, userID, items)
+		if err != nil {
+			return err
+		}
+
+		// Items are sorted by product, so concurrent orders lock products in the same order
+		for _, item := range items {
+			if err := adjustStock(tx, item.ProductID, item.Quantity-held[item.ProductID]); err != nil {
+				return err
+			}
+
+			reservation := entity.NewReservation(orderID, item.ProductID, item.Quantity)
+			if err := tx.Create(reservation).Error; err != nil {
+				return err
+			}
+			reservations = append(reservations, reservation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// Release gives the stock of the order's open reservations back
+func (r *InventoryRepository) Release(ctx context.Context, orderID uuid.UUID) error {
+	return r.release(ctx, orderID, entity.ReservationStatusReserved)
+}
+
+// Cancel gives the stock of the order's open and sold reservations back. Sold
+// stock is still on the shelf until the order ships, so it is released alike.
+func (r *InventoryRepository) Cancel(ctx context.Context, orderID uuid.UUID) error {
+	return r.release(ctx, orderID, entity.ReservationStatusReserved, entity.ReservationStatusCommitted)
+}
+
+// release gives the stock of the order's reservations in one of statuses back.
+// A reservation in another status, other than released, fails the release.
+func (r *InventoryRepository) release(ctx context.Context, orderID uuid.UUID, statuses ...entity.ReservationStatus) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOrder(tx, orderID); err != nil {
+			return err
+		}
+
+		reservations, err := findReservations(tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		for _, reservation := range reservations {
+			if reservation.Status == entity.ReservationStatusReleased {
+				continue
+			}
+			if !hasStatus(reservation, statuses) {
+				return repository.ErrReservationCommitted
+			}
+
+			if err := adjustStock(tx, reservation.ProductID, -reservation.Quantity); err != nil {
+				return err
+			}
+		}
+
+		return setStatus(tx, orderID, entity.ReservationStatusReleased, statuses...)
+	})
+}
+
+// Commit marks the order's open reservations as sold
+func (r *InventoryRepository) Commit(ctx context.Context, orderID uuid.UUID) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOrder(tx, orderID); err != nil {
+			return err
+		}
+
+		reservations, err := findReservations(tx, orderID)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range reservations {
+			if reservation.Status == entity.ReservationStatusReleased {
+				return repository.ErrReservationReleased
+			}
+		}
+
+		return setStatus(tx, orderID, entity.ReservationStatusCommitted, entity.ReservationStatusReserved)
+	})
+}
+
+// Restock gives returned items back to stock
+func (r *InventoryRepository) Restock(ctx context.Context, items []repository.StockItem) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, item := range mergeItems(items) {
+			result := tx.Table("products").
+				Where("id = ?", item.ProductID).
+				Update("stock", gorm.Expr("stock + ?", item.Quantity))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return repository.ErrProductNotFound
+			}
+		}
+		return nil
+	})
+}
+
+// Unstock takes restocked items out of stock again. Units sold in the
+// meantime stay sold, so stock never drops below zero.
+func (r *InventoryRepository) Unstock(ctx context.Context, items []repository.StockItem) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, item := range mergeItems(items) {
+			err := tx.Table("products").
+				Where("id = ?", item.ProductID).
+				Update("stock", gorm.Expr("GREATEST(stock - ?, 0)", item.Quantity)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetReservations retrieves the reservations of an order
+func (r *InventoryRepository) GetReservations(ctx context.Context, orderID uuid.UUID) ([]*entity.Reservation, error) {
+	return findReservations(r.db.WithContext(ctx), orderID)
+}
+
+// GetStock retrieves the stock level of a product
+func (r *InventoryRepository) GetStock(ctx context.Context, productID uuid.UUID) (*entity.Stock, error) {
+	var stock entity.Stock
+	err := r.db.WithContext(ctx).
+		Table("products p").
+		Select(`p.id AS product_id, p.stock AS available,
+			COALESCE((SELECT SUM(quantity) FROM inventory_reservations WHERE product_id = p.id AND status = ?), 0) AS reserved,
+			COALESCE((SELECT SUM(quantity) FROM inventory_holds WHERE product_id = p.id), 0) AS held`,
+			entity.ReservationStatusReserved).
+		Where("p.id = ?", productID).
+		Take(&stock).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stock, nil
+}
+
+// lockOrder takes a transaction-scoped advisory lock on the order's reservations
+func lockOrder(tx *gorm.DB, orderID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", orderID.String()).Error
+}
+
+// findReservations returns the reservations of an order
+func findReservations(tx *gorm.DB, orderID uuid.UUID) ([]*entity.Reservation, error) {
+	var reservations []*entity.Reservation
+	err := tx.Where("order_id = ?", orderID).
+		Order("product_id").
+		Find(&reservations).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// setStatus moves the order's reservations in one of the from statuses to status
+func setStatus(tx *gorm.DB, orderID uuid.UUID, status entity.ReservationStatus, from ...entity.ReservationStatus) error {
+	return tx.Model(&entity.Reservation{}).
+		Where("order_id = ? AND status IN ?", orderID, from).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// hasStatus checks if the reservation is in one of statuses
+func hasStatus(reservation *entity.Reservation, statuses []entity.ReservationStatus) bool {
+	for _, status := range statuses {
+		if reservation.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// adjustStock takes quantity units of the product from stock, or gives them back
+// when quantity is negative. The conditional decrement never takes stock below zero.
+func adjustStock(tx *gorm.DB, productID uuid.UUID, quantity int) error {
+	switch {
+	case quantity < 0:
+		return tx.Table("products").
+			Where("id = ?", productID).
+			Update("stock", gorm.Expr("stock + ?", -quantity)).Error
+	case quantity > 0:
+		result := tx.Table("products").
+			Where("id = ? AND stock >= ?", productID, quantity).
+			Update("stock", gorm.Expr("stock - ?", quantity))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return missingStockError(tx, productID)
+		}
+	}
+	return nil
+}
+
+// missingStockError tells a product without enough stock from an unknown one
+func missingStockError(tx *gorm.DB, productID uuid.UUID) error {
+	var count int64
+	if err := tx.Table("products").Where("id = ?", productID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return repository.ErrProductNotFound
+	}
+	return repository.ErrInsufficientStock
+}
+
+// mergeItems sums the quantities of repeated products and sorts the items by product ID
+func mergeItems(items []repository.StockItem) []repository.StockItem {
+	quantities := make(map[uuid.UUID]int, len(items))
+	var merged []repository.StockItem
+	for _, item := range items {
+		if _, ok := quantities[item.ProductID]; !ok {
+			merged = append(merged, repository.StockItem{ProductID: item.ProductID})
+		}
+		quantities[item.ProductID] += item.Quantity
+	}
+	for i := range merged {
+		merged[i].Quantity = quantities[merged[i].ProductID]
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].ProductID.String() < merged[j].ProductID.String()
+	})
+	return merged
+}
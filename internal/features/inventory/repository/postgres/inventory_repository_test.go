@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/repository"
+)
+
+func TestMergeItems(t *testing.T) {
+	first := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	second := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	merged := mergeItems([]repository.StockItem{
+		{ProductID: second, Quantity: 1},
+		{ProductID: first, Quantity: 2},
+		{ProductID: second, Quantity: 3},
+	})
+
+	assert.Equal(t, []repository.StockItem{
+		{ProductID: first, Quantity: 2},
+		{ProductID: second, Quantity: 4},
+	}, merged)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// HoldSweeper gives the stock of expired cart holds back. It runs once at
+// startup and then on every interval.
+type HoldSweeper struct {
+	inventoryUsecase *InventoryUsecase
+	interval         time.Duration
+}
+
+// NewHoldSweeper creates a new hold sweeper
+func NewHoldSweeper(inventoryUsecase *InventoryUsecase, interval time.Duration) *HoldSweeper {
+	return &HoldSweeper{
+		inventoryUsecase: inventoryUsecase,
+		interval:         interval,
+	}
+}
+
+// Run releases expired holds until the context is cancelled
+func (s *HoldSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		released, err := s.inventoryUsecase.ReleaseExpiredHolds(ctx)
+		if err != nil {
+			log.Printf("Error releasing expired holds: %v", err)
+		} else if released > 0 {
+			log.Printf("Released %d expired cart holds", released)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
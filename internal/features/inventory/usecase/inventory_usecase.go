@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
+)
+
+type InventoryUsecase struct {
+	inventoryRepo repository.InventoryRepository
+}
+
+func NewInventoryUsecase(inventoryRepo repository.InventoryRepository) *InventoryUsecase {
+	return &InventoryUsecase{
+		inventoryRepo: inventoryRepo,
+	}
+}
+
+// ReserveStock reserves stock of every item for an order, or none if one of them
+// is short. The stock the user holds in their cart is reserved first.
+func (u *InventoryUsecase) ReserveStock(ctx context.Context, orderID, userID uuid.UUID, items []usecase.ReservationItem) ([]*usecase.ReservationResponse, error) {
+	if len(items) == 0 {
+		return nil, usecase.ErrNoItems
+	}
+
+	stockItems, err := toStockItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations, err := u.inventoryRepo.Reserve(ctx, orderID, userID, stockItems)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return convertReservations(reservations), nil
+}
+
+// ReleaseStock gives the stock reserved for an order back
+func (u *InventoryUsecase) ReleaseStock(ctx context.Context, orderID uuid.UUID) error {
+	return convertError(u.inventoryRepo.Release(ctx, orderID))
+}
+
+// CommitStock marks the stock reserved for an order as sold
+func (u *InventoryUsecase) CommitStock(ctx context.Context, orderID uuid.UUID) error {
+	return convertError(u.inventoryRepo.Commit(ctx, orderID))
+}
+
+// CancelStock gives the stock reserved or sold for a cancelled order back
+func (u *InventoryUsecase) CancelStock(ctx context.Context, orderID uuid.UUID) error {
+	return convertError(u.inventoryRepo.Cancel(ctx, orderID))
+}
+
+// RestockItems gives returned items back to stock
+func (u *InventoryUsecase) RestockItems(ctx context.Context, items []usecase.ReservationItem) error {
+	stockItems, err := toStockItems(items)
+	if err != nil {
+		return err
+	}
+	return convertError(u.inventoryRepo.Restock(ctx, stockItems))
+}
+
+// UnstockItems takes restocked items out of stock again
+func (u *InventoryUsecase) UnstockItems(ctx context.Context, items []usecase.ReservationItem) error {
+	stockItems, err := toStockItems(items)
+	if err != nil {
+		return err
+	}
+	return convertError(u.inventoryRepo.Unstock(ctx, stockItems))
+}
+
+// GetReservations retrieves the reservations of an order
+func (u *InventoryUsecase) GetReservations(ctx context.Context, orderID uuid.UUID) ([]*usecase.ReservationResponse, error) {
+	reservations, err := u.inventoryRepo.GetReservations(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(reservations) == 0 {
+		return nil, usecase.ErrReservationNotFound
+	}
+
+	return convertReservations(reservations), nil
+}
+
+// GetStock retrieves the stock level of a product
+func (u *InventoryUsecase) GetStock(ctx context.Context, productID uuid.UUID) (*usecase.StockResponse, error) {
+	stock, err := u.inventoryRepo.GetStock(ctx, productID)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &usecase.StockResponse{
+		ProductID: stock.ProductID,
+		Available: stock.Available,
+		Reserved:  stock.Reserved,
+		Held:      stock.Held,
+	}, nil
+}
+
+// HoldStock sets the quantity of a product held for a cart until expiresAt
+func (u *InventoryUsecase) HoldStock(ctx context.Context, cartID, userID, productID uuid.UUID, quantity int, expiresAt time.Time) error {
+	if quantity <= 0 {
+		return usecase.ErrInvalidQuantity
+	}
+
+	hold := entity.NewHold(cartID, userID, productID, quantity, expiresAt)
+	return convertError(u.inventoryRepo.Hold(ctx, hold))
+}
+
+// ReleaseHold gives the stock held for a product in a cart back
+func (u *InventoryUsecase) ReleaseHold(ctx context.Context, cartID, productID uuid.UUID) error {
+	return u.inventoryRepo.ReleaseHold(ctx, cartID, productID)
+}
+
+// ReleaseHolds gives the stock held for a cart back
+func (u *InventoryUsecase) ReleaseHolds(ctx context.Context, cartID uuid.UUID) error {
+	return u.inventoryRepo.ReleaseHolds(ctx, cartID)
+}
+
+// ExtendHolds keeps the stock held for a cart until expiresAt
+func (u *InventoryUsecase) ExtendHolds(ctx context.Context, cartID uuid.UUID, expiresAt time.Time) error {
+	return u.inventoryRepo.ExtendHolds(ctx, cartID, expiresAt)
+}
+
+// ReleaseExpiredHolds gives the stock of expired holds back and returns how many were released
+func (u *InventoryUsecase) ReleaseExpiredHolds(ctx context.Context) (int64, error) {
+	return u.inventoryRepo.ReleaseExpiredHolds(ctx, time.Now())
+}
+
+// toStockItems converts the items, which must have positive quantities
+func toStockItems(items []usecase.ReservationItem) ([]repository.StockItem, error) {
+	stockItems := make([]repository.StockItem, len(items))
+	for i, item := range items {
+		if item.Quantity <= 0 {
+			return nil, usecase.ErrInvalidQuantity
+		}
+		stockItems[i] = repository.StockItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	return stockItems, nil
+}
+
+// convertError maps repository errors to usecase errors
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound):
+		return usecase.ErrProductNotFound
+	case errors.Is(err, repository.ErrInsufficientStock):
+		return usecase.ErrInsufficientStock
+	case errors.Is(err, repository.ErrReservationReleased):
+		return usecase.ErrReservationReleased
+	case errors.Is(err, repository.ErrReservationCommitted):
+		return usecase.ErrReservationCommitted
+	default:
+		return err
+	}
+}
+
+func convertReservations(reservations []*entity.Reservation) []*usecase.ReservationResponse {
+	result := make([]*usecase.ReservationResponse, len(reservations))
+	for i, reservation := range reservations {
+		result[i] = &usecase.ReservationResponse{
+			ID:        reservation.ID,
+			OrderID:   reservation.OrderID,
+			ProductID: reservation.ProductID,
+			Quantity:  reservation.Quantity,
+			Status:    string(reservation.Status),
+			CreatedAt: reservation.CreatedAt,
+			UpdatedAt: reservation.UpdatedAt,
+		}
+	}
+	return result
+}
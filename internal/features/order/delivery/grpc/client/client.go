@@ -38,7 +38,7 @@ func (c *OrderClient) Close() error {
 }
 
 // CreateOrder creates a new order
-func (c *OrderClient) CreateOrder(ctx context.Context, shippingAddress, paymentMethod string) (*pb.Order, error) {
+func (c *OrderClient) CreateOrder(ctx context.Context, shippingAddress *pb.Address, paymentMethod string) (*pb.Order, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
@@ -86,6 +86,21 @@ func (c *OrderClient) ListOrders(ctx context.Context, page, limit int32, status
 	return c.client.ListOrders(ctx, req)
 }
 
+// SearchOrders retrieves a list of the orders of all users
+func (c *OrderClient) SearchOrders(ctx context.Context, query string, page, limit int32, filter *pb.OrderFilter) (*pb.ListOrdersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	req := &pb.SearchOrdersRequest{
+		Query:  query,
+		Page:   page,
+		Limit:  limit,
+		Filter: filter,
+	}
+
+	return c.client.SearchOrders(ctx, req)
+}
+
 // CancelOrder cancels an order
 func (c *OrderClient) CancelOrder(ctx context.Context, orderID, reason string) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
@@ -109,13 +124,14 @@ func (c *OrderClient) CancelOrder(ctx context.Context, orderID, reason string) e
 }
 
 // UpdateOrderStatus updates the status of an order
-func (c *OrderClient) UpdateOrderStatus(ctx context.Context, orderID, status string) (*pb.Order, error) {
+func (c *OrderClient) UpdateOrderStatus(ctx context.Context, orderID, status, reason string) (*pb.Order, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
 	req := &pb.UpdateOrderStatusRequest{
 		OrderId: orderID,
 		Status:  status,
+		Reason:  reason,
 	}
 
 	resp, err := c.client.UpdateOrderStatus(ctx, req)
@@ -126,6 +142,23 @@ func (c *OrderClient) UpdateOrderStatus(ctx context.Context, orderID, status str
 	return resp.Order, nil
 }
 
+// GetOrderHistory retrieves the status changes of an order, oldest first
+func (c *OrderClient) GetOrderHistory(ctx context.Context, orderID string) ([]*pb.StatusChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	req := &pb.GetOrderHistoryRequest{
+		OrderId: orderID,
+	}
+
+	resp, err := c.client.GetOrderHistory(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order history: %w", err)
+	}
+
+	return resp.History, nil
+}
+
 // WithToken adds an authorization token to the context
 func WithToken(ctx context.Context, token string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, "authorization", fmt.Sprintf("Bearer %s", token))
@@ -22,21 +22,135 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type OrderItem struct {
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// ExchangeRate is the price of one unit of from in units of to, as a decimal
+type ExchangeRate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Subtotal      float64                `protobuf:"fixed64,6,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExchangeRate) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type OrderItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity         int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price            *Money                 `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	Subtotal         *Money                 `protobuf:"bytes,8,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	ReturnedQuantity int32                  `protobuf:"varint,9,opt,name=returned_quantity,json=returnedQuantity,proto3" json:"returned_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *OrderItem) Reset() {
 	*x = OrderItem{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[0]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48,7 +162,7 @@ func (x *OrderItem) String() string {
 func (*OrderItem) ProtoMessage() {}
 
 func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[0]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +175,7 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{0}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OrderItem) GetId() string {
@@ -85,43 +199,399 @@ func (x *OrderItem) GetName() string {
 	return ""
 }
 
-func (x *OrderItem) GetPrice() float64 {
+func (x *OrderItem) GetQuantity() int32 {
 	if x != nil {
-		return x.Price
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *OrderItem) GetPrice() *Money {
 	if x != nil {
-		return x.Quantity
+		return x.Price
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetSubtotal() float64 {
+func (x *OrderItem) GetSubtotal() *Money {
 	if x != nil {
 		return x.Subtotal
 	}
+	return nil
+}
+
+func (x *OrderItem) GetReturnedQuantity() int32 {
+	if x != nil {
+		return x.ReturnedQuantity
+	}
 	return 0
 }
 
-type Order struct {
+type OrderDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderDiscount) Reset() {
+	*x = OrderDiscount{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderDiscount) ProtoMessage() {}
+
+func (x *OrderDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderDiscount.ProtoReflect.Descriptor instead.
+func (*OrderDiscount) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderDiscount) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *OrderDiscount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OrderDiscount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OrderDiscount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// TaxLine is a tax charged on the order at one rate
+type TaxLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Rate          float64                `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Taxable       *Money                 `protobuf:"bytes,6,opt,name=taxable,proto3" json:"taxable,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxLine) Reset() {
+	*x = TaxLine{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxLine) ProtoMessage() {}
+
+func (x *TaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxLine.ProtoReflect.Descriptor instead.
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaxLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxLine) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *TaxLine) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TaxLine) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TaxLine) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TaxLine) GetTaxable() *Money {
+	if x != nil {
+		return x.Taxable
+	}
+	return nil
+}
+
+func (x *TaxLine) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Address is the address an order is shipped to
+type Address struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Line1         string                 `protobuf:"bytes,3,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,4,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// StatusChange is a status an order moved to, who moved it there and why
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Order carries its status history only when fetched with GetOrder
+type Order struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Discounts       []*OrderDiscount       `protobuf:"bytes,9,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	TotalAmount     *Money                 `protobuf:"bytes,11,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Subtotal        *Money                 `protobuf:"bytes,12,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal   *Money                 `protobuf:"bytes,13,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	ExchangeRate    *ExchangeRate          `protobuf:"bytes,14,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	Taxes           []*TaxLine             `protobuf:"bytes,15,rep,name=taxes,proto3" json:"taxes,omitempty"`
+	TaxTotal        *Money                 `protobuf:"bytes,16,opt,name=tax_total,json=taxTotal,proto3" json:"tax_total,omitempty"`
+	TaxInclusive    bool                   `protobuf:"varint,17,opt,name=tax_inclusive,json=taxInclusive,proto3" json:"tax_inclusive,omitempty"`
+	ShippingAddress *Address               `protobuf:"bytes,18,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	History         []*StatusChange        `protobuf:"bytes,19,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[1]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +603,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[1]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +616,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{1}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Order) GetId() string {
@@ -170,47 +640,113 @@ func (x *Order) GetItems() []*OrderItem {
 	return nil
 }
 
-func (x *Order) GetTotalAmount() float64 {
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetDiscounts() []*OrderDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *Order) GetTotalAmount() *Money {
 	if x != nil {
 		return x.TotalAmount
 	}
-	return 0
+	return nil
+}
+
+func (x *Order) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Order) GetDiscountTotal() *Money {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return nil
+}
+
+func (x *Order) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+func (x *Order) GetTaxes() []*TaxLine {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *Order) GetTaxTotal() *Money {
+	if x != nil {
+		return x.TaxTotal
+	}
+	return nil
 }
 
-func (x *Order) GetStatus() string {
+func (x *Order) GetTaxInclusive() bool {
 	if x != nil {
-		return x.Status
+		return x.TaxInclusive
 	}
-	return ""
+	return false
 }
 
-func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Order) GetShippingAddress() *Address {
 	if x != nil {
-		return x.CreatedAt
+		return x.ShippingAddress
 	}
 	return nil
 }
 
-func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Order) GetHistory() []*StatusChange {
 	if x != nil {
-		return x.UpdatedAt
+		return x.History
 	}
 	return nil
 }
 
+// CreateOrderRequest ships the order to the saved address address_id if set,
+// to shipping_address otherwise
 type CreateOrderRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CartId          string                 `protobuf:"bytes,2,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
 	PaymentMethod   string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	ShippingAddress string                 `protobuf:"bytes,4,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	AddressId       string                 `protobuf:"bytes,7,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ShippingAddress *Address               `protobuf:"bytes,8,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[2]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +758,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[2]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +771,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{2}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateOrderRequest) GetUserId() string {
@@ -259,13 +795,20 @@ func (x *CreateOrderRequest) GetPaymentMethod() string {
 	return ""
 }
 
-func (x *CreateOrderRequest) GetShippingAddress() string {
+func (x *CreateOrderRequest) GetAddressId() string {
 	if x != nil {
-		return x.ShippingAddress
+		return x.AddressId
 	}
 	return ""
 }
 
+func (x *CreateOrderRequest) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -277,7 +820,7 @@ type CreateOrderResponse struct {
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[3]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +832,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[3]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +845,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{3}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateOrderResponse) GetSuccess() bool {
@@ -336,7 +879,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[4]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +891,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[4]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +904,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{4}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetOrderRequest) GetUserId() string {
@@ -387,7 +930,7 @@ type GetOrderResponse struct {
 
 func (x *GetOrderResponse) Reset() {
 	*x = GetOrderResponse{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[5]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +942,7 @@ func (x *GetOrderResponse) String() string {
 func (*GetOrderResponse) ProtoMessage() {}
 
 func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[5]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +955,7 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{5}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetOrderResponse) GetOrder() *Order {
@@ -422,19 +965,118 @@ func (x *GetOrderResponse) GetOrder() *Order {
 	return nil
 }
 
+// OrderFilter narrows listed orders down; unset fields do not filter. Orders
+// are created in [created_from, created_to) and their total is in
+// [min_total, max_total], which must share a currency. sort is newest,
+// oldest, total_asc or total_desc.
+type OrderFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	MinTotal      *Money                 `protobuf:"bytes,4,opt,name=min_total,json=minTotal,proto3" json:"min_total,omitempty"`
+	MaxTotal      *Money                 `protobuf:"bytes,5,opt,name=max_total,json=maxTotal,proto3" json:"max_total,omitempty"`
+	ProductId     string                 `protobuf:"bytes,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sort          string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderFilter) Reset() {
+	*x = OrderFilter{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFilter) ProtoMessage() {}
+
+func (x *OrderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
+func (*OrderFilter) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetMinTotal() *Money {
+	if x != nil {
+		return x.MinTotal
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetMaxTotal() *Money {
+	if x != nil {
+		return x.MaxTotal
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// ListOrdersRequest lists the orders of a user. status is kept for older
+// clients; filter.status takes precedence.
 type ListOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Filter        *OrderFilter           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListOrdersRequest) Reset() {
 	*x = ListOrdersRequest{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[6]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +1088,7 @@ func (x *ListOrdersRequest) String() string {
 func (*ListOrdersRequest) ProtoMessage() {}
 
 func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[6]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +1101,7 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{6}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListOrdersRequest) GetUserId() string {
@@ -490,6 +1132,83 @@ func (x *ListOrdersRequest) GetStatus() string {
 	return ""
 }
 
+func (x *ListOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// SearchOrdersRequest lists the orders of all users for admins. query
+// matches a prefix of the email of the user or of the order ID.
+type SearchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter        *OrderFilter           `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersRequest) Reset() {
+	*x = SearchOrdersRequest{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersRequest) ProtoMessage() {}
+
+func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchOrdersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type ListOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -502,7 +1221,7 @@ type ListOrdersResponse struct {
 
 func (x *ListOrdersResponse) Reset() {
 	*x = ListOrdersResponse{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[7]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +1233,7 @@ func (x *ListOrdersResponse) String() string {
 func (*ListOrdersResponse) ProtoMessage() {}
 
 func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[7]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +1246,7 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
 func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{7}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListOrdersResponse) GetOrders() []*Order {
@@ -569,7 +1288,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[8]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +1300,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[8]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +1313,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{8}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CancelOrderRequest) GetUserId() string {
@@ -628,7 +1347,7 @@ type CancelOrderResponse struct {
 
 func (x *CancelOrderResponse) Reset() {
 	*x = CancelOrderResponse{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[9]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +1359,7 @@ func (x *CancelOrderResponse) String() string {
 func (*CancelOrderResponse) ProtoMessage() {}
 
 func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[9]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +1372,7 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
 func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{9}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CancelOrderResponse) GetSuccess() bool {
@@ -674,13 +1393,14 @@ type UpdateOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateOrderStatusRequest) Reset() {
 	*x = UpdateOrderStatusRequest{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[10]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +1412,7 @@ func (x *UpdateOrderStatusRequest) String() string {
 func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[10]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +1425,7 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{10}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateOrderStatusRequest) GetOrderId() string {
@@ -722,6 +1442,13 @@ func (x *UpdateOrderStatusRequest) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type UpdateOrderStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -733,7 +1460,7 @@ type UpdateOrderStatusResponse struct {
 
 func (x *UpdateOrderStatusResponse) Reset() {
 	*x = UpdateOrderStatusResponse{}
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[11]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +1472,7 @@ func (x *UpdateOrderStatusResponse) String() string {
 func (*UpdateOrderStatusResponse) ProtoMessage() {}
 
 func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[11]
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +1485,7 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{11}
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateOrderStatusResponse) GetSuccess() bool {
@@ -782,34 +1509,181 @@ func (x *UpdateOrderStatusResponse) GetOrder() *Order {
 	return nil
 }
 
+type GetOrderHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetOrderHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrderHistoryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*StatusChange        `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderHistoryResponse) Reset() {
+	*x = GetOrderHistoryResponse{}
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryResponse) ProtoMessage() {}
+
+func (x *GetOrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOrderHistoryResponse) GetHistory() []*StatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
 var File_internal_features_order_delivery_grpc_proto_order_proto protoreflect.FileDescriptor
 
 const file_internal_features_order_delivery_grpc_proto_order_proto_rawDesc = "" +
 	"\n" +
-	"7internal/features/order/delivery/grpc/proto/order.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x01\n" +
+	"7internal/features/order/delivery/grpc/proto/order.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"F\n" +
+	"\fExchangeRate\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\"\xf1\x01\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1a\n" +
-	"\bsubtotal\x18\x06 \x01(\x01R\bsubtotal\"\x89\x02\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\"\n" +
+	"\x05price\x18\a \x01(\v2\f.order.MoneyR\x05price\x12(\n" +
+	"\bsubtotal\x18\b \x01(\v2\f.order.MoneyR\bsubtotal\x12+\n" +
+	"\x11returned_quantity\x18\t \x01(\x05R\x10returnedQuantityJ\x04\b\x04\x10\x05J\x04\b\x06\x10\a\"\x94\x01\n" +
+	"\rOrderDiscount\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\x06amount\x18\x05 \x01(\v2\f.order.MoneyR\x06amountJ\x04\b\x04\x10\x05\"\xcd\x01\n" +
+	"\aTaxLine\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate\x12&\n" +
+	"\ataxable\x18\x06 \x01(\v2\f.order.MoneyR\ataxable\x12$\n" +
+	"\x06amount\x18\a \x01(\v2\f.order.MoneyR\x06amount\"\xd0\x01\n" +
+	"\aAddress\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05line1\x18\x03 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x04 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\a \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\"\xb5\x01\n" +
+	"\fStatusChange\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd6\x05\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
-	"\x05items\x18\x03 \x03(\v2\x10.order.OrderItemR\x05items\x12!\n" +
-	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\x12\x16\n" +
+	"\x05items\x18\x03 \x03(\v2\x10.order.OrderItemR\x05items\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\tdiscounts\x18\t \x03(\v2\x14.order.OrderDiscountR\tdiscounts\x12/\n" +
+	"\ftotal_amount\x18\v \x01(\v2\f.order.MoneyR\vtotalAmount\x12(\n" +
+	"\bsubtotal\x18\f \x01(\v2\f.order.MoneyR\bsubtotal\x123\n" +
+	"\x0ediscount_total\x18\r \x01(\v2\f.order.MoneyR\rdiscountTotal\x128\n" +
+	"\rexchange_rate\x18\x0e \x01(\v2\x13.order.ExchangeRateR\fexchangeRate\x12$\n" +
+	"\x05taxes\x18\x0f \x03(\v2\x0e.order.TaxLineR\x05taxes\x12)\n" +
+	"\ttax_total\x18\x10 \x01(\v2\f.order.MoneyR\btaxTotal\x12#\n" +
+	"\rtax_inclusive\x18\x11 \x01(\bR\ftaxInclusive\x129\n" +
+	"\x10shipping_address\x18\x12 \x01(\v2\x0e.order.AddressR\x0fshippingAddress\x12-\n" +
+	"\ahistory\x18\x13 \x03(\v2\x13.order.StatusChangeR\ahistoryJ\x04\b\x04\x10\x05J\x04\b\b\x10\tJ\x04\b\n" +
+	"\x10\v\"\xd9\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\acart_id\x18\x02 \x01(\tR\x06cartId\x12%\n" +
-	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12)\n" +
-	"\x10shipping_address\x18\x04 \x01(\tR\x0fshippingAddress\"m\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\a \x01(\tR\taddressId\x129\n" +
+	"\x10shipping_address\x18\b \x01(\v2\x0e.order.AddressR\x0fshippingAddressJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"m\n" +
 	"\x13CreateOrderResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
@@ -818,12 +1692,28 @@ const file_internal_features_order_delivery_grpc_proto_order_proto_rawDesc = ""
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\"6\n" +
 	"\x10GetOrderResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"n\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\xa8\x02\n" +
+	"\vOrderFilter\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12=\n" +
+	"\fcreated_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12)\n" +
+	"\tmin_total\x18\x04 \x01(\v2\f.order.MoneyR\bminTotal\x12)\n" +
+	"\tmax_total\x18\x05 \x01(\v2\f.order.MoneyR\bmaxTotal\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x06 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sort\"\x9a\x01\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"z\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12*\n" +
+	"\x06filter\x18\x05 \x01(\v2\x12.order.OrderFilterR\x06filter\"\x81\x01\n" +
+	"\x13SearchOrdersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12*\n" +
+	"\x06filter\x18\x04 \x01(\v2\x12.order.OrderFilterR\x06filter\"z\n" +
 	"\x12ListOrdersResponse\x12$\n" +
 	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
@@ -835,21 +1725,29 @@ const file_internal_features_order_delivery_grpc_proto_order_proto_rawDesc = ""
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"I\n" +
 	"\x13CancelOrderResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"M\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"e\n" +
 	"\x18UpdateOrderStatusRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"s\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"s\n" +
 	"\x19UpdateOrderStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
-	"\x05order\x18\x03 \x01(\v2\f.order.OrderR\x05order2\xf2\x02\n" +
+	"\x05order\x18\x03 \x01(\v2\f.order.OrderR\x05order\"L\n" +
+	"\x16GetOrderHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"H\n" +
+	"\x17GetOrderHistoryResponse\x12-\n" +
+	"\ahistory\x18\x01 \x03(\v2\x13.order.StatusChangeR\ahistory2\x8b\x04\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12;\n" +
 	"\bGetOrder\x12\x16.order.GetOrderRequest\x1a\x17.order.GetOrderResponse\x12A\n" +
 	"\n" +
-	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12D\n" +
+	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12E\n" +
+	"\fSearchOrders\x12\x1a.order.SearchOrdersRequest\x1a\x19.order.ListOrdersResponse\x12D\n" +
 	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x12V\n" +
-	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponseBTZRgithub.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc/protob\x06proto3"
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12P\n" +
+	"\x0fGetOrderHistory\x12\x1d.order.GetOrderHistoryRequest\x1a\x1e.order.GetOrderHistoryResponseBTZRgithub.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc/protob\x06proto3"
 
 var (
 	file_internal_features_order_delivery_grpc_proto_order_proto_rawDescOnce sync.Once
@@ -863,45 +1761,82 @@ func file_internal_features_order_delivery_grpc_proto_order_proto_rawDescGZIP()
 	return file_internal_features_order_delivery_grpc_proto_order_proto_rawDescData
 }
 
-var file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_features_order_delivery_grpc_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_internal_features_order_delivery_grpc_proto_order_proto_goTypes = []any{
-	(*OrderItem)(nil),                 // 0: order.OrderItem
-	(*Order)(nil),                     // 1: order.Order
-	(*CreateOrderRequest)(nil),        // 2: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),       // 3: order.CreateOrderResponse
-	(*GetOrderRequest)(nil),           // 4: order.GetOrderRequest
-	(*GetOrderResponse)(nil),          // 5: order.GetOrderResponse
-	(*ListOrdersRequest)(nil),         // 6: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),        // 7: order.ListOrdersResponse
-	(*CancelOrderRequest)(nil),        // 8: order.CancelOrderRequest
-	(*CancelOrderResponse)(nil),       // 9: order.CancelOrderResponse
-	(*UpdateOrderStatusRequest)(nil),  // 10: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 11: order.UpdateOrderStatusResponse
-	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+	(*Money)(nil),                     // 0: order.Money
+	(*ExchangeRate)(nil),              // 1: order.ExchangeRate
+	(*OrderItem)(nil),                 // 2: order.OrderItem
+	(*OrderDiscount)(nil),             // 3: order.OrderDiscount
+	(*TaxLine)(nil),                   // 4: order.TaxLine
+	(*Address)(nil),                   // 5: order.Address
+	(*StatusChange)(nil),              // 6: order.StatusChange
+	(*Order)(nil),                     // 7: order.Order
+	(*CreateOrderRequest)(nil),        // 8: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),       // 9: order.CreateOrderResponse
+	(*GetOrderRequest)(nil),           // 10: order.GetOrderRequest
+	(*GetOrderResponse)(nil),          // 11: order.GetOrderResponse
+	(*OrderFilter)(nil),               // 12: order.OrderFilter
+	(*ListOrdersRequest)(nil),         // 13: order.ListOrdersRequest
+	(*SearchOrdersRequest)(nil),       // 14: order.SearchOrdersRequest
+	(*ListOrdersResponse)(nil),        // 15: order.ListOrdersResponse
+	(*CancelOrderRequest)(nil),        // 16: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),       // 17: order.CancelOrderResponse
+	(*UpdateOrderStatusRequest)(nil),  // 18: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil), // 19: order.UpdateOrderStatusResponse
+	(*GetOrderHistoryRequest)(nil),    // 20: order.GetOrderHistoryRequest
+	(*GetOrderHistoryResponse)(nil),   // 21: order.GetOrderHistoryResponse
+	(*timestamppb.Timestamp)(nil),     // 22: google.protobuf.Timestamp
 }
 var file_internal_features_order_delivery_grpc_proto_order_proto_depIdxs = []int32{
-	0,  // 0: order.Order.items:type_name -> order.OrderItem
-	12, // 1: order.Order.created_at:type_name -> google.protobuf.Timestamp
-	12, // 2: order.Order.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: order.CreateOrderResponse.order:type_name -> order.Order
-	1,  // 4: order.GetOrderResponse.order:type_name -> order.Order
-	1,  // 5: order.ListOrdersResponse.orders:type_name -> order.Order
-	1,  // 6: order.UpdateOrderStatusResponse.order:type_name -> order.Order
-	2,  // 7: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	4,  // 8: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
-	6,  // 9: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	8,  // 10: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
-	10, // 11: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	3,  // 12: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	5,  // 13: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
-	7,  // 14: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	9,  // 15: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
-	11, // 16: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	0,  // 0: order.OrderItem.price:type_name -> order.Money
+	0,  // 1: order.OrderItem.subtotal:type_name -> order.Money
+	0,  // 2: order.OrderDiscount.amount:type_name -> order.Money
+	0,  // 3: order.TaxLine.taxable:type_name -> order.Money
+	0,  // 4: order.TaxLine.amount:type_name -> order.Money
+	22, // 5: order.StatusChange.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: order.Order.items:type_name -> order.OrderItem
+	22, // 7: order.Order.created_at:type_name -> google.protobuf.Timestamp
+	22, // 8: order.Order.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 9: order.Order.discounts:type_name -> order.OrderDiscount
+	0,  // 10: order.Order.total_amount:type_name -> order.Money
+	0,  // 11: order.Order.subtotal:type_name -> order.Money
+	0,  // 12: order.Order.discount_total:type_name -> order.Money
+	1,  // 13: order.Order.exchange_rate:type_name -> order.ExchangeRate
+	4,  // 14: order.Order.taxes:type_name -> order.TaxLine
+	0,  // 15: order.Order.tax_total:type_name -> order.Money
+	5,  // 16: order.Order.shipping_address:type_name -> order.Address
+	6,  // 17: order.Order.history:type_name -> order.StatusChange
+	5,  // 18: order.CreateOrderRequest.shipping_address:type_name -> order.Address
+	7,  // 19: order.CreateOrderResponse.order:type_name -> order.Order
+	7,  // 20: order.GetOrderResponse.order:type_name -> order.Order
+	22, // 21: order.OrderFilter.created_from:type_name -> google.protobuf.Timestamp
+	22, // 22: order.OrderFilter.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 23: order.OrderFilter.min_total:type_name -> order.Money
+	0,  // 24: order.OrderFilter.max_total:type_name -> order.Money
+	12, // 25: order.ListOrdersRequest.filter:type_name -> order.OrderFilter
+	12, // 26: order.SearchOrdersRequest.filter:type_name -> order.OrderFilter
+	7,  // 27: order.ListOrdersResponse.orders:type_name -> order.Order
+	7,  // 28: order.UpdateOrderStatusResponse.order:type_name -> order.Order
+	6,  // 29: order.GetOrderHistoryResponse.history:type_name -> order.StatusChange
+	8,  // 30: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	10, // 31: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
+	13, // 32: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	14, // 33: order.OrderService.SearchOrders:input_type -> order.SearchOrdersRequest
+	16, // 34: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	18, // 35: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	20, // 36: order.OrderService.GetOrderHistory:input_type -> order.GetOrderHistoryRequest
+	9,  // 37: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	11, // 38: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
+	15, // 39: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	15, // 40: order.OrderService.SearchOrders:output_type -> order.ListOrdersResponse
+	17, // 41: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	19, // 42: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	21, // 43: order.OrderService.GetOrderHistory:output_type -> order.GetOrderHistoryResponse
+	37, // [37:44] is the sub-list for method output_type
+	30, // [30:37] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_internal_features_order_delivery_grpc_proto_order_proto_init() }
@@ -915,7 +1850,7 @@ func file_internal_features_order_delivery_grpc_proto_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_features_order_delivery_grpc_proto_order_proto_rawDesc), len(file_internal_features_order_delivery_grpc_proto_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
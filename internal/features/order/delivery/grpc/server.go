@@ -10,6 +10,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc/proto"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type OrderServer struct {
@@ -34,14 +36,39 @@ func (s *OrderServer) CreateOrder(ctx context.Context, req *pb.CreateOrderReques
 		return nil, status.Error(codes.InvalidArgument, "invalid cart ID")
 	}
 
-	orderResp, err := s.orderUsecase.CreateOrder(ctx, userID, cartID, req.PaymentMethod, req.ShippingAddress)
+	var shipping usecase.Shipping
+	if req.AddressId != "" {
+		addressID, err := uuid.Parse(req.AddressId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid address ID")
+		}
+		shipping.AddressID = &addressID
+	} else if address := req.ShippingAddress; address != nil {
+		shipping.Address = usecase.Address{
+			Recipient:  address.Recipient,
+			Phone:      address.Phone,
+			Line1:      address.Line1,
+			Line2:      address.Line2,
+			City:       address.City,
+			Region:     address.Region,
+			PostalCode: address.PostalCode,
+			Country:    address.Country,
+		}
+	}
+
+	orderResp, err := s.orderUsecase.CreateOrder(ctx, userID, cartID, req.PaymentMethod, shipping)
 	if err != nil {
 		switch err {
-		case usecase.ErrCartNotFound:
+		case usecase.ErrCartNotFound, usecase.ErrAddressNotFound:
 			return nil, status.Error(codes.NotFound, err.Error())
-		case usecase.ErrCartEmpty:
+		case usecase.ErrCartEmpty, usecase.ErrCurrencyUnavailable:
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case usecase.ErrInvalidAddress:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
+			if _, ok := err.(*promotion.Error); ok {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
 			return nil, status.Error(codes.Internal, "failed to create order")
 		}
 	}
@@ -85,11 +112,88 @@ func (s *OrderServer) ListOrders(ctx context.Context, req *pb.ListOrdersRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
 
-	ordersResp, total, err := s.orderUsecase.ListOrders(ctx, userID, req.Page, req.Limit, req.Status)
+	listReq, err := convertListRequest(req.Page, req.Limit, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if listReq.Status == "" {
+		listReq.Status = usecase.Status(req.Status)
+	}
+
+	ordersResp, total, err := s.orderUsecase.ListOrders(ctx, userID, listReq)
+	if err != nil {
+		return nil, listError(err)
+	}
+
+	return convertListResponse(ordersResp, total, listReq), nil
+}
+
+// SearchOrders lists the orders of all users for admins
+func (s *OrderServer) SearchOrders(ctx context.Context, req *pb.SearchOrdersRequest) (*pb.ListOrdersResponse, error) {
+	listReq, err := convertListRequest(req.Page, req.Limit, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	listReq.Query = req.Query
+
+	ordersResp, total, err := s.orderUsecase.SearchOrders(ctx, listReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to list orders")
+		return nil, listError(err)
 	}
 
+	return convertListResponse(ordersResp, total, listReq), nil
+}
+
+// listError maps list errors to gRPC errors
+func listError(err error) error {
+	switch err {
+	case usecase.ErrInvalidStatus, usecase.ErrInvalidSort, usecase.ErrInvalidDateRange, usecase.ErrInvalidAmountRange:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "failed to list orders")
+	}
+}
+
+func convertListRequest(page, limit int32, filter *pb.OrderFilter) (usecase.ListOrdersRequest, error) {
+	listReq := usecase.ListOrdersRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if filter == nil {
+		return listReq, nil
+	}
+
+	listReq.Status = usecase.Status(filter.Status)
+	listReq.Sort = filter.Sort
+	if filter.CreatedFrom != nil {
+		createdFrom := filter.CreatedFrom.AsTime()
+		listReq.CreatedFrom = &createdFrom
+	}
+	if filter.CreatedTo != nil {
+		createdTo := filter.CreatedTo.AsTime()
+		listReq.CreatedTo = &createdTo
+	}
+
+	var err error
+	if listReq.MinTotal, err = convertMoneyFromPb(filter.MinTotal); err != nil {
+		return listReq, err
+	}
+	if listReq.MaxTotal, err = convertMoneyFromPb(filter.MaxTotal); err != nil {
+		return listReq, err
+	}
+
+	if filter.ProductId != "" {
+		productID, err := uuid.Parse(filter.ProductId)
+		if err != nil {
+			return listReq, status.Error(codes.InvalidArgument, "invalid product ID")
+		}
+		listReq.ProductID = &productID
+	}
+
+	return listReq, nil
+}
+
+func convertListResponse(ordersResp []*usecase.OrderResponse, total int64, listReq usecase.ListOrdersRequest) *pb.ListOrdersResponse {
 	orders := make([]*pb.Order, len(ordersResp))
 	for i, o := range ordersResp {
 		orders[i] = convertOrderToPb(o)
@@ -98,9 +202,9 @@ func (s *OrderServer) ListOrders(ctx context.Context, req *pb.ListOrdersRequest)
 	return &pb.ListOrdersResponse{
 		Orders: orders,
 		Total:  total,
-		Page:   req.Page,
-		Limit:  req.Limit,
-	}, nil
+		Page:   listReq.Page,
+		Limit:  listReq.Limit,
+	}
 }
 
 func (s *OrderServer) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
@@ -123,8 +227,10 @@ func (s *OrderServer) CancelOrder(ctx context.Context, req *pb.CancelOrderReques
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		case usecase.ErrCompleted:
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
-		case usecase.ErrOrderAlreadyFinal:
+		case usecase.ErrOrderAlreadyFinal, usecase.ErrStatusTransition:
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case usecase.ErrPaymentInProgress:
+			return nil, status.Error(codes.Aborted, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "failed to cancel order")
 		}
@@ -143,7 +249,7 @@ func (s *OrderServer) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrder
 	}
 
 	orderStatus := usecase.Status(req.Status)
-	orderResp, err := s.orderUsecase.UpdateOrderStatus(ctx, orderID, orderStatus)
+	orderResp, err := s.orderUsecase.UpdateOrderStatus(ctx, orderID, orderStatus, req.Reason)
 	if err != nil {
 		switch err {
 		case usecase.ErrNotFound:
@@ -166,26 +272,131 @@ func (s *OrderServer) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrder
 	}, nil
 }
 
+func (s *OrderServer) GetOrderHistory(ctx context.Context, req *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+	}
+
+	history, err := s.orderUsecase.GetOrderHistory(ctx, userID, orderID)
+	if err != nil {
+		switch err {
+		case usecase.ErrNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to get order history")
+		}
+	}
+
+	return &pb.GetOrderHistoryResponse{
+		History: convertHistoryToPb(history),
+	}, nil
+}
+
+func convertHistoryToPb(history []usecase.StatusChange) []*pb.StatusChange {
+	result := make([]*pb.StatusChange, len(history))
+	for i, change := range history {
+		result[i] = &pb.StatusChange{
+			FromStatus: string(change.FromStatus),
+			ToStatus:   string(change.ToStatus),
+			Actor:      change.Actor,
+			Reason:     change.Reason,
+			CreatedAt:  timestamppb.New(change.CreatedAt),
+		}
+	}
+	return result
+}
+
+func convertMoneyToPb(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
+
+func convertMoneyFromPb(m *pb.Money) (*money.Money, error) {
+	if m == nil {
+		return nil, nil
+	}
+	currency, err := money.ParseCurrency(m.Currency)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid currency")
+	}
+	amount := money.New(m.Amount, currency)
+	return &amount, nil
+}
+
 func convertOrderToPb(order *usecase.OrderResponse) *pb.Order {
 	pbItems := make([]*pb.OrderItem, len(order.Items))
 	for i, item := range order.Items {
 		pbItems[i] = &pb.OrderItem{
-			Id:        item.ID.String(),
-			ProductId: item.ProductID.String(),
-			Name:      item.Name,
-			Price:     item.Price,
-			Quantity:  int32(item.Quantity),
-			Subtotal:  item.Subtotal,
+			Id:               item.ID.String(),
+			ProductId:        item.ProductID.String(),
+			Name:             item.Name,
+			Price:            convertMoneyToPb(item.Price),
+			Quantity:         int32(item.Quantity),
+			ReturnedQuantity: int32(item.ReturnedQuantity),
+			Subtotal:         convertMoneyToPb(item.Subtotal),
+		}
+	}
+
+	pbDiscounts := make([]*pb.OrderDiscount, len(order.Discounts))
+	for i, discount := range order.Discounts {
+		pbDiscounts[i] = &pb.OrderDiscount{
+			PromotionId: discount.PromotionID.String(),
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      convertMoneyToPb(discount.Amount),
+		}
+	}
+
+	pbTaxes := make([]*pb.TaxLine, len(order.Taxes))
+	for i, tax := range order.Taxes {
+		pbTaxes[i] = &pb.TaxLine{
+			Name:     tax.Name,
+			Country:  tax.Country,
+			Region:   tax.Region,
+			Category: tax.Category,
+			Rate:     tax.Rate,
+			Taxable:  convertMoneyToPb(tax.Taxable),
+			Amount:   convertMoneyToPb(tax.Amount),
 		}
 	}
 
 	return &pb.Order{
-		Id:          order.ID.String(),
-		UserId:      order.UserID.String(),
-		Items:       pbItems,
-		TotalAmount: order.TotalAmount,
-		Status:      string(order.Status),
-		CreatedAt:   timestamppb.New(order.CreatedAt),
-		UpdatedAt:   timestamppb.New(order.UpdatedAt),
+		Id:            order.ID.String(),
+		UserId:        order.UserID.String(),
+		Items:         pbItems,
+		Subtotal:      convertMoneyToPb(order.Subtotal),
+		Discounts:     pbDiscounts,
+		DiscountTotal: convertMoneyToPb(order.DiscountTotal),
+		Taxes:         pbTaxes,
+		TaxTotal:      convertMoneyToPb(order.TaxTotal),
+		TaxInclusive:  order.TaxInclusive,
+		TotalAmount:   convertMoneyToPb(order.TotalAmount),
+		ExchangeRate: &pb.ExchangeRate{
+			From: string(order.ExchangeRate.From),
+			To:   string(order.ExchangeRate.To),
+			Rate: order.ExchangeRate.Value,
+		},
+		ShippingAddress: &pb.Address{
+			Recipient:  order.ShippingAddress.Recipient,
+			Phone:      order.ShippingAddress.Phone,
+			Line1:      order.ShippingAddress.Line1,
+			Line2:      order.ShippingAddress.Line2,
+			City:       order.ShippingAddress.City,
+			Region:     order.ShippingAddress.Region,
+			PostalCode: order.ShippingAddress.PostalCode,
+			Country:    order.ShippingAddress.Country,
+		},
+		Status:    string(order.Status),
+		History:   convertHistoryToPb(order.History),
+		CreatedAt: timestamppb.New(order.CreatedAt),
+		UpdatedAt: timestamppb.New(order.UpdatedAt),
 	}
 }
@@ -1,13 +1,17 @@
 package http
 
 import (
+	"time"
+
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/usecase"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/dto/request"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
 	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type OrderHandler struct {
@@ -39,14 +43,38 @@ func (h *OrderHandler) CreateOrder(c *fiber.Ctx) error {
 		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid cart ID"))
 	}
 
-	resp, err := h.orderUsecase.CreateOrder(c.Context(), userID, cartID, req.PaymentMethod, req.ShippingAddress)
+	var shipping usecase.Shipping
+	if req.AddressID != "" {
+		addressID, err := uuid.Parse(req.AddressID)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid address ID"))
+		}
+		shipping.AddressID = &addressID
+	} else if req.ShippingAddress != nil {
+		shipping.Address = usecase.Address{
+			Recipient:  req.ShippingAddress.Recipient,
+			Phone:      req.ShippingAddress.Phone,
+			Line1:      req.ShippingAddress.Line1,
+			Line2:      req.ShippingAddress.Line2,
+			City:       req.ShippingAddress.City,
+			Region:     req.ShippingAddress.Region,
+			PostalCode: req.ShippingAddress.PostalCode,
+			Country:    req.ShippingAddress.Country,
+		}
+	}
+
+	resp, err := h.orderUsecase.CreateOrder(c.Context(), userID, cartID, req.PaymentMethod, shipping)
 	if err != nil {
 		switch err {
-		case usecase.ErrCartNotFound:
+		case usecase.ErrCartNotFound, usecase.ErrAddressNotFound:
 			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
-		case usecase.ErrCartEmpty:
+		case usecase.ErrCartEmpty, usecase.ErrCurrencyUnavailable, usecase.ErrInvalidAddress:
 			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
 		default:
+			// The cart's coupon no longer applies
+			if _, ok := err.(*promotion.Error); ok {
+				return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+			}
 			return h.errorHandler.Handle(c, errors.NewInternalError(err))
 		}
 	}
@@ -91,27 +119,124 @@ func (h *OrderHandler) ListOrders(c *fiber.Ctx) error {
 		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
 	}
 
-	// Set default values if not provided
-	if req.Page <= 0 {
-		req.Page = 1
+	listReq, err := listOrdersRequest(req)
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
 	}
-	if req.PageSize <= 0 {
-		req.PageSize = 10
+
+	resp, total, err := h.orderUsecase.ListOrders(c.Context(), userID, listReq)
+	if err != nil {
+		return h.handleListError(c, err)
+	}
+
+	return httpresponse.OK(c, "Orders retrieved successfully", fiber.Map{
+		"orders": resp,
+		"total":  total,
+		"page":   listReq.Page,
+		"limit":  listReq.Limit,
+	})
+}
+
+// SearchOrders handles GET /orders/search request, which lists the orders of
+// all users for admins
+func (h *OrderHandler) SearchOrders(c *fiber.Ctx) error {
+	var req request.SearchOrdersRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
 	}
 
-	resp, total, err := h.orderUsecase.ListOrders(c.Context(), userID, int32(req.Page), int32(req.PageSize), req.Status)
+	listReq, err := listOrdersRequest(req.ListOrdersRequest)
 	if err != nil {
-		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		return h.errorHandler.Handle(c, err)
+	}
+	listReq.Query = req.Query
+
+	resp, total, err := h.orderUsecase.SearchOrders(c.Context(), listReq)
+	if err != nil {
+		return h.handleListError(c, err)
 	}
 
 	return httpresponse.OK(c, "Orders retrieved successfully", fiber.Map{
 		"orders": resp,
 		"total":  total,
-		"page":   req.Page,
-		"limit":  req.PageSize,
+		"page":   listReq.Page,
+		"limit":  listReq.Limit,
 	})
 }
 
+// handleListError maps list errors to HTTP errors
+func (h *OrderHandler) handleListError(c *fiber.Ctx, err error) error {
+	switch err {
+	case usecase.ErrInvalidStatus, usecase.ErrInvalidSort, usecase.ErrInvalidDateRange, usecase.ErrInvalidAmountRange:
+		return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+	default:
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+}
+
+// listOrdersRequest parses the filters of a list request
+func listOrdersRequest(req request.ListOrdersRequest) (usecase.ListOrdersRequest, error) {
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	listReq := usecase.ListOrdersRequest{
+		Page:   int32(req.Page),
+		Limit:  int32(req.PageSize),
+		Status: usecase.Status(req.Status),
+		Sort:   req.Sort,
+	}
+
+	var err error
+	if listReq.CreatedFrom, err = parseOptionalTime(req.CreatedFrom, "Invalid created_from date"); err != nil {
+		return listReq, err
+	}
+	if listReq.CreatedTo, err = parseOptionalTime(req.CreatedTo, "Invalid created_to date"); err != nil {
+		return listReq, err
+	}
+
+	if req.MinTotal != nil || req.MaxTotal != nil {
+		currency, err := money.ParseCurrency(req.Currency)
+		if err != nil {
+			return listReq, errors.NewValidationError("Invalid currency")
+		}
+		if req.MinTotal != nil {
+			minTotal := money.New(*req.MinTotal, currency)
+			listReq.MinTotal = &minTotal
+		}
+		if req.MaxTotal != nil {
+			maxTotal := money.New(*req.MaxTotal, currency)
+			listReq.MaxTotal = &maxTotal
+		}
+	}
+
+	if req.ProductID != "" {
+		productID, err := uuid.Parse(req.ProductID)
+		if err != nil {
+			return listReq, errors.NewValidationError("Invalid product ID")
+		}
+		listReq.ProductID = &productID
+	}
+
+	return listReq, nil
+}
+
+// parseOptionalTime parses an RFC 3339 time that may be left empty
+func parseOptionalTime(value, message string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.NewValidationError(message)
+	}
+	return &t, nil
+}
+
 // UpdateOrderStatus handles PUT /orders/:id/status request
 func (h *OrderHandler) UpdateOrderStatus(c *fiber.Ctx) error {
 	orderID, err := uuid.Parse(c.Params("id"))
@@ -124,7 +249,7 @@ func (h *OrderHandler) UpdateOrderStatus(c *fiber.Ctx) error {
 		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
 	}
 
-	resp, err := h.orderUsecase.UpdateOrderStatus(c.Context(), orderID, usecase.Status(req.Status))
+	resp, err := h.orderUsecase.UpdateOrderStatus(c.Context(), orderID, usecase.Status(req.Status), req.Reason)
 	if err != nil {
 		switch err {
 		case usecase.ErrNotFound:
@@ -4,13 +4,16 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-// RegisterRoutes registers all order-related routes
-func RegisterRoutes(router fiber.Router, handler *OrderHandler, authMiddleware fiber.Handler) {
+// RegisterRoutes registers all order-related routes. Order creation is retried
+// safely through the idempotency middleware, and only admins search the
+// orders of all users.
+func RegisterRoutes(router fiber.Router, handler *OrderHandler, authMiddleware, adminMiddleware, idempotency fiber.Handler) {
 	orders := router.Group("/orders")
 	orders.Use(authMiddleware)
 
-	orders.Post("", handler.CreateOrder)
+	orders.Post("", idempotency, handler.CreateOrder)
 	orders.Get("", handler.ListOrders)
+	orders.Get("/search", adminMiddleware, handler.SearchOrders)
 	orders.Get("/:id", handler.GetOrder)
 	orders.Put("/:id/status", handler.UpdateOrderStatus)
 }
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// TopicOrderExpired is the topic OrderExpired events are published on
+const TopicOrderExpired = "order.expired"
+
+// OrderExpired announces that an order was cancelled because it was not paid in time
+type OrderExpired struct {
+	OrderID     uuid.UUID   `json:"order_id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	TotalAmount money.Money `json:"total_amount"`
+	ExpiredAt   time.Time   `json:"expired_at"`
+}
@@ -4,6 +4,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // OrderStatus represents the status of an order
@@ -20,74 +23,157 @@ const (
 	OrderStatusCompleted  OrderStatus = "COMPLETED"
 )
 
-// OrderItem represents an item in the order
+// OrderItem represents an item in the order. ReturnedQuantity is how many
+// units were sent back and refunded.
 type OrderItem struct {
-	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	OrderID   uuid.UUID `json:"order_id" gorm:"type:uuid;not null"`
-	ProductID uuid.UUID `json:"product_id" gorm:"type:uuid;not null"`
-	Name      string    `json:"name" gorm:"not null"`
-	Price     float64   `json:"price" gorm:"not null"`
-	Quantity  int       `json:"quantity" gorm:"not null"`
+	ID               uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID          uuid.UUID   `json:"order_id" gorm:"type:uuid;not null"`
+	ProductID        uuid.UUID   `json:"product_id" gorm:"type:uuid;not null"`
+	Name             string      `json:"name" gorm:"not null"`
+	Price            money.Money `json:"price" gorm:"embedded;embeddedPrefix:price_"`
+	TaxCategory      string      `json:"tax_category" gorm:"type:varchar(50)"`
+	Quantity         int         `json:"quantity" gorm:"not null"`
+	ReturnedQuantity int         `json:"returned_quantity" gorm:"not null;default:0"`
 }
 
-// Order represents an order in the system
-type Order struct {
+// Subtotal returns the price of the item times its quantity
+func (i OrderItem) Subtotal() money.Money {
+	return i.Price.Mul(int64(i.Quantity))
+}
+
+// OrderDiscount represents a promotion applied to the order
+type OrderDiscount struct {
 	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	UserID      uuid.UUID   `json:"user_id" gorm:"type:uuid;not null"`
-	Items       []OrderItem `json:"items" gorm:"foreignKey:OrderID"`
-	TotalAmount float64     `json:"total_amount" gorm:"not null"`
-	Status      OrderStatus `json:"status" gorm:"type:varchar(50);not null"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	OrderID     uuid.UUID   `json:"order_id" gorm:"type:uuid;not null"`
+	PromotionID uuid.UUID   `json:"promotion_id" gorm:"type:uuid;not null"`
+	Code        string      `json:"code" gorm:"not null"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount" gorm:"embedded"`
+}
+
+// OrderTax represents a tax charged on the order at one rate
+type OrderTax struct {
+	ID       uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID  uuid.UUID   `json:"order_id" gorm:"type:uuid;not null"`
+	Name     string      `json:"name" gorm:"not null"`
+	Country  string      `json:"country" gorm:"type:varchar(2);not null"`
+	Region   string      `json:"region" gorm:"type:varchar(50)"`
+	Category string      `json:"category" gorm:"type:varchar(50)"`
+	Rate     float64     `json:"rate" gorm:"type:numeric(7,4);not null"`
+	Taxable  money.Money `json:"taxable" gorm:"embedded;embeddedPrefix:taxable_"`
+	Amount   money.Money `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+}
+
+// ShippingAddress is the address an order is shipped to, as it read when the
+// order was placed
+type ShippingAddress struct {
+	Recipient  string `json:"recipient" gorm:"type:varchar(255)"`
+	Phone      string `json:"phone" gorm:"type:varchar(50)"`
+	Line1      string `json:"line1" gorm:"type:varchar(255)"`
+	Line2      string `json:"line2" gorm:"type:varchar(255)"`
+	City       string `json:"city" gorm:"type:varchar(100)"`
+	Region     string `json:"region" gorm:"type:varchar(50)"`
+	PostalCode string `json:"postal_code" gorm:"type:varchar(20)"`
+	Country    string `json:"country" gorm:"type:varchar(2)"`
+}
+
+// Order represents an order in the system. TotalAmount is the Subtotal of
+// the items less the DiscountTotal plus the TaxTotal, unless the prices
+// include the taxes, all in the currency of the items. ExchangeRate is the
+// rate the catalog prices were converted at. ShippingAddress is a copy, which
+// later changes to the user's address book leave alone.
+type Order struct {
+	ID              uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	UserID          uuid.UUID       `json:"user_id" gorm:"type:uuid;not null"`
+	Items           []OrderItem     `json:"items" gorm:"foreignKey:OrderID"`
+	Discounts       []OrderDiscount `json:"discounts" gorm:"foreignKey:OrderID"`
+	Subtotal        money.Money     `json:"subtotal" gorm:"embedded;embeddedPrefix:subtotal_"`
+	DiscountTotal   money.Money     `json:"discount_total" gorm:"embedded;embeddedPrefix:discount_total_"`
+	Taxes           []OrderTax      `json:"taxes" gorm:"foreignKey:OrderID"`
+	TaxTotal        money.Money     `json:"tax_total" gorm:"embedded;embeddedPrefix:tax_total_"`
+	TaxInclusive    bool            `json:"tax_inclusive" gorm:"not null;default:false"`
+	TotalAmount     money.Money     `json:"total_amount" gorm:"embedded;embeddedPrefix:total_"`
+	ExchangeRate    fx.Rate         `json:"exchange_rate" gorm:"embedded;embeddedPrefix:fx_"`
+	ShippingAddress ShippingAddress `json:"shipping_address" gorm:"embedded;embeddedPrefix:shipping_"`
+	Status          OrderStatus     `json:"status" gorm:"type:varchar(50);not null"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
 }
 
-// NewOrder creates a new order from cart items
-func NewOrder(userID uuid.UUID, items []OrderItem) *Order {
-	var totalAmount float64
+// NewOrder creates a new order from cart items, which must share a currency
+func NewOrder(userID uuid.UUID, items []OrderItem) (*Order, error) {
+	var subtotal money.Money
 	for _, item := range items {
-		totalAmount += item.Price * float64(item.Quantity)
+		var err error
+		if subtotal, err = subtotal.Add(item.Subtotal()); err != nil {
+			return nil, err
+		}
 	}
 
 	return &Order{
-		ID:          uuid.New(),
-		UserID:      userID,
-		Items:       items,
-		TotalAmount: totalAmount,
-		Status:      OrderStatusPending,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
+		ID:            uuid.New(),
+		UserID:        userID,
+		Items:         items,
+		Subtotal:      subtotal,
+		DiscountTotal: money.Zero(subtotal.Currency),
+		TaxTotal:      money.Zero(subtotal.Currency),
+		TotalAmount:   subtotal,
+		Status:        OrderStatusPending,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}, nil
 }
 
-// UpdateStatus updates the order status
-func (o *Order) UpdateStatus(status OrderStatus) {
-	o.Status = status
-	o.UpdatedAt = time.Now()
-}
+// ApplyDiscounts attaches the discounts to the order and takes them off the
+// total, which never drops below zero
+func (o *Order) ApplyDiscounts(discounts []OrderDiscount) error {
+	discountTotal := money.Zero(o.Subtotal.Currency)
+	for i := range discounts {
+		discounts[i].OrderID = o.ID
+		var err error
+		if discountTotal, err = discountTotal.Add(discounts[i].Amount); err != nil {
+			return err
+		}
+	}
+
+	total, err := o.Subtotal.Sub(discountTotal)
+	if err != nil {
+		return err
+	}
+	if total.IsNegative() {
+		total = money.Zero(total.Currency)
+	}
 
-// IsFinal checks if the order is in a final state
-func (o *Order) IsFinal() bool {
-	return o.Status == OrderStatusDelivered ||
-		o.Status == OrderStatusCancelled ||
-		o.Status == OrderStatusFailed
+	o.Discounts = discounts
+	o.DiscountTotal = discountTotal
+	o.TotalAmount = total
+	o.UpdatedAt = time.Now()
+	return nil
 }
 
-// CanTransitionTo checks if the order can transition to the given status
-func (o *Order) CanTransitionTo(status OrderStatus) bool {
-	if o.IsFinal() {
-		return false
+// ApplyTaxes attaches the taxes to the order. They are added to the total
+// unless the prices include them; discounts are applied first.
+func (o *Order) ApplyTaxes(taxes []OrderTax, inclusive bool) error {
+	taxTotal := money.Zero(o.Subtotal.Currency)
+	for i := range taxes {
+		taxes[i].OrderID = o.ID
+		var err error
+		if taxTotal, err = taxTotal.Add(taxes[i].Amount); err != nil {
+			return err
+		}
 	}
 
-	switch o.Status {
-	case OrderStatusPending:
-		return status == OrderStatusConfirmed || status == OrderStatusCancelled
-	case OrderStatusConfirmed:
-		return status == OrderStatusProcessing || status == OrderStatusCancelled
-	case OrderStatusProcessing:
-		return status == OrderStatusShipped || status == OrderStatusFailed
-	case OrderStatusShipped:
-		return status == OrderStatusDelivered || status == OrderStatusFailed
-	default:
-		return false
+	if !inclusive {
+		total, err := o.TotalAmount.Add(taxTotal)
+		if err != nil {
+			return err
+		}
+		o.TotalAmount = total
 	}
+
+	o.Taxes = taxes
+	o.TaxTotal = taxTotal
+	o.TaxInclusive = inclusive
+	o.UpdatedAt = time.Now()
+	return nil
 }
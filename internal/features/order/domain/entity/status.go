@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidTransition is returned when an order cannot move to a status
+var ErrInvalidTransition = errors.New("invalid order status transition")
+
+// Actors recorded in the order status history
+const (
+	ActorCustomer    = "customer"
+	ActorAdmin       = "admin"
+	ActorSaga        = "saga"
+	ActorFulfillment = "fulfillment"
+	ActorSystem      = "system"
+)
+
+// transitions lists the statuses an order may move to from each status.
+// Statuses without an entry are final.
+var transitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:    {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed:  {OrderStatusProcessing, OrderStatusCancelled},
+	OrderStatusProcessing: {OrderStatusShipped, OrderStatusFailed, OrderStatusCancelled},
+	OrderStatusShipped:    {OrderStatusDelivered, OrderStatusFailed},
+}
+
+// OrderStatusHistory records a status change of an order, who made it and why
+type OrderStatusHistory struct {
+	ID         uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID    uuid.UUID   `json:"order_id" gorm:"type:uuid;not null"`
+	FromStatus OrderStatus `json:"from_status" gorm:"type:varchar(50);not null"`
+	ToStatus   OrderStatus `json:"to_status" gorm:"type:varchar(50);not null"`
+	Actor      string      `json:"actor" gorm:"type:varchar(50);not null"`
+	Reason     string      `json:"reason" gorm:"type:text"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// TableName returns the order status history table name
+func (OrderStatusHistory) TableName() string {
+	return "order_status_history"
+}
+
+// IsValid checks if an order can be in the status
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusProcessing, OrderStatusShipped,
+		OrderStatusDelivered, OrderStatusCancelled, OrderStatusFailed, OrderStatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFinal checks if the order is in a final state
+func (o *Order) IsFinal() bool {
+	return o.Status == OrderStatusDelivered ||
+		o.Status == OrderStatusCancelled ||
+		o.Status == OrderStatusFailed
+}
+
+// CanTransitionTo checks if the order can transition to the given status
+func (o *Order) CanTransitionTo(status OrderStatus) bool {
+	for _, next := range transitions[o.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition moves the order to the given status and returns the change to
+// record, failing with ErrInvalidTransition if the move is not allowed
+func (o *Order) Transition(status OrderStatus, actor, reason string) (*OrderStatusHistory, error) {
+	if !o.CanTransitionTo(status) {
+		return nil, ErrInvalidTransition
+	}
+
+	change := &OrderStatusHistory{
+		ID:         uuid.New(),
+		OrderID:    o.ID,
+		FromStatus: o.Status,
+		ToStatus:   status,
+		Actor:      actor,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}
+	o.Status = status
+	o.UpdatedAt = change.CreatedAt
+	return change, nil
+}
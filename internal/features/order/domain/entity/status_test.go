@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_Transition(t *testing.T) {
+	order := &Order{Status: OrderStatusPending}
+
+	change, err := order.Transition(OrderStatusConfirmed, ActorSaga, "order accepted")
+	require.NoError(t, err)
+	assert.Equal(t, OrderStatusConfirmed, order.Status)
+	assert.Equal(t, OrderStatusPending, change.FromStatus)
+	assert.Equal(t, OrderStatusConfirmed, change.ToStatus)
+	assert.Equal(t, ActorSaga, change.Actor)
+
+	// Skipping ahead is refused and leaves the order alone
+	_, err = order.Transition(OrderStatusDelivered, ActorAdmin, "")
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Equal(t, OrderStatusConfirmed, order.Status)
+
+	_, err = order.Transition(OrderStatusCancelled, ActorCustomer, "changed my mind")
+	require.NoError(t, err)
+	assert.True(t, order.IsFinal())
+	assert.False(t, order.CanTransitionTo(OrderStatusConfirmed))
+}
+
+func TestOrder_CancelBeforeShipping(t *testing.T) {
+	// A paid order may be cancelled until it ships
+	order := &Order{Status: OrderStatusProcessing}
+	_, err := order.Transition(OrderStatusCancelled, ActorCustomer, "ordered twice")
+	require.NoError(t, err)
+
+	order = &Order{Status: OrderStatusShipped}
+	_, err = order.Transition(OrderStatusCancelled, ActorCustomer, "too late")
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+}
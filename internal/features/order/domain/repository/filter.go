@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+var ErrInvalidSort = errors.New("invalid sort")
+
+// ListSort is an order listed orders can be returned in
+type ListSort string
+
+const (
+	SortNewest    ListSort = "newest"
+	SortOldest    ListSort = "oldest"
+	SortTotalAsc  ListSort = "total_asc"
+	SortTotalDesc ListSort = "total_desc"
+)
+
+// ParseListSort validates a sort, which defaults to newest
+func ParseListSort(value string) (ListSort, error) {
+	switch ListSort(value) {
+	case "":
+		return SortNewest, nil
+	case SortNewest, SortOldest, SortTotalAsc, SortTotalDesc:
+		return ListSort(value), nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+// ListFilter selects and orders the orders returned by List. Unset fields do
+// not filter, so without a UserID the orders of all users are listed. Orders
+// are created in [CreatedFrom, CreatedTo) and their total is in [MinTotal,
+// MaxTotal], in the currency of the bounds. Query matches a prefix of the
+// email of the user or of the order ID.
+type ListFilter struct {
+	UserID      *uuid.UUID
+	Status      entity.OrderStatus
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MinTotal    *money.Money
+	MaxTotal    *money.Money
+	ProductID   *uuid.UUID
+	Query       string
+	Sort        ListSort
+	Limit       int
+	Offset      int
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListSort(t *testing.T) {
+	sort, err := ParseListSort("")
+	require.NoError(t, err)
+	assert.Equal(t, SortNewest, sort)
+
+	sort, err = ParseListSort("total_desc")
+	require.NoError(t, err)
+	assert.Equal(t, SortTotalDesc, sort)
+
+	_, err = ParseListSort("status")
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
@@ -2,10 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
+)
+
+var (
+	ErrStatusChanged = errors.New("order status changed")
 )
 
 // OrderRepository defines the interface for order data persistence
@@ -16,21 +23,30 @@ type OrderRepository interface {
 	// GetByID retrieves an order by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Order, error)
 
-	// GetByUserID retrieves orders for a user
-	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Order, error)
+	// List retrieves a page of the orders matching filter, along with the
+	// number of matching orders
+	List(ctx context.Context, filter ListFilter) ([]*entity.Order, int64, error)
 
 	// Update updates an existing order in the database
 	Update(ctx context.Context, order *entity.Order) error
 
-	// UpdateStatus updates the order status
-	UpdateStatus(ctx context.Context, id uuid.UUID, status entity.OrderStatus) error
+	// Transition saves the status an order moved to together with the
+	// recorded change and the outbox messages announcing it, failing with
+	// ErrStatusChanged if the order was no longer in the status the change
+	// moves it from
+	Transition(ctx context.Context, change *entity.OrderStatusHistory, messages ...*outbox.Message) error
+
+	// ListUnpaid retrieves up to limit orders still PENDING that were created
+	// before cutoff, oldest first. Pass the last order of a page as after to
+	// get the next one.
+	ListUnpaid(ctx context.Context, cutoff time.Time, after *entity.Order, limit int) ([]*entity.Order, error)
+
+	// GetStatusHistory retrieves the status changes of an order, oldest first
+	GetStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*entity.OrderStatusHistory, error)
 
 	// Delete removes an order from the database
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// CountByUserID counts total orders for a user
-	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
-
 	// Setup creates necessary indexes for the order table
 	Setup(ctx context.Context) error
 }
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type Status string
@@ -23,11 +26,14 @@ const (
 
 // Usecase defines the order business logic interface
 type Usecase interface {
-	CreateOrder(ctx context.Context, userID, cartID uuid.UUID, paymentMethod, shippingAddress string) (*OrderResponse, error)
+	CreateOrder(ctx context.Context, userID, cartID uuid.UUID, paymentMethod string, shipping Shipping) (*OrderResponse, error)
 	GetOrder(ctx context.Context, userID, orderID uuid.UUID) (*OrderResponse, error)
-	ListOrders(ctx context.Context, userID uuid.UUID, page, limit int32, status string) ([]*OrderResponse, int64, error)
+	ListOrders(ctx context.Context, userID uuid.UUID, req ListOrdersRequest) ([]*OrderResponse, int64, error)
+	// SearchOrders lists the orders of all users on behalf of an admin
+	SearchOrders(ctx context.Context, req ListOrdersRequest) ([]*OrderResponse, int64, error)
 	CancelOrder(ctx context.Context, userID, orderID uuid.UUID, reason string) error
-	UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, status Status) (*OrderResponse, error)
+	UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, status Status, reason string) (*OrderResponse, error)
+	GetOrderHistory(ctx context.Context, userID, orderID uuid.UUID) ([]StatusChange, error)
 	// CreateOrder creates a new order from the user's cart
 	//CreateOrder(ctx context.Context, userID uuid.UUID, cartID uuid.UUID, paymentMethod, shippingAddress string) (*pb.Order, error)
 	//
@@ -44,35 +50,98 @@ type Usecase interface {
 	//UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, status Status) (*pb.Order, error)
 }
 
+// Shipping says where an order is shipped to: the address of the user's
+// address book with ID AddressID if set, Address otherwise
+type Shipping struct {
+	AddressID *uuid.UUID
+	Address   Address
+}
+
+// Address is a shipping address. Its Country, an ISO 3166 alpha-2 code, and
+// Region decide the taxes of the order.
+type Address struct {
+	Recipient  string `json:"recipient"`
+	Phone      string `json:"phone,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
 type OrderResponse struct {
-	ID          uuid.UUID   `json:"id"`
-	UserID      uuid.UUID   `json:"user_id"`
-	Items       []OrderItem `json:"items"`
-	TotalAmount float64     `json:"total_amount"`
-	Status      Status      `json:"status"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID              uuid.UUID       `json:"id"`
+	UserID          uuid.UUID       `json:"user_id"`
+	Items           []OrderItem     `json:"items"`
+	Subtotal        money.Money     `json:"subtotal"`
+	Discounts       []OrderDiscount `json:"discounts"`
+	DiscountTotal   money.Money     `json:"discount_total"`
+	Taxes           []OrderTax      `json:"taxes"`
+	TaxTotal        money.Money     `json:"tax_total"`
+	TaxInclusive    bool            `json:"tax_inclusive"`
+	TotalAmount     money.Money     `json:"total_amount"`
+	ExchangeRate    fx.Rate         `json:"exchange_rate"`
+	ShippingAddress Address         `json:"shipping_address"`
+	Status          Status          `json:"status"`
+	History         []StatusChange  `json:"history,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// StatusChange is a status an order moved to, who moved it there and why
+type StatusChange struct {
+	FromStatus Status    `json:"from_status"`
+	ToStatus   Status    `json:"to_status"`
+	Actor      string    `json:"actor"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type OrderItem struct {
-	ID        uuid.UUID `json:"id"`
-	ProductID uuid.UUID `json:"product_id"`
-	Name      string    `json:"name"`
-	Price     float64   `json:"price"`
-	Quantity  int       `json:"quantity"`
-	Subtotal  float64   `json:"subtotal"`
+	ID               uuid.UUID   `json:"id"`
+	ProductID        uuid.UUID   `json:"product_id"`
+	Name             string      `json:"name"`
+	Price            money.Money `json:"price"`
+	Quantity         int         `json:"quantity"`
+	ReturnedQuantity int         `json:"returned_quantity"`
+	Subtotal         money.Money `json:"subtotal"`
+}
+
+type OrderDiscount struct {
+	PromotionID uuid.UUID   `json:"promotion_id"`
+	Code        string      `json:"code"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+}
+
+type OrderTax struct {
+	Name     string      `json:"name"`
+	Country  string      `json:"country"`
+	Region   string      `json:"region,omitempty"`
+	Category string      `json:"category,omitempty"`
+	Rate     float64     `json:"rate"`
+	Taxable  money.Money `json:"taxable"`
+	Amount   money.Money `json:"amount"`
 }
 
 // Common errors
 var (
-	ErrNotFound          = NewError("order not found")
-	ErrCartNotFound      = NewError("cart not found")
-	ErrCartEmpty         = NewError("cart is empty")
-	ErrCancelled         = NewError("order is already cancelled")
-	ErrCompleted         = NewError("order is already completed")
-	ErrInvalidStatus     = NewError("invalid order status")
-	ErrStatusTransition  = NewError("invalid status transition")
-	ErrOrderAlreadyFinal = NewError("order is in final state")
+	ErrNotFound            = NewError("order not found")
+	ErrCartNotFound        = NewError("cart not found")
+	ErrCartEmpty           = NewError("cart is empty")
+	ErrCancelled           = NewError("order is already cancelled")
+	ErrCompleted           = NewError("order is already completed")
+	ErrInvalidStatus       = NewError("invalid order status")
+	ErrStatusTransition    = NewError("invalid status transition")
+	ErrOrderAlreadyFinal   = NewError("order is in final state")
+	ErrCurrencyUnavailable = NewError("currency is not available")
+	ErrInvalidAddress      = NewError("shipping address needs a recipient, street, city and two-letter country")
+	ErrAddressNotFound     = NewError("shipping address not found")
+	ErrPaymentInProgress   = NewError("order payment is being processed, try again later")
+	ErrInvalidSort         = NewError("invalid sort")
+	ErrInvalidDateRange    = NewError("invalid date range")
+	ErrInvalidAmountRange  = NewError("invalid amount range")
 )
 
 // Error represents an order error
@@ -94,7 +163,7 @@ type Order struct {
 	ID              uuid.UUID
 	UserID          uuid.UUID
 	Items           []*OrderItem
-	Total           float64
+	Total           money.Money
 	Status          Status
 	PaymentMethod   string
 	PaymentID       *uuid.UUID
@@ -110,9 +179,20 @@ type CreateOrderRequest struct {
 	ShippingAddress string
 }
 
-// ListOrdersRequest represents the request to list orders
+// ListOrdersRequest selects, sorts and pages listed orders. Unset fields do
+// not filter. Orders are created in [CreatedFrom, CreatedTo) and their total
+// is in [MinTotal, MaxTotal], which must share a currency. Sort is newest,
+// oldest, total_asc or total_desc. Query, which only SearchOrders uses,
+// matches a prefix of the email of the user or of the order ID.
 type ListOrdersRequest struct {
-	Page   int
-	Limit  int
-	Status string
+	Page        int32
+	Limit       int32
+	Status      Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MinTotal    *money.Money
+	MaxTotal    *money.Money
+	ProductID   *uuid.UUID
+	Sort        string
+	Query       string
 }
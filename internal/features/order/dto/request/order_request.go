@@ -1,20 +1,51 @@
 package request
 
-// CreateOrderRequest represents the request to create a new order
+// CreateOrderRequest represents the request to create a new order. It is
+// shipped to the saved address AddressID, or else to ShippingAddress.
 type CreateOrderRequest struct {
-	CartID          string `json:"cart_id" validate:"required"`
-	PaymentMethod   string `json:"payment_method" validate:"required"`
-	ShippingAddress string `json:"shipping_address" validate:"required"`
+	CartID          string          `json:"cart_id" validate:"required"`
+	PaymentMethod   string          `json:"payment_method" validate:"required"`
+	AddressID       string          `json:"address_id" validate:"required_without=ShippingAddress,omitempty,uuid"`
+	ShippingAddress *AddressRequest `json:"shipping_address" validate:"required_without=AddressID"`
+}
+
+// AddressRequest represents a shipping address. Country is an ISO 3166
+// alpha-2 code; it and Region decide the taxes of the order.
+type AddressRequest struct {
+	Recipient  string `json:"recipient" validate:"required"`
+	Phone      string `json:"phone"`
+	Line1      string `json:"line1" validate:"required"`
+	Line2      string `json:"line2"`
+	City       string `json:"city" validate:"required"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country" validate:"required,len=2"`
 }
 
 // UpdateOrderStatusRequest represents the request to update an order's status
 type UpdateOrderStatusRequest struct {
 	Status string `json:"status" validate:"required,oneof=PENDING PAID SHIPPED DELIVERED CANCELLED"`
+	Reason string `json:"reason"`
 }
 
-// ListOrdersRequest represents the request to list user orders
+// ListOrdersRequest represents the request to list user orders. Dates are
+// RFC 3339 timestamps; totals are in minor units of Currency.
 type ListOrdersRequest struct {
-	Page     int    `form:"page" validate:"min=1"`
-	PageSize int    `form:"page_size" validate:"min=1,max=100"`
-	Status   string `form:"status" validate:"omitempty,oneof=PENDING PAID SHIPPED DELIVERED CANCELLED"`
+	Page        int    `query:"page" validate:"min=1"`
+	PageSize    int    `query:"page_size" validate:"min=1,max=100"`
+	Status      string `query:"status" validate:"omitempty,oneof=PENDING CONFIRMED PROCESSING SHIPPED DELIVERED CANCELLED FAILED COMPLETED"`
+	CreatedFrom string `query:"created_from"`
+	CreatedTo   string `query:"created_to"`
+	MinTotal    *int64 `query:"min_total" validate:"omitempty,min=0"`
+	MaxTotal    *int64 `query:"max_total" validate:"omitempty,min=0"`
+	Currency    string `query:"currency" validate:"required_with=MinTotal MaxTotal,omitempty,len=3"`
+	ProductID   string `query:"product_id" validate:"omitempty,uuid"`
+	Sort        string `query:"sort" validate:"omitempty,oneof=newest oldest total_asc total_desc"`
+}
+
+// SearchOrdersRequest represents the request to search the orders of all
+// users. Query matches a prefix of the email of the user or of the order ID.
+type SearchOrdersRequest struct {
+	ListOrdersRequest
+	Query string `query:"q"`
 }
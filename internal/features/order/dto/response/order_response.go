@@ -6,27 +6,30 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // OrderItemResponse represents an order item in responses
 type OrderItemResponse struct {
-	ID        uuid.UUID `json:"id"`
-	ProductID uuid.UUID `json:"product_id"`
-	Name      string    `json:"name"`
-	Price     float64   `json:"price"`
-	Quantity  int       `json:"quantity"`
-	Subtotal  float64   `json:"subtotal"`
+	ID        uuid.UUID   `json:"id"`
+	ProductID uuid.UUID   `json:"product_id"`
+	Name      string      `json:"name"`
+	Price     money.Money `json:"price"`
+	Quantity  int         `json:"quantity"`
+	Subtotal  money.Money `json:"subtotal"`
 }
 
 // OrderResponse represents an order in responses
 type OrderResponse struct {
-	ID          uuid.UUID           `json:"id"`
-	UserID      uuid.UUID           `json:"user_id"`
-	Items       []OrderItemResponse `json:"items"`
-	TotalAmount float64             `json:"total_amount"`
-	Status      string              `json:"status"`
-	CreatedAt   time.Time           `json:"created_at"`
-	UpdatedAt   time.Time           `json:"updated_at"`
+	ID           uuid.UUID           `json:"id"`
+	UserID       uuid.UUID           `json:"user_id"`
+	Items        []OrderItemResponse `json:"items"`
+	TotalAmount  money.Money         `json:"total_amount"`
+	ExchangeRate fx.Rate             `json:"exchange_rate"`
+	Status       string              `json:"status"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
 }
 
 // OrderListResponse represents a paginated list of orders
@@ -47,18 +50,19 @@ func NewOrderResponse(order *entity.Order) *OrderResponse {
 			Name:      item.Name,
 			Price:     item.Price,
 			Quantity:  item.Quantity,
-			Subtotal:  item.Price * float64(item.Quantity),
+			Subtotal:  item.Subtotal(),
 		}
 	}
 
 	return &OrderResponse{
-		ID:          order.ID,
-		UserID:      order.UserID,
-		Items:       items,
-		TotalAmount: order.TotalAmount,
-		Status:      string(order.Status),
-		CreatedAt:   order.CreatedAt,
-		UpdatedAt:   order.UpdatedAt,
+		ID:           order.ID,
+		UserID:       order.UserID,
+		Items:        items,
+		TotalAmount:  order.TotalAmount,
+		ExchangeRate: order.ExchangeRate,
+		Status:       string(order.Status),
+		CreatedAt:    order.CreatedAt,
+		UpdatedAt:    order.UpdatedAt,
 	}
 }
 
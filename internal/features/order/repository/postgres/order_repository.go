@@ -3,10 +3,15 @@ package postgres
 import (
 	"context"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
 )
 
 // OrderRepository implements the domain.OrderRepository interface
@@ -31,6 +36,8 @@ func (r *OrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Or
 	var order entity.Order
 	err := r.db.WithContext(ctx).
 		Preload("Items").
+		Preload("Discounts").
+		Preload("Taxes").
 		First(&order, "id = ?", id).Error
 	if err != nil {
 		return nil, err
@@ -38,20 +45,78 @@ func (r *OrderRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Or
 	return &order, nil
 }
 
-// GetByUserID retrieves orders for a user
-func (r *OrderRepository) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Order, error) {
+// List retrieves a page of the orders matching filter, along with the number
+// of matching orders
+func (r *OrderRepository) List(ctx context.Context, filter repository.ListFilter) ([]*entity.Order, int64, error) {
+	var total int64
+	if err := r.filtered(ctx, filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
 	var orders []*entity.Order
-	err := r.db.WithContext(ctx).
+	err := r.filtered(ctx, filter).
 		Preload("Items").
-		Where("user_id = ?", userID).
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
+		Preload("Discounts").
+		Preload("Taxes").
+		Order(listOrder(filter.Sort)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
 		Find(&orders).Error
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return orders, nil
+	return orders, total, nil
+}
+
+// filtered selects the orders matching filter
+func (r *OrderRepository) filtered(ctx context.Context, filter repository.ListFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&entity.Order{})
+	if filter.UserID != nil {
+		query = query.Where("orders.user_id = ?", *filter.UserID)
+	}
+	if filter.Status != "" {
+		query = query.Where("orders.status = ?", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("orders.created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("orders.created_at < ?", *filter.CreatedTo)
+	}
+	if filter.MinTotal != nil {
+		query = query.Where("orders.total_currency = ? AND orders.total_amount >= ?", filter.MinTotal.Currency, filter.MinTotal.Amount)
+	}
+	if filter.MaxTotal != nil {
+		query = query.Where("orders.total_currency = ? AND orders.total_amount <= ?", filter.MaxTotal.Currency, filter.MaxTotal.Amount)
+	}
+	if filter.ProductID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM order_items WHERE order_items.order_id = orders.id AND order_items.product_id = ?)", *filter.ProductID)
+	}
+	if filter.Query != "" {
+		prefix := likePrefix(strings.ToLower(filter.Query))
+		query = query.Where("(orders.id::text LIKE ? OR orders.user_id IN (SELECT id FROM users WHERE lower(email) LIKE ?))", prefix, prefix)
+	}
+	return query
+}
+
+// listOrder returns the ORDER BY clause of a sort; the ID breaks ties so that
+// pages never overlap
+func listOrder(sort repository.ListSort) string {
+	switch sort {
+	case repository.SortOldest:
+		return "orders.created_at ASC, orders.id ASC"
+	case repository.SortTotalAsc:
+		return "orders.total_amount ASC, orders.id ASC"
+	case repository.SortTotalDesc:
+		return "orders.total_amount DESC, orders.id DESC"
+	default:
+		return "orders.created_at DESC, orders.id DESC"
+	}
+}
+
+// likePrefix returns a LIKE pattern matching values starting with prefix
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix) + "%"
 }
 
 // Update updates an existing order in the database
@@ -59,27 +124,66 @@ func (r *OrderRepository) Update(ctx context.Context, order *entity.Order) error
 	return r.db.WithContext(ctx).Save(order).Error
 }
 
-// UpdateStatus updates the order status
-func (r *OrderRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.OrderStatus) error {
-	return r.db.WithContext(ctx).
-		Model(&entity.Order{}).
-		Where("id = ?", id).
-		Update("status", status).Error
+// Transition saves the new status of the order with the recorded change and
+// the outbox messages announcing it. It joins the inbox transaction carried by
+// ctx, if any, so that a saga step moves the order exactly once.
+func (r *OrderRepository) Transition(ctx context.Context, change *entity.OrderStatusHistory, messages ...*outbox.Message) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Order{}).
+			Where("id = ? AND status = ?", change.OrderID, change.FromStatus).
+			Updates(map[string]interface{}{
+				"status":     change.ToStatus,
+				"updated_at": change.CreatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrStatusChanged
+		}
+		if err := tx.Create(change).Error; err != nil {
+			return err
+		}
+		return outbox.Add(tx, messages...)
+	})
 }
 
-// Delete removes an order from the database
-func (r *OrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Order{}, "id = ?", id).Error
+// ListUnpaid retrieves up to limit PENDING orders created before cutoff,
+// ordered by creation time and ID so that pages never overlap
+func (r *OrderRepository) ListUnpaid(ctx context.Context, cutoff time.Time, after *entity.Order, limit int) ([]*entity.Order, error) {
+	query := r.db.WithContext(ctx).
+		Where("status = ? AND created_at < ?", entity.OrderStatusPending, cutoff)
+	if after != nil {
+		query = query.Where("(created_at, id) > (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var orders []*entity.Order
+	err := query.
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
 }
 
-// CountByUserID counts total orders for a user
-func (r *OrderRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
-	var count int64
+// GetStatusHistory retrieves the status changes of an order, oldest first
+func (r *OrderRepository) GetStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*entity.OrderStatusHistory, error) {
+	var history []*entity.OrderStatusHistory
 	err := r.db.WithContext(ctx).
-		Model(&entity.Order{}).
-		Where("user_id = ?", userID).
-		Count(&count).Error
-	return count, err
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// Delete removes an order from the database
+func (r *OrderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&entity.Order{}, "id = ?", id).Error
 }
 
 // Setup creates necessary indexes for the order tables
@@ -88,7 +192,12 @@ func (r *OrderRepository) Setup(ctx context.Context) error {
 	err := r.db.WithContext(ctx).Exec(`
 		CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders(user_id);
 		CREATE INDEX IF NOT EXISTS idx_orders_status ON orders(status);
+		CREATE INDEX IF NOT EXISTS idx_orders_status_created_at ON orders(status, created_at);
+		CREATE INDEX IF NOT EXISTS idx_orders_user_id_created_at ON orders(user_id, created_at);
 		CREATE INDEX IF NOT EXISTS idx_order_items_order_id ON order_items(order_id);
+		CREATE INDEX IF NOT EXISTS idx_order_items_product_id ON order_items(product_id);
+		CREATE INDEX IF NOT EXISTS idx_order_discounts_order_id ON order_discounts(order_id);
+		CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history(order_id);
 	`).Error
 	return err
 }
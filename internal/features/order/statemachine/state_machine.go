@@ -0,0 +1,55 @@
+package statemachine
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
+)
+
+// OrderStateMachine moves orders between statuses. Every status change goes
+// through it, so that orders only make the transitions entity.Order allows
+// and each of them is appended to the status history.
+type OrderStateMachine struct {
+	orderRepo repository.OrderRepository
+}
+
+// NewOrderStateMachine creates a new order state machine
+func NewOrderStateMachine(orderRepo repository.OrderRepository) *OrderStateMachine {
+	return &OrderStateMachine{
+		orderRepo: orderRepo,
+	}
+}
+
+// Transition loads the order and moves it to status on behalf of actor
+func (m *OrderStateMachine) Transition(ctx context.Context, orderID uuid.UUID, status entity.OrderStatus, actor, reason string, messages ...*outbox.Message) (*entity.Order, error) {
+	order, err := m.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Apply(ctx, order, status, actor, reason, messages...); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// Apply moves a loaded order to status on behalf of actor. It fails with
+// entity.ErrInvalidTransition if the order may not move there, and with
+// repository.ErrStatusChanged if the order moved in the meantime. Moving an
+// order to the status it already has is a no-op, so that a redelivered saga
+// step or a repeated tracking update changes nothing. The messages are added
+// to the outbox together with the change.
+func (m *OrderStateMachine) Apply(ctx context.Context, order *entity.Order, status entity.OrderStatus, actor, reason string, messages ...*outbox.Message) error {
+	if order.Status == status {
+		return nil
+	}
+
+	change, err := order.Transition(status, actor, reason)
+	if err != nil {
+		return err
+	}
+	return m.orderRepo.Transition(ctx, change, messages...)
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/statemachine"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
+)
+
+// expiryBatchSize is the number of unpaid orders loaded per query
+const expiryBatchSize = 100
+
+// PaymentSagas tells whether the order-payment saga of an order is in flight
+type PaymentSagas interface {
+	IsOrderSagaRunning(ctx context.Context, orderID uuid.UUID) (bool, error)
+}
+
+// StockReleaser gives the stock reserved for an order back. It must write
+// through inbox.Tx, so that the release joins the cancellation of the order.
+type StockReleaser interface {
+	ReleaseStock(ctx context.Context, orderID uuid.UUID) error
+}
+
+// OrderExpirer cancels orders left PENDING for longer than the payment window
+// and announces each of them with an order.expired event. Orders whose saga is
+// still in flight are left to the saga, which confirms or cancels them. It runs
+// once at startup and then on every interval.
+type OrderExpirer struct {
+	db        *gorm.DB
+	orderRepo repository.OrderRepository
+	states    *statemachine.OrderStateMachine
+	sagas     PaymentSagas
+	stock     StockReleaser
+	window    time.Duration
+	interval  time.Duration
+}
+
+// NewOrderExpirer creates a new order expirer
+func NewOrderExpirer(db *gorm.DB, orderRepo repository.OrderRepository, sagas PaymentSagas, stock StockReleaser, window, interval time.Duration) *OrderExpirer {
+	return &OrderExpirer{
+		db:        db,
+		orderRepo: orderRepo,
+		states:    statemachine.NewOrderStateMachine(orderRepo),
+		sagas:     sagas,
+		stock:     stock,
+		window:    window,
+		interval:  interval,
+	}
+}
+
+// Run expires unpaid orders until the context is cancelled
+func (e *OrderExpirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := e.ExpireOrders(ctx)
+		if err != nil {
+			log.Printf("Error expiring unpaid orders: %v", err)
+		} else if expired > 0 {
+			log.Printf("Expired %d unpaid orders", expired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireOrders cancels the orders created before the payment window that are
+// still unpaid and returns how many were cancelled. An order that fails to
+// expire is logged and tried again on the next run.
+func (e *OrderExpirer) ExpireOrders(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-e.window)
+	expired := 0
+
+	var after *entity.Order
+	for {
+		orders, err := e.orderRepo.ListUnpaid(ctx, cutoff, after, expiryBatchSize)
+		if err != nil {
+			return expired, fmt.Errorf("failed to list unpaid orders: %w", err)
+		}
+
+		for _, order := range orders {
+			ok, err := e.expire(ctx, order)
+			if err != nil {
+				log.Printf("Error expiring order %s: %v", order.ID, err)
+				continue
+			}
+			if ok {
+				expired++
+			}
+		}
+
+		if len(orders) < expiryBatchSize || ctx.Err() != nil {
+			return expired, ctx.Err()
+		}
+		after = orders[len(orders)-1]
+	}
+}
+
+// expire cancels an unpaid order and reports whether it did. The order is
+// left alone while its saga runs, or if it moved on in the meantime.
+func (e *OrderExpirer) expire(ctx context.Context, order *entity.Order) (bool, error) {
+	running, err := e.sagas.IsOrderSagaRunning(ctx, order.ID)
+	if err != nil {
+		return false, err
+	}
+	if running {
+		return false, nil
+	}
+
+	msg, err := expiredMessage(order)
+	if err != nil {
+		return false, err
+	}
+	reason := fmt.Sprintf("not paid within %s", e.window)
+
+	// Reservations left behind by an abandoned saga are given back in the
+	// transaction that cancels the order, so that the stock of an order paid
+	// in the meantime is kept, and a failed release is retried with the order
+	// still PENDING
+	err = inbox.Transaction(ctx, e.db, func(ctx context.Context) error {
+		if err := e.states.Apply(ctx, order, entity.OrderStatusCancelled, entity.ActorSystem, reason, msg); err != nil {
+			return err
+		}
+		if err := e.stock.ReleaseStock(ctx, order.ID); err != nil {
+			return fmt.Errorf("failed to release stock: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, repository.ErrStatusChanged) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// expiredMessage builds the outbox message announcing that the order expired
+func expiredMessage(order *entity.Order) (*outbox.Message, error) {
+	payload, err := json.Marshal(entity.OrderExpired{
+		OrderID:     order.ID,
+		UserID:      order.UserID,
+		TotalAmount: order.TotalAmount,
+		ExpiredAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal order expired event: %w", err)
+	}
+	return outbox.NewMessage(order.ID, entity.TopicOrderExpired, payload), nil
+}
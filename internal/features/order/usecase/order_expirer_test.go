@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/testutil"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
+)
+
+type fakePaymentSagas struct {
+	running bool
+}
+
+func (f *fakePaymentSagas) IsOrderSagaRunning(ctx context.Context, orderID uuid.UUID) (bool, error) {
+	return f.running, nil
+}
+
+type fakeStockReleaser struct {
+	err      error
+	released []uuid.UUID
+}
+
+func (f *fakeStockReleaser) ReleaseStock(ctx context.Context, orderID uuid.UUID) error {
+	f.released = append(f.released, orderID)
+	return f.err
+}
+
+func newPendingOrder() *entity.Order {
+	return &entity.Order{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Status:    entity.OrderStatusPending,
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+}
+
+func TestOrderExpirer_Expire_SagaRunning(t *testing.T) {
+	stock := &fakeStockReleaser{}
+	expirer := NewOrderExpirer(nil, nil, &fakePaymentSagas{running: true}, stock, 30*time.Minute, time.Minute)
+	order := newPendingOrder()
+
+	// The saga confirms or cancels the order itself
+	expired, err := expirer.expire(context.Background(), order)
+	require.NoError(t, err)
+	assert.False(t, expired)
+	assert.Equal(t, entity.OrderStatusPending, order.Status)
+	assert.Empty(t, stock.released)
+}
+
+func TestOrderExpirer_Expire(t *testing.T) {
+	testutil.SkipWithoutPostgres(t)
+	db := testutil.NewTestPostgres(t)
+	t.Cleanup(func() { db.Cleanup(t) })
+
+	require.NoError(t, db.Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`).Error)
+	require.NoError(t, db.AutoMigrate(&entity.Order{}, &entity.OrderStatusHistory{}))
+	for _, migration := range outbox.NewRepository(db.DB).Migrations() {
+		require.NoError(t, db.Exec(migration).Error)
+	}
+
+	orderRepo := postgres.NewOrderRepository(db.DB)
+	ctx := context.Background()
+
+	stored := func(t *testing.T, order *entity.Order) (entity.OrderStatus, int64) {
+		t.Helper()
+		saved, err := orderRepo.GetByID(ctx, order.ID)
+		require.NoError(t, err)
+		var messages int64
+		require.NoError(t, db.Model(&outbox.Message{}).
+			Where("aggregate_id = ? AND topic = ?", order.ID, entity.TopicOrderExpired).
+			Count(&messages).Error)
+		return saved.Status, messages
+	}
+
+	t.Run("cancels the order and releases its stock", func(t *testing.T) {
+		stock := &fakeStockReleaser{}
+		expirer := NewOrderExpirer(db.DB, orderRepo, &fakePaymentSagas{}, stock, 30*time.Minute, time.Minute)
+		order := newPendingOrder()
+		require.NoError(t, orderRepo.Create(ctx, order))
+
+		expired, err := expirer.expire(ctx, order)
+		require.NoError(t, err)
+		assert.True(t, expired)
+		assert.Equal(t, []uuid.UUID{order.ID}, stock.released)
+
+		status, messages := stored(t, order)
+		assert.Equal(t, entity.OrderStatusCancelled, status)
+		assert.EqualValues(t, 1, messages)
+	})
+
+	t.Run("leaves an order that moved on alone", func(t *testing.T) {
+		stock := &fakeStockReleaser{}
+		expirer := NewOrderExpirer(db.DB, orderRepo, &fakePaymentSagas{}, stock, 30*time.Minute, time.Minute)
+		order := newPendingOrder()
+		require.NoError(t, orderRepo.Create(ctx, order))
+
+		// The order is paid after it was listed as unpaid
+		paid := *order
+		require.NoError(t, expirer.states.Apply(ctx, &paid, entity.OrderStatusConfirmed, entity.ActorSystem, "paid"))
+
+		expired, err := expirer.expire(ctx, order)
+		require.NoError(t, err)
+		assert.False(t, expired)
+		assert.Empty(t, stock.released)
+
+		status, messages := stored(t, order)
+		assert.Equal(t, entity.OrderStatusConfirmed, status)
+		assert.Zero(t, messages)
+	})
+
+	t.Run("keeps the order pending when the stock is not released", func(t *testing.T) {
+		stock := &fakeStockReleaser{err: errors.New("connection refused")}
+		expirer := NewOrderExpirer(db.DB, orderRepo, &fakePaymentSagas{}, stock, 30*time.Minute, time.Minute)
+		order := newPendingOrder()
+		require.NoError(t, orderRepo.Create(ctx, order))
+
+		expired, err := expirer.expire(ctx, order)
+		assert.ErrorIs(t, err, stock.err)
+		assert.False(t, expired)
+
+		status, messages := stored(t, order)
+		assert.Equal(t, entity.OrderStatusPending, status)
+		assert.Zero(t, messages)
+	})
+}
@@ -5,11 +5,24 @@ import (
 	"errors"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/usecase"
+	"strings"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 
+	address "github.com/diki-haryadi/ecommerce-saga/internal/features/address/domain/usecase"
 	cartRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/repository"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/statemachine"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	tax "github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+const (
+	defaultPageLimit = 10
+	maxPageLimit     = 100
 )
 
 var (
@@ -25,20 +38,53 @@ var (
 	ErrCompleted = errors.New("order is already completed")
 )
 
+// Pricer prices order items with the coupon applied to the cart
+type Pricer interface {
+	PriceItems(ctx context.Context, userID uuid.UUID, code string, items []promotion.LineItem) (*promotion.Breakdown, error)
+}
+
+// AddressBook looks up the saved addresses of a user
+type AddressBook interface {
+	GetAddress(ctx context.Context, userID, addressID uuid.UUID) (*address.AddressResponse, error)
+}
+
+// OrderSagas undoes the order-payment saga of a cancelled order. It reports
+// false if the saga is being processed and cannot be stopped right now.
+type OrderSagas interface {
+	CompensateOrderSaga(ctx context.Context, orderID uuid.UUID, reason string) (bool, error)
+}
+
 type OrderUsecase struct {
 	orderRepo repository.OrderRepository
 	cartRepo  cartRepo.CartRepository
+	pricer    Pricer
+	rates     fx.Provider
+	taxes     tax.TaxCalculator
+	addresses AddressBook
+	sagas     OrderSagas
+	states    *statemachine.OrderStateMachine
 }
 
-func NewOrderUsecase(orderRepo repository.OrderRepository, cartRepo cartRepo.CartRepository) *OrderUsecase {
+// NewOrderUsecase creates a new order usecase. Orders are created without
+// discounts when pricer is nil, in the currency of the cart at the rates of
+// rates, and without taxes when taxes is nil. They are shipped to an address
+// given with the order when addresses is nil. Cancelled orders keep their
+// payment and stock when sagas is nil.
+func NewOrderUsecase(orderRepo repository.OrderRepository, cartRepo cartRepo.CartRepository, pricer Pricer, rates fx.Provider, taxes tax.TaxCalculator, addresses AddressBook, sagas OrderSagas) *OrderUsecase {
 	return &OrderUsecase{
 		orderRepo: orderRepo,
 		cartRepo:  cartRepo,
+		pricer:    pricer,
+		rates:     rates,
+		taxes:     taxes,
+		addresses: addresses,
+		sagas:     sagas,
+		states:    statemachine.NewOrderStateMachine(orderRepo),
 	}
 }
 
 // CreateOrder creates a new order from a cart
-func (u *OrderUsecase) CreateOrder(ctx context.Context, userID, cartID uuid.UUID, paymentMethod, shippingAddress string) (*usecase.OrderResponse, error) {
+func (u *OrderUsecase) CreateOrder(ctx context.Context, userID, cartID uuid.UUID, paymentMethod string, shipping usecase.Shipping) (*usecase.OrderResponse, error) {
 	// Get cart
 	cart, err := u.cartRepo.GetByID(ctx, cartID)
 	if err != nil {
@@ -56,20 +102,66 @@ func (u *OrderUsecase) CreateOrder(ctx context.Context, userID, cartID uuid.UUID
 		return nil, usecase.ErrCartNotFound
 	}
 
+	// Copy the address the order is shipped to
+	shippingAddress, err := u.shippingAddress(ctx, userID, shipping)
+	if err != nil {
+		return nil, err
+	}
+
+	// Price the items in the currency the shopper chose
+	currency := cart.Currency
+	if currency == "" {
+		currency = cart.Items[0].Price.Currency
+	}
+	rate, err := fx.Lookup(ctx, u.rates, cart.Items[0].Price.Currency, currency)
+	if errors.Is(err, fx.ErrRateNotFound) {
+		return nil, usecase.ErrCurrencyUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cart, err = cart.Convert(rate); err != nil {
+		return nil, err
+	}
+
 	// Create order items
 	items := make([]entity.OrderItem, len(cart.Items))
 	for i, cartItem := range cart.Items {
 		items[i] = entity.OrderItem{
-			ID:        uuid.New(),
-			ProductID: cartItem.ProductID,
-			Name:      cartItem.Name,
-			Price:     cartItem.Price,
-			Quantity:  cartItem.Quantity,
+			ID:          uuid.New(),
+			ProductID:   cartItem.ProductID,
+			Name:        cartItem.Name,
+			Price:       cartItem.Price,
+			TaxCategory: cartItem.TaxCategory,
+			Quantity:    cartItem.Quantity,
 		}
 	}
 
 	// Create order
-	newOrder := entity.NewOrder(userID, items)
+	newOrder, err := entity.NewOrder(userID, items)
+	if err != nil {
+		return nil, err
+	}
+	newOrder.ExchangeRate = rate
+	newOrder.ShippingAddress = shippingAddress
+
+	// Apply the cart's coupon
+	if u.pricer != nil && cart.CouponCode != "" {
+		discounts, err := u.priceOrder(ctx, userID, cart.CouponCode, items)
+		if err != nil {
+			return nil, err
+		}
+		if err := newOrder.ApplyDiscounts(discounts); err != nil {
+			return nil, err
+		}
+	}
+
+	// Tax what is paid for the items once discounted
+	if u.taxes != nil {
+		if err := u.taxOrder(ctx, newOrder); err != nil {
+			return nil, err
+		}
+	}
 
 	// Save order
 	if err := u.orderRepo.Create(ctx, newOrder); err != nil {
@@ -82,33 +174,211 @@ func (u *OrderUsecase) CreateOrder(ctx context.Context, userID, cartID uuid.UUID
 	}
 
 	// Convert to response
+	return u.convertOrder(newOrder), nil
+}
+
+// priceOrder returns the discounts the coupon takes off the items
+func (u *OrderUsecase) priceOrder(ctx context.Context, userID uuid.UUID, code string, items []entity.OrderItem) ([]entity.OrderDiscount, error) {
+	lines := make([]promotion.LineItem, len(items))
+	for i, item := range items {
+		lines[i] = promotion.LineItem{
+			ProductID: item.ProductID,
+			Price:     item.Price,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	breakdown, err := u.pricer.PriceItems(ctx, userID, code, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts := make([]entity.OrderDiscount, len(breakdown.Discounts))
+	for i, discount := range breakdown.Discounts {
+		discounts[i] = entity.OrderDiscount{
+			ID:          uuid.New(),
+			PromotionID: discount.PromotionID,
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      discount.Amount,
+		}
+	}
+	return discounts, nil
+}
+
+// shippingAddress returns the address the order is shipped to, taken from the
+// user's address book if shipping names one
+func (u *OrderUsecase) shippingAddress(ctx context.Context, userID uuid.UUID, shipping usecase.Shipping) (entity.ShippingAddress, error) {
+	input := shipping.Address
+	if shipping.AddressID != nil {
+		if u.addresses == nil {
+			return entity.ShippingAddress{}, usecase.ErrAddressNotFound
+		}
+		saved, err := u.addresses.GetAddress(ctx, userID, *shipping.AddressID)
+		if errors.Is(err, address.ErrNotFound) {
+			return entity.ShippingAddress{}, usecase.ErrAddressNotFound
+		}
+		if err != nil {
+			return entity.ShippingAddress{}, err
+		}
+		input = usecase.Address{
+			Recipient:  saved.Recipient,
+			Phone:      saved.Phone,
+			Line1:      saved.Line1,
+			Line2:      saved.Line2,
+			City:       saved.City,
+			Region:     saved.Region,
+			PostalCode: saved.PostalCode,
+			Country:    saved.Country,
+		}
+	}
+
+	shippingAddress := entity.ShippingAddress{
+		Recipient:  strings.TrimSpace(input.Recipient),
+		Phone:      strings.TrimSpace(input.Phone),
+		Line1:      strings.TrimSpace(input.Line1),
+		Line2:      strings.TrimSpace(input.Line2),
+		City:       strings.TrimSpace(input.City),
+		Region:     strings.ToUpper(strings.TrimSpace(input.Region)),
+		PostalCode: strings.TrimSpace(input.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(input.Country)),
+	}
+	if shippingAddress.Recipient == "" || shippingAddress.Line1 == "" ||
+		shippingAddress.City == "" || len(shippingAddress.Country) != 2 {
+		return entity.ShippingAddress{}, usecase.ErrInvalidAddress
+	}
+	return shippingAddress, nil
+}
+
+// taxOrder applies the taxes of the shipping address to the order. The
+// discount total is spread over the items in proportion to their subtotals.
+func (u *OrderUsecase) taxOrder(ctx context.Context, order *entity.Order) error {
+	amounts := make([]money.Money, len(order.Items))
+	weights := make([]int64, len(order.Items))
+	for i, item := range order.Items {
+		amounts[i] = item.Subtotal()
+		weights[i] = amounts[i].Amount
+	}
+	if order.DiscountTotal.IsPositive() {
+		shares, err := order.DiscountTotal.Allocate(weights)
+		if err != nil {
+			return err
+		}
+		for i, share := range shares {
+			if amounts[i], err = amounts[i].Sub(share); err != nil {
+				return err
+			}
+		}
+	}
+
+	lines := make([]tax.Line, len(order.Items))
+	for i, item := range order.Items {
+		lines[i] = tax.Line{
+			ProductID: item.ProductID,
+			Category:  item.TaxCategory,
+			Amount:    amounts[i],
+		}
+	}
+
+	result, err := u.taxes.Calculate(ctx, tax.Location{
+		Country: order.ShippingAddress.Country,
+		Region:  order.ShippingAddress.Region,
+	}, lines)
+	if err != nil {
+		return err
+	}
+
+	taxes := make([]entity.OrderTax, len(result.Lines))
+	for i, line := range result.Lines {
+		taxes[i] = entity.OrderTax{
+			ID:       uuid.New(),
+			Name:     line.Name,
+			Country:  line.Country,
+			Region:   line.Region,
+			Category: line.Category,
+			Rate:     line.Rate,
+			Taxable:  line.Taxable,
+			Amount:   line.Amount,
+		}
+	}
+	return order.ApplyTaxes(taxes, result.Inclusive)
+}
+
+func (u *OrderUsecase) convertOrder(o *entity.Order) *usecase.OrderResponse {
 	return &usecase.OrderResponse{
-		ID:          newOrder.ID,
-		UserID:      newOrder.UserID,
-		Items:       u.convertItems(items),
-		TotalAmount: newOrder.TotalAmount,
-		Status:      usecase.Status(newOrder.Status),
-		CreatedAt:   newOrder.CreatedAt,
-		UpdatedAt:   newOrder.UpdatedAt,
-	}, nil
+		ID:            o.ID,
+		UserID:        o.UserID,
+		Items:         u.convertItems(o.Items),
+		Subtotal:      o.Subtotal,
+		Discounts:     u.convertDiscounts(o.Discounts),
+		DiscountTotal: o.DiscountTotal,
+		Taxes:         u.convertTaxes(o.Taxes),
+		TaxTotal:      o.TaxTotal,
+		TaxInclusive:  o.TaxInclusive,
+		TotalAmount:   o.TotalAmount,
+		ExchangeRate:  o.ExchangeRate,
+		ShippingAddress: usecase.Address{
+			Recipient:  o.ShippingAddress.Recipient,
+			Phone:      o.ShippingAddress.Phone,
+			Line1:      o.ShippingAddress.Line1,
+			Line2:      o.ShippingAddress.Line2,
+			City:       o.ShippingAddress.City,
+			Region:     o.ShippingAddress.Region,
+			PostalCode: o.ShippingAddress.PostalCode,
+			Country:    o.ShippingAddress.Country,
+		},
+		Status:    usecase.Status(o.Status),
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func (u *OrderUsecase) convertDiscounts(discounts []entity.OrderDiscount) []usecase.OrderDiscount {
+	result := make([]usecase.OrderDiscount, len(discounts))
+	for i, discount := range discounts {
+		result[i] = usecase.OrderDiscount{
+			PromotionID: discount.PromotionID,
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      discount.Amount,
+		}
+	}
+	return result
+}
+
+func (u *OrderUsecase) convertTaxes(taxes []entity.OrderTax) []usecase.OrderTax {
+	result := make([]usecase.OrderTax, len(taxes))
+	for i, orderTax := range taxes {
+		result[i] = usecase.OrderTax{
+			Name:     orderTax.Name,
+			Country:  orderTax.Country,
+			Region:   orderTax.Region,
+			Category: orderTax.Category,
+			Rate:     orderTax.Rate,
+			Taxable:  orderTax.Taxable,
+			Amount:   orderTax.Amount,
+		}
+	}
+	return result
 }
 
 func (u *OrderUsecase) convertItems(items []entity.OrderItem) []usecase.OrderItem {
 	result := make([]usecase.OrderItem, len(items))
 	for i, item := range items {
 		result[i] = usecase.OrderItem{
-			ID:        item.ID,
-			ProductID: item.ProductID,
-			Name:      item.Name,
-			Price:     item.Price,
-			Quantity:  item.Quantity,
-			Subtotal:  item.Price * float64(item.Quantity),
+			ID:               item.ID,
+			ProductID:        item.ProductID,
+			Name:             item.Name,
+			Price:            item.Price,
+			Quantity:         item.Quantity,
+			ReturnedQuantity: item.ReturnedQuantity,
+			Subtotal:         item.Subtotal(),
 		}
 	}
 	return result
 }
 
-// GetOrder retrieves an order by ID
+// GetOrder retrieves an order of the user with its status history
 func (u *OrderUsecase) GetOrder(ctx context.Context, userID, orderID uuid.UUID) (*usecase.OrderResponse, error) {
 	orderEntity, err := u.orderRepo.GetByID(ctx, orderID)
 	if err != nil {
@@ -118,30 +388,59 @@ func (u *OrderUsecase) GetOrder(ctx context.Context, userID, orderID uuid.UUID)
 		return nil, usecase.ErrNotFound
 	}
 
-	return &usecase.OrderResponse{
-		ID:          orderEntity.ID,
-		UserID:      orderEntity.UserID,
-		Items:       u.convertItems(orderEntity.Items),
-		TotalAmount: orderEntity.TotalAmount,
-		Status:      usecase.Status(orderEntity.Status),
-		CreatedAt:   orderEntity.CreatedAt,
-		UpdatedAt:   orderEntity.UpdatedAt,
-	}, nil
+	history, err := u.orderRepo.GetStatusHistory(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := u.convertOrder(orderEntity)
+	resp.History = convertHistory(history)
+	return resp, nil
 }
 
-// ListOrders retrieves a paginated list of orders for a user
-func (u *OrderUsecase) ListOrders(ctx context.Context, userID uuid.UUID, page, limit int32, status string) ([]*usecase.OrderResponse, int64, error) {
-	// Get total count
-	totalRows, err := u.orderRepo.CountByUserID(ctx, userID)
+// GetOrderHistory retrieves the status changes of an order of the user, oldest first
+func (u *OrderUsecase) GetOrderHistory(ctx context.Context, userID, orderID uuid.UUID) ([]usecase.StatusChange, error) {
+	orderEntity, err := u.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && (orderEntity == nil || orderEntity.UserID != userID)) {
+		return nil, usecase.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := u.orderRepo.GetStatusHistory(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return convertHistory(history), nil
+}
+
+// ListOrders retrieves a filtered, paginated list of orders for a user
+func (u *OrderUsecase) ListOrders(ctx context.Context, userID uuid.UUID, req usecase.ListOrdersRequest) ([]*usecase.OrderResponse, int64, error) {
+	filter, err := listFilter(req)
 	if err != nil {
 		return nil, 0, err
 	}
+	filter.UserID = &userID
+	filter.Query = ""
 
-	// Calculate pagination
-	offset := (page - 1) * limit
+	return u.listOrders(ctx, filter)
+}
+
+// SearchOrders retrieves a filtered, paginated list of the orders of all
+// users, matching the email of the user or the order ID by prefix
+func (u *OrderUsecase) SearchOrders(ctx context.Context, req usecase.ListOrdersRequest) ([]*usecase.OrderResponse, int64, error) {
+	filter, err := listFilter(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return u.listOrders(ctx, filter)
+}
 
-	// Get orders
-	orders, err := u.orderRepo.GetByUserID(ctx, userID, int(limit), int(offset))
+// listOrders retrieves the orders matching filter
+func (u *OrderUsecase) listOrders(ctx context.Context, filter repository.ListFilter) ([]*usecase.OrderResponse, int64, error) {
+	orders, total, err := u.orderRepo.List(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -149,22 +448,64 @@ func (u *OrderUsecase) ListOrders(ctx context.Context, userID uuid.UUID, page, l
 	// Convert to response
 	result := make([]*usecase.OrderResponse, len(orders))
 	for i, o := range orders {
-		result[i] = &usecase.OrderResponse{
-			ID:          o.ID,
-			UserID:      o.UserID,
-			Items:       u.convertItems(o.Items),
-			TotalAmount: o.TotalAmount,
-			Status:      usecase.Status(o.Status),
-			CreatedAt:   o.CreatedAt,
-			UpdatedAt:   o.UpdatedAt,
+		result[i] = u.convertOrder(o)
+	}
+
+	return result, total, nil
+}
+
+// listFilter validates a list request and turns it into a repository filter
+func listFilter(req usecase.ListOrdersRequest) (repository.ListFilter, error) {
+	sort, err := repository.ParseListSort(req.Sort)
+	if err != nil {
+		return repository.ListFilter{}, usecase.ErrInvalidSort
+	}
+
+	status := entity.OrderStatus(strings.ToUpper(string(req.Status)))
+	if status != "" && !status.IsValid() {
+		return repository.ListFilter{}, usecase.ErrInvalidStatus
+	}
+
+	if req.CreatedFrom != nil && req.CreatedTo != nil && !req.CreatedFrom.Before(*req.CreatedTo) {
+		return repository.ListFilter{}, usecase.ErrInvalidDateRange
+	}
+
+	if (req.MinTotal != nil && req.MinTotal.IsNegative()) || (req.MaxTotal != nil && req.MaxTotal.IsNegative()) {
+		return repository.ListFilter{}, usecase.ErrInvalidAmountRange
+	}
+	if req.MinTotal != nil && req.MaxTotal != nil {
+		if cmp, err := req.MinTotal.Cmp(*req.MaxTotal); err != nil || cmp > 0 {
+			return repository.ListFilter{}, usecase.ErrInvalidAmountRange
 		}
 	}
 
-	return result, totalRows, nil
+	page, limit := req.Page, req.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	return repository.ListFilter{
+		Status:      status,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		MinTotal:    req.MinTotal,
+		MaxTotal:    req.MaxTotal,
+		ProductID:   req.ProductID,
+		Query:       strings.TrimSpace(req.Query),
+		Sort:        sort,
+		Limit:       int(limit),
+		Offset:      int((page - 1) * limit),
+	}, nil
 }
 
-// UpdateOrderStatus updates the status of an order
-func (u *OrderUsecase) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, status usecase.Status) (*usecase.OrderResponse, error) {
+// UpdateOrderStatus moves an order to a status on behalf of an admin
+func (u *OrderUsecase) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID, status usecase.Status, reason string) (*usecase.OrderResponse, error) {
 	// Get order
 	orderEntity, err := u.orderRepo.GetByID(ctx, orderID)
 	if err != nil {
@@ -184,8 +525,8 @@ func (u *OrderUsecase) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID,
 	}
 
 	// Update status
-	if err := u.orderRepo.UpdateStatus(ctx, orderID, newStatus); err != nil {
-		return nil, err
+	if err := u.states.Apply(ctx, orderEntity, newStatus, entity.ActorAdmin, reason); err != nil {
+		return nil, transitionError(err)
 	}
 
 	// Get updated order
@@ -194,15 +535,7 @@ func (u *OrderUsecase) UpdateOrderStatus(ctx context.Context, orderID uuid.UUID,
 		return nil, err
 	}
 
-	return &usecase.OrderResponse{
-		ID:          orderEntity.ID,
-		UserID:      orderEntity.UserID,
-		Items:       u.convertItems(orderEntity.Items),
-		TotalAmount: orderEntity.TotalAmount,
-		Status:      usecase.Status(orderEntity.Status),
-		CreatedAt:   orderEntity.CreatedAt,
-		UpdatedAt:   orderEntity.UpdatedAt,
-	}, nil
+	return u.convertOrder(orderEntity), nil
 }
 
 // CancelOrder cancels an order if possible
@@ -224,10 +557,57 @@ func (u *OrderUsecase) CancelOrder(ctx context.Context, userID, orderID uuid.UUI
 		return usecase.ErrCompleted
 	}
 
-	// Update status to cancelled
-	if err := u.orderRepo.UpdateStatus(ctx, orderID, entity.OrderStatus(usecase.StatusCancelled)); err != nil {
-		return err
+	if !orderEntity.CanTransitionTo(entity.OrderStatusCancelled) {
+		return usecase.ErrStatusTransition
+	}
+
+	// A confirmed order may have been paid and its stock reserved; both are
+	// given back by compensating its saga before the order is cancelled
+	if u.sagas != nil && (orderEntity.Status == entity.OrderStatusConfirmed || orderEntity.Status == entity.OrderStatusProcessing) {
+		accepted, err := u.sagas.CompensateOrderSaga(ctx, orderID, cancellationReason(reason))
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			return usecase.ErrPaymentInProgress
+		}
+	}
+
+	// Update status to cancelled. The saga may have cancelled the order already.
+	if _, err := u.states.Transition(ctx, orderID, entity.OrderStatusCancelled, entity.ActorCustomer, reason); err != nil {
+		return transitionError(err)
 	}
 
 	return nil
 }
+
+// cancellationReason returns why the saga of an order cancelled for reason is compensated
+func cancellationReason(reason string) string {
+	if reason == "" {
+		return "order cancelled by customer"
+	}
+	return "order cancelled by customer: " + reason
+}
+
+// transitionError maps the state machine errors to usecase errors
+func transitionError(err error) error {
+	if errors.Is(err, entity.ErrInvalidTransition) || errors.Is(err, repository.ErrStatusChanged) {
+		return usecase.ErrStatusTransition
+	}
+	return err
+}
+
+// convertHistory converts recorded status changes to their response
+func convertHistory(history []*entity.OrderStatusHistory) []usecase.StatusChange {
+	result := make([]usecase.StatusChange, len(history))
+	for i, change := range history {
+		result[i] = usecase.StatusChange{
+			FromStatus: usecase.Status(change.FromStatus),
+			ToStatus:   usecase.Status(change.ToStatus),
+			Actor:      change.Actor,
+			Reason:     change.Reason,
+			CreatedAt:  change.CreatedAt,
+		}
+	}
+	return result
+}
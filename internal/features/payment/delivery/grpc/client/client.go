@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // PaymentClient represents the gRPC client for payment service
@@ -38,14 +39,13 @@ func (c *PaymentClient) Close() error {
 }
 
 // CreatePayment creates a new payment
-func (c *PaymentClient) CreatePayment(ctx context.Context, orderID string, amount float64, currency, paymentMethod string) (*pb.Payment, error) {
+func (c *PaymentClient) CreatePayment(ctx context.Context, orderID string, amount money.Money, paymentMethod string) (*pb.Payment, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
 	req := &pb.CreatePaymentRequest{
 		OrderId:       orderID,
-		Amount:        amount,
-		Currency:      currency,
+		Amount:        &pb.Money{Amount: amount.Amount, Currency: string(amount.Currency)},
 		PaymentMethod: paymentMethod,
 	}
 
@@ -102,13 +102,13 @@ func (c *PaymentClient) ProcessPayment(ctx context.Context, paymentID string, de
 }
 
 // RefundPayment processes a refund
-func (c *PaymentClient) RefundPayment(ctx context.Context, paymentID string, amount float64, reason string) (*pb.RefundPaymentResponse, error) {
+func (c *PaymentClient) RefundPayment(ctx context.Context, paymentID string, amount money.Money, reason string) (*pb.RefundPaymentResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
 	req := &pb.RefundPaymentRequest{
 		PaymentId: paymentID,
-		Amount:    amount,
+		Amount:    &pb.Money{Amount: amount.Amount, Currency: string(amount.Currency)},
 		Reason:    reason,
 	}
 
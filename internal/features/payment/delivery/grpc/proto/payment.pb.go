@@ -22,26 +22,78 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Money represents an amount in the minor units of an ISO 4217 currency
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_proto_payment_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 // Payment represents a payment in the system
 type Payment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
 	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	TransactionId string                 `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,11,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Payment) Reset() {
 	*x = Payment{}
-	mi := &file_proto_payment_payment_proto_msgTypes[0]
+	mi := &file_proto_payment_payment_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -53,7 +105,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[0]
+	mi := &file_proto_payment_payment_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -66,7 +118,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{0}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Payment) GetId() string {
@@ -90,20 +142,6 @@ func (x *Payment) GetUserId() string {
 	return ""
 }
 
-func (x *Payment) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *Payment) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
-}
-
 func (x *Payment) GetStatus() string {
 	if x != nil {
 		return x.Status
@@ -139,20 +177,26 @@ func (x *Payment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 // CreatePaymentRequest represents a request to create a new payment
 type CreatePaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePaymentRequest) Reset() {
 	*x = CreatePaymentRequest{}
-	mi := &file_proto_payment_payment_proto_msgTypes[1]
+	mi := &file_proto_payment_payment_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +208,7 @@ func (x *CreatePaymentRequest) String() string {
 func (*CreatePaymentRequest) ProtoMessage() {}
 
 func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[1]
+	mi := &file_proto_payment_payment_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +221,7 @@ func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
 func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{1}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePaymentRequest) GetOrderId() string {
@@ -187,25 +231,18 @@ func (x *CreatePaymentRequest) GetOrderId() string {
 	return ""
 }
 
-func (x *CreatePaymentRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreatePaymentRequest) GetCurrency() string {
+func (x *CreatePaymentRequest) GetPaymentMethod() string {
 	if x != nil {
-		return x.Currency
+		return x.PaymentMethod
 	}
 	return ""
 }
 
-func (x *CreatePaymentRequest) GetPaymentMethod() string {
+func (x *CreatePaymentRequest) GetAmount() *Money {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
 // CreatePaymentResponse represents the response after creating a payment
@@ -220,7 +257,7 @@ type CreatePaymentResponse struct {
 
 func (x *CreatePaymentResponse) Reset() {
 	*x = CreatePaymentResponse{}
-	mi := &file_proto_payment_payment_proto_msgTypes[2]
+	mi := &file_proto_payment_payment_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +269,7 @@ func (x *CreatePaymentResponse) String() string {
 func (*CreatePaymentResponse) ProtoMessage() {}
 
 func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[2]
+	mi := &file_proto_payment_payment_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +282,7 @@ func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentResponse.ProtoReflect.Descriptor instead.
 func (*CreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{2}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePaymentResponse) GetSuccess() bool {
@@ -279,7 +316,7 @@ type GetPaymentRequest struct {
 
 func (x *GetPaymentRequest) Reset() {
 	*x = GetPaymentRequest{}
-	mi := &file_proto_payment_payment_proto_msgTypes[3]
+	mi := &file_proto_payment_payment_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +328,7 @@ func (x *GetPaymentRequest) String() string {
 func (*GetPaymentRequest) ProtoMessage() {}
 
 func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[3]
+	mi := &file_proto_payment_payment_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +341,7 @@ func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
 func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{3}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetPaymentRequest) GetPaymentId() string {
@@ -324,7 +361,7 @@ type GetPaymentResponse struct {
 
 func (x *GetPaymentResponse) Reset() {
 	*x = GetPaymentResponse{}
-	mi := &file_proto_payment_payment_proto_msgTypes[4]
+	mi := &file_proto_payment_payment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +373,7 @@ func (x *GetPaymentResponse) String() string {
 func (*GetPaymentResponse) ProtoMessage() {}
 
 func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[4]
+	mi := &file_proto_payment_payment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +386,7 @@ func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentResponse.ProtoReflect.Descriptor instead.
 func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{4}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetPaymentResponse) GetPayment() *Payment {
@@ -371,7 +408,7 @@ type ListPaymentsRequest struct {
 
 func (x *ListPaymentsRequest) Reset() {
 	*x = ListPaymentsRequest{}
-	mi := &file_proto_payment_payment_proto_msgTypes[5]
+	mi := &file_proto_payment_payment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +420,7 @@ func (x *ListPaymentsRequest) String() string {
 func (*ListPaymentsRequest) ProtoMessage() {}
 
 func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[5]
+	mi := &file_proto_payment_payment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +433,7 @@ func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{5}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListPaymentsRequest) GetPage() int32 {
@@ -433,7 +470,7 @@ type ListPaymentsResponse struct {
 
 func (x *ListPaymentsResponse) Reset() {
 	*x = ListPaymentsResponse{}
-	mi := &file_proto_payment_payment_proto_msgTypes[6]
+	mi := &file_proto_payment_payment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +482,7 @@ func (x *ListPaymentsResponse) String() string {
 func (*ListPaymentsResponse) ProtoMessage() {}
 
 func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[6]
+	mi := &file_proto_payment_payment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +495,7 @@ func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{6}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListPaymentsResponse) GetPayments() []*Payment {
@@ -500,7 +537,7 @@ type ProcessPaymentRequest struct {
 
 func (x *ProcessPaymentRequest) Reset() {
 	*x = ProcessPaymentRequest{}
-	mi := &file_proto_payment_payment_proto_msgTypes[7]
+	mi := &file_proto_payment_payment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +549,7 @@ func (x *ProcessPaymentRequest) String() string {
 func (*ProcessPaymentRequest) ProtoMessage() {}
 
 func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[7]
+	mi := &file_proto_payment_payment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +562,7 @@ func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessPaymentRequest.ProtoReflect.Descriptor instead.
 func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{7}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ProcessPaymentRequest) GetPaymentId() string {
@@ -556,7 +593,7 @@ type PaymentDetails struct {
 
 func (x *PaymentDetails) Reset() {
 	*x = PaymentDetails{}
-	mi := &file_proto_payment_payment_proto_msgTypes[8]
+	mi := &file_proto_payment_payment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +605,7 @@ func (x *PaymentDetails) String() string {
 func (*PaymentDetails) ProtoMessage() {}
 
 func (x *PaymentDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[8]
+	mi := &file_proto_payment_payment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +618,7 @@ func (x *PaymentDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentDetails.ProtoReflect.Descriptor instead.
 func (*PaymentDetails) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{8}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PaymentDetails) GetCardNumber() string {
@@ -632,7 +669,7 @@ type ProcessPaymentResponse struct {
 
 func (x *ProcessPaymentResponse) Reset() {
 	*x = ProcessPaymentResponse{}
-	mi := &file_proto_payment_payment_proto_msgTypes[9]
+	mi := &file_proto_payment_payment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -644,7 +681,7 @@ func (x *ProcessPaymentResponse) String() string {
 func (*ProcessPaymentResponse) ProtoMessage() {}
 
 func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[9]
+	mi := &file_proto_payment_payment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +694,7 @@ func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessPaymentResponse.ProtoReflect.Descriptor instead.
 func (*ProcessPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{9}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProcessPaymentResponse) GetSuccess() bool {
@@ -692,15 +729,15 @@ func (x *ProcessPaymentResponse) GetPayment() *Payment {
 type RefundPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RefundPaymentRequest) Reset() {
 	*x = RefundPaymentRequest{}
-	mi := &file_proto_payment_payment_proto_msgTypes[10]
+	mi := &file_proto_payment_payment_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +749,7 @@ func (x *RefundPaymentRequest) String() string {
 func (*RefundPaymentRequest) ProtoMessage() {}
 
 func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[10]
+	mi := &file_proto_payment_payment_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +762,7 @@ func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
 func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{10}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RefundPaymentRequest) GetPaymentId() string {
@@ -735,18 +772,18 @@ func (x *RefundPaymentRequest) GetPaymentId() string {
 	return ""
 }
 
-func (x *RefundPaymentRequest) GetAmount() float64 {
+func (x *RefundPaymentRequest) GetReason() string {
 	if x != nil {
-		return x.Amount
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *RefundPaymentRequest) GetReason() string {
+func (x *RefundPaymentRequest) GetAmount() *Money {
 	if x != nil {
-		return x.Reason
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
 // RefundPaymentResponse represents the response after processing a refund
@@ -762,7 +799,7 @@ type RefundPaymentResponse struct {
 
 func (x *RefundPaymentResponse) Reset() {
 	*x = RefundPaymentResponse{}
-	mi := &file_proto_payment_payment_proto_msgTypes[11]
+	mi := &file_proto_payment_payment_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -774,7 +811,7 @@ func (x *RefundPaymentResponse) String() string {
 func (*RefundPaymentResponse) ProtoMessage() {}
 
 func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_payment_payment_proto_msgTypes[11]
+	mi := &file_proto_payment_payment_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +824,7 @@ func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
 func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_payment_payment_proto_rawDescGZIP(), []int{11}
+	return file_proto_payment_payment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RefundPaymentResponse) GetSuccess() bool {
@@ -822,13 +859,14 @@ var File_proto_payment_payment_proto protoreflect.FileDescriptor
 
 const file_proto_payment_payment_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/payment/payment.proto\x12\apayment\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x02\n" +
+	"\x1bproto/payment/payment.proto\x12\apayment\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xdd\x02\n" +
 	"\aPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06status\x18\x06 \x01(\tR\x06status\x12%\n" +
 	"\x0epayment_method\x18\a \x01(\tR\rpaymentMethod\x12%\n" +
 	"\x0etransaction_id\x18\b \x01(\tR\rtransactionId\x129\n" +
@@ -836,12 +874,12 @@ const file_proto_payment_payment_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8c\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12&\n" +
+	"\x06amount\x18\v \x01(\v2\x0e.payment.MoneyR\x06amountJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"\x8c\x01\n" +
 	"\x14CreatePaymentRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12%\n" +
-	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\"w\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12&\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0e.payment.MoneyR\x06amountJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"w\n" +
 	"\x15CreatePaymentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
@@ -877,12 +915,12 @@ const file_proto_payment_payment_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
 	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12*\n" +
-	"\apayment\x18\x04 \x01(\v2\x10.payment.PaymentR\apayment\"e\n" +
+	"\apayment\x18\x04 \x01(\v2\x10.payment.PaymentR\apayment\"{\n" +
 	"\x14RefundPaymentRequest\x12\x1d\n" +
 	"\n" +
 	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x94\x01\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12&\n" +
+	"\x06amount\x18\x04 \x01(\v2\x0e.payment.MoneyR\x06amountJ\x04\b\x02\x10\x03\"\x94\x01\n" +
 	"\x15RefundPaymentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
@@ -908,46 +946,50 @@ func file_proto_payment_payment_proto_rawDescGZIP() []byte {
 	return file_proto_payment_payment_proto_rawDescData
 }
 
-var file_proto_payment_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_payment_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_proto_payment_payment_proto_goTypes = []any{
-	(*Payment)(nil),                // 0: payment.Payment
-	(*CreatePaymentRequest)(nil),   // 1: payment.CreatePaymentRequest
-	(*CreatePaymentResponse)(nil),  // 2: payment.CreatePaymentResponse
-	(*GetPaymentRequest)(nil),      // 3: payment.GetPaymentRequest
-	(*GetPaymentResponse)(nil),     // 4: payment.GetPaymentResponse
-	(*ListPaymentsRequest)(nil),    // 5: payment.ListPaymentsRequest
-	(*ListPaymentsResponse)(nil),   // 6: payment.ListPaymentsResponse
-	(*ProcessPaymentRequest)(nil),  // 7: payment.ProcessPaymentRequest
-	(*PaymentDetails)(nil),         // 8: payment.PaymentDetails
-	(*ProcessPaymentResponse)(nil), // 9: payment.ProcessPaymentResponse
-	(*RefundPaymentRequest)(nil),   // 10: payment.RefundPaymentRequest
-	(*RefundPaymentResponse)(nil),  // 11: payment.RefundPaymentResponse
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*Money)(nil),                  // 0: payment.Money
+	(*Payment)(nil),                // 1: payment.Payment
+	(*CreatePaymentRequest)(nil),   // 2: payment.CreatePaymentRequest
+	(*CreatePaymentResponse)(nil),  // 3: payment.CreatePaymentResponse
+	(*GetPaymentRequest)(nil),      // 4: payment.GetPaymentRequest
+	(*GetPaymentResponse)(nil),     // 5: payment.GetPaymentResponse
+	(*ListPaymentsRequest)(nil),    // 6: payment.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),   // 7: payment.ListPaymentsResponse
+	(*ProcessPaymentRequest)(nil),  // 8: payment.ProcessPaymentRequest
+	(*PaymentDetails)(nil),         // 9: payment.PaymentDetails
+	(*ProcessPaymentResponse)(nil), // 10: payment.ProcessPaymentResponse
+	(*RefundPaymentRequest)(nil),   // 11: payment.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),  // 12: payment.RefundPaymentResponse
+	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
 }
 var file_proto_payment_payment_proto_depIdxs = []int32{
-	12, // 0: payment.Payment.created_at:type_name -> google.protobuf.Timestamp
-	12, // 1: payment.Payment.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: payment.CreatePaymentResponse.payment:type_name -> payment.Payment
-	0,  // 3: payment.GetPaymentResponse.payment:type_name -> payment.Payment
-	0,  // 4: payment.ListPaymentsResponse.payments:type_name -> payment.Payment
-	8,  // 5: payment.ProcessPaymentRequest.payment_details:type_name -> payment.PaymentDetails
-	0,  // 6: payment.ProcessPaymentResponse.payment:type_name -> payment.Payment
-	0,  // 7: payment.RefundPaymentResponse.payment:type_name -> payment.Payment
-	1,  // 8: payment.PaymentService.CreatePayment:input_type -> payment.CreatePaymentRequest
-	3,  // 9: payment.PaymentService.GetPayment:input_type -> payment.GetPaymentRequest
-	5,  // 10: payment.PaymentService.ListPayments:input_type -> payment.ListPaymentsRequest
-	7,  // 11: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
-	10, // 12: payment.PaymentService.RefundPayment:input_type -> payment.RefundPaymentRequest
-	2,  // 13: payment.PaymentService.CreatePayment:output_type -> payment.CreatePaymentResponse
-	4,  // 14: payment.PaymentService.GetPayment:output_type -> payment.GetPaymentResponse
-	6,  // 15: payment.PaymentService.ListPayments:output_type -> payment.ListPaymentsResponse
-	9,  // 16: payment.PaymentService.ProcessPayment:output_type -> payment.ProcessPaymentResponse
-	11, // 17: payment.PaymentService.RefundPayment:output_type -> payment.RefundPaymentResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	13, // 0: payment.Payment.created_at:type_name -> google.protobuf.Timestamp
+	13, // 1: payment.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: payment.Payment.amount:type_name -> payment.Money
+	0,  // 3: payment.CreatePaymentRequest.amount:type_name -> payment.Money
+	1,  // 4: payment.CreatePaymentResponse.payment:type_name -> payment.Payment
+	1,  // 5: payment.GetPaymentResponse.payment:type_name -> payment.Payment
+	1,  // 6: payment.ListPaymentsResponse.payments:type_name -> payment.Payment
+	9,  // 7: payment.ProcessPaymentRequest.payment_details:type_name -> payment.PaymentDetails
+	1,  // 8: payment.ProcessPaymentResponse.payment:type_name -> payment.Payment
+	0,  // 9: payment.RefundPaymentRequest.amount:type_name -> payment.Money
+	1,  // 10: payment.RefundPaymentResponse.payment:type_name -> payment.Payment
+	2,  // 11: payment.PaymentService.CreatePayment:input_type -> payment.CreatePaymentRequest
+	4,  // 12: payment.PaymentService.GetPayment:input_type -> payment.GetPaymentRequest
+	6,  // 13: payment.PaymentService.ListPayments:input_type -> payment.ListPaymentsRequest
+	8,  // 14: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
+	11, // 15: payment.PaymentService.RefundPayment:input_type -> payment.RefundPaymentRequest
+	3,  // 16: payment.PaymentService.CreatePayment:output_type -> payment.CreatePaymentResponse
+	5,  // 17: payment.PaymentService.GetPayment:output_type -> payment.GetPaymentResponse
+	7,  // 18: payment.PaymentService.ListPayments:output_type -> payment.ListPaymentsResponse
+	10, // 19: payment.PaymentService.ProcessPayment:output_type -> payment.ProcessPaymentResponse
+	12, // 20: payment.PaymentService.RefundPayment:output_type -> payment.RefundPaymentResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_payment_payment_proto_init() }
@@ -961,7 +1003,7 @@ func file_proto_payment_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_payment_payment_proto_rawDesc), len(file_proto_payment_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type PaymentServer struct {
@@ -29,7 +30,7 @@ func (s *PaymentServer) CreatePayment(ctx context.Context, req *pb.CreatePayment
 		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
 	}
 
-	paymentResp, err := s.paymentUsecase.CreatePayment(ctx, orderID, req.Amount, req.Currency, req.PaymentMethod)
+	paymentResp, err := s.paymentUsecase.CreatePayment(ctx, orderID, convertMoney(req.Amount), req.PaymentMethod)
 	if err != nil {
 		var errStatus error
 		switch err {
@@ -146,7 +147,7 @@ func (s *PaymentServer) RefundPayment(ctx context.Context, req *pb.RefundPayment
 		return nil, status.Error(codes.InvalidArgument, "invalid payment ID")
 	}
 
-	paymentResp, refundID, err := s.paymentUsecase.RefundPayment(ctx, paymentID, req.Amount, req.Reason)
+	paymentResp, refundID, err := s.paymentUsecase.RefundPayment(ctx, paymentID, convertMoney(req.Amount), req.Reason)
 	if err != nil {
 		var errStatus error
 		switch err {
@@ -170,13 +171,26 @@ func (s *PaymentServer) RefundPayment(ctx context.Context, req *pb.RefundPayment
 	}, nil
 }
 
+func convertMoney(m *pb.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+	return money.New(m.Amount, money.Currency(m.Currency))
+}
+
+func convertMoneyToPb(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
+
 func convertPaymentToPb(p *usecase.PaymentResponse) *pb.Payment {
 	return &pb.Payment{
 		Id:            p.ID.String(),
 		OrderId:       p.OrderID.String(),
 		UserId:        p.UserID.String(),
-		Amount:        p.Amount,
-		Currency:      p.Currency,
+		Amount:        convertMoneyToPb(p.Amount),
 		Status:        string(p.Status),
 		PaymentMethod: p.PaymentMethod,
 		TransactionId: p.ProviderTransactionID,
@@ -2,6 +2,7 @@ package http
 
 import (
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
@@ -17,10 +18,9 @@ func NewPaymentHandler(useCase usecase.Usecase) *PaymentHandler {
 }
 
 type CreatePaymentRequest struct {
-	OrderID       uuid.UUID `json:"order_id" validate:"required"`
-	Amount        float64   `json:"amount" validate:"required,gt=0"`
-	Currency      string    `json:"currency" validate:"required,len=3"`
-	PaymentMethod string    `json:"payment_method" validate:"required"`
+	OrderID       uuid.UUID   `json:"order_id" validate:"required"`
+	Amount        money.Money `json:"amount" validate:"required"`
+	PaymentMethod string      `json:"payment_method" validate:"required"`
 }
 
 type ProcessPaymentRequest struct {
@@ -32,8 +32,8 @@ type ProcessPaymentRequest struct {
 }
 
 type RefundPaymentRequest struct {
-	Amount float64 `json:"amount" validate:"required,gt=0"`
-	Reason string  `json:"reason" validate:"required"`
+	Amount money.Money `json:"amount" validate:"required"`
+	Reason string      `json:"reason" validate:"required"`
 }
 
 func (h *PaymentHandler) CreatePayment(c *fiber.Ctx) error {
@@ -44,7 +44,7 @@ func (h *PaymentHandler) CreatePayment(c *fiber.Ctx) error {
 		})
 	}
 
-	payment, err := h.useCase.CreatePayment(c.Context(), req.OrderID, req.Amount, req.Currency, req.PaymentMethod)
+	payment, err := h.useCase.CreatePayment(c.Context(), req.OrderID, req.Amount, req.PaymentMethod)
 	if err != nil {
 		if err == payment.ErrOrderNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
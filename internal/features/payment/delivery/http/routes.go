@@ -5,8 +5,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-// RegisterRoutes registers payment routes
-func RegisterRoutes(router fiber.Router, useCase usecase.Usecase) {
+// RegisterRoutes registers payment routes. Payment processing is retried
+// safely through the idempotency middleware.
+func RegisterRoutes(router fiber.Router, useCase usecase.Usecase, idempotency fiber.Handler) {
 	handler := NewPaymentHandler(useCase)
 
 	paymentGroup := router.Group("/payments")
@@ -14,7 +15,7 @@ func RegisterRoutes(router fiber.Router, useCase usecase.Usecase) {
 		paymentGroup.Post("/", handler.CreatePayment)
 		paymentGroup.Get("/:id", handler.GetPayment)
 		paymentGroup.Get("/", handler.ListPayments)
-		paymentGroup.Post("/:id/process", handler.ProcessPayment)
+		paymentGroup.Post("/:id/process", idempotency, handler.ProcessPayment)
 		paymentGroup.Post("/:id/refund", handler.RefundPayment)
 	}
 }
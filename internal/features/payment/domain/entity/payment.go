@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // PaymentStatus represents the status of a payment
@@ -14,6 +16,7 @@ const (
 	PaymentStatusProcessing PaymentStatus = "PROCESSING"
 	PaymentStatusSuccess    PaymentStatus = "SUCCESS"
 	PaymentStatusFailed     PaymentStatus = "FAILED"
+	PaymentStatusRefunded   PaymentStatus = "REFUNDED"
 )
 
 // PaymentProvider represents the payment provider
@@ -28,8 +31,7 @@ const (
 type Payment struct {
 	ID                    uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
 	OrderID               uuid.UUID       `json:"order_id" gorm:"type:uuid;not null"`
-	Amount                float64         `json:"amount" gorm:"not null"`
-	Currency              string          `json:"currency" gorm:"type:varchar(3);not null"`
+	Amount                money.Money     `json:"amount" gorm:"embedded"`
 	Status                PaymentStatus   `json:"status" gorm:"type:varchar(50);not null"`
 	Provider              PaymentProvider `json:"provider" gorm:"type:varchar(50);not null"`
 	ProviderTransactionID string          `json:"provider_transaction_id" gorm:"type:varchar(255)"`
@@ -39,12 +41,11 @@ type Payment struct {
 }
 
 // NewPayment creates a new payment
-func NewPayment(orderID uuid.UUID, amount float64, currency string, provider PaymentProvider) *Payment {
+func NewPayment(orderID uuid.UUID, amount money.Money, provider PaymentProvider) *Payment {
 	return &Payment{
 		ID:        uuid.New(),
 		OrderID:   orderID,
 		Amount:    amount,
-		Currency:  currency,
 		Status:    PaymentStatusPending,
 		Provider:  provider,
 		CreatedAt: time.Now(),
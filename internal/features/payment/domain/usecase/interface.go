@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // Status represents the status of a payment
@@ -34,8 +35,7 @@ type PaymentResponse struct {
 	ID                    uuid.UUID
 	OrderID               uuid.UUID
 	UserID                uuid.UUID
-	Amount                float64
-	Currency              string
+	Amount                money.Money
 	Status                Status
 	PaymentMethod         string
 	ProviderTransactionID string
@@ -46,8 +46,7 @@ type PaymentResponse struct {
 // CreatePaymentRequest represents the request to create a payment
 type CreatePaymentRequest struct {
 	OrderID       uuid.UUID
-	Amount        float64
-	Currency      string
+	Amount        money.Money
 	PaymentMethod string
 }
 
@@ -61,7 +60,7 @@ type ListPaymentsRequest struct {
 // Usecase defines the interface for payment business logic
 type Usecase interface {
 	// CreatePayment creates a new payment for an order
-	CreatePayment(ctx context.Context, orderID uuid.UUID, amount float64, currency, paymentMethod string) (*pb.Payment, error)
+	CreatePayment(ctx context.Context, orderID uuid.UUID, amount money.Money, paymentMethod string) (*pb.Payment, error)
 
 	// GetPayment retrieves a payment by ID
 	GetPayment(ctx context.Context, paymentID uuid.UUID) (*pb.Payment, error)
@@ -73,5 +72,5 @@ type Usecase interface {
 	ProcessPayment(ctx context.Context, paymentID uuid.UUID, details *pb.PaymentDetails) (*pb.Payment, error)
 
 	// RefundPayment processes a refund for a payment
-	RefundPayment(ctx context.Context, paymentID uuid.UUID, amount float64, reason string) (*pb.Payment, string, error)
+	RefundPayment(ctx context.Context, paymentID uuid.UUID, amount money.Money, reason string) (*pb.Payment, string, error)
 }
@@ -6,20 +6,20 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // PaymentResponse represents a payment in responses
 type PaymentResponse struct {
-	ID                    uuid.UUID `json:"id"`
-	OrderID               uuid.UUID `json:"order_id"`
-	Amount                float64   `json:"amount"`
-	Currency              string    `json:"currency"`
-	Status                string    `json:"status"`
-	Provider              string    `json:"provider"`
-	ProviderTransactionID string    `json:"provider_transaction_id,omitempty"`
-	ErrorMessage          string    `json:"error_message,omitempty"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
+	ID                    uuid.UUID   `json:"id"`
+	OrderID               uuid.UUID   `json:"order_id"`
+	Amount                money.Money `json:"amount"`
+	Status                string      `json:"status"`
+	Provider              string      `json:"provider"`
+	ProviderTransactionID string      `json:"provider_transaction_id,omitempty"`
+	ErrorMessage          string      `json:"error_message,omitempty"`
+	CreatedAt             time.Time   `json:"created_at"`
+	UpdatedAt             time.Time   `json:"updated_at"`
 }
 
 // NewPaymentResponse creates a new payment response from a payment entity
@@ -28,7 +28,6 @@ func NewPaymentResponse(payment *entity.Payment) *PaymentResponse {
 		ID:                    payment.ID,
 		OrderID:               payment.OrderID,
 		Amount:                payment.Amount,
-		Currency:              payment.Currency,
 		Status:                string(payment.Status),
 		Provider:              string(payment.Provider),
 		ProviderTransactionID: payment.ProviderTransactionID,
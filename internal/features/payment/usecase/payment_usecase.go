@@ -11,6 +11,7 @@ import (
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/payment"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/eventbus"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/payment/provider"
 )
 
@@ -56,7 +57,7 @@ func NewPaymentUsecase(
 }
 
 // CreatePayment creates a new payment
-func (u *PaymentUsecase) CreatePayment(ctx context.Context, orderID uuid.UUID, amount float64, currency, paymentMethod string) (*usecase.PaymentResponse, error) {
+func (u *PaymentUsecase) CreatePayment(ctx context.Context, orderID uuid.UUID, amount money.Money, paymentMethod string) (*usecase.PaymentResponse, error) {
 	// Validate order exists
 	order, err := u.orderRepo.GetByID(ctx, orderID)
 	if err != nil {
@@ -72,7 +73,6 @@ func (u *PaymentUsecase) CreatePayment(ctx context.Context, orderID uuid.UUID, a
 		OrderID:        orderID,
 		UserID:         order.UserID,
 		Amount:         amount,
-		Currency:       currency,
 		usecase.Status: usecase.StatusPending,
 		PaymentMethod:  paymentMethod,
 		CreatedAt:      time.Now(),
@@ -145,7 +145,7 @@ func (u *PaymentUsecase) ProcessPayment(ctx context.Context, paymentID uuid.UUID
 }
 
 // RefundPayment processes a refund
-func (u *PaymentUsecase) RefundPayment(ctx context.Context, paymentID uuid.UUID, amount float64, reason string) (*usecase.PaymentResponse, string, error) {
+func (u *PaymentUsecase) RefundPayment(ctx context.Context, paymentID uuid.UUID, amount money.Money, reason string) (*usecase.PaymentResponse, string, error) {
 	p, err := u.paymentRepo.GetByID(ctx, paymentID)
 	if err != nil {
 		return nil, "", err
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/product/delivery/grpc/proto"
+)
+
+// ProductClient represents the gRPC client for product service
+type ProductClient struct {
+	client pb.ProductServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewProductClient creates a new product gRPC client
+func NewProductClient(address string) (*ProductClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	client := pb.NewProductServiceClient(conn)
+	return &ProductClient{
+		client: client,
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the client connection
+func (c *ProductClient) Close() error {
+	return c.conn.Close()
+}
+
+// CreateProduct creates a new product
+func (c *ProductClient) CreateProduct(ctx context.Context, product *pb.ProductInput) (*pb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.CreateProduct(ctx, &pb.CreateProductRequest{Product: product})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product: %w", err)
+	}
+
+	return resp.Product, nil
+}
+
+// UpdateProduct updates a product
+func (c *ProductClient) UpdateProduct(ctx context.Context, productID string, product *pb.ProductInput) (*pb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	req := &pb.UpdateProductRequest{
+		ProductId: productID,
+		Product:   product,
+	}
+
+	resp, err := c.client.UpdateProduct(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	return resp.Product, nil
+}
+
+// ArchiveProduct removes a product from sale
+func (c *ProductClient) ArchiveProduct(ctx context.Context, productID string) (*pb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.ArchiveProduct(ctx, &pb.ArchiveProductRequest{ProductId: productID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive product: %w", err)
+	}
+
+	return resp.Product, nil
+}
+
+// GetProduct retrieves a product by ID
+func (c *ProductClient) GetProduct(ctx context.Context, productID string) (*pb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return resp.Product, nil
+}
+
+// ListProducts retrieves a list of products
+func (c *ProductClient) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	return c.client.ListProducts(ctx, req)
+}
+
+// CreateCategory creates a new category
+func (c *ProductClient) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.CreateCategory(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return resp.Category, nil
+}
+
+// ListCategories retrieves all categories
+func (c *ProductClient) ListCategories(ctx context.Context) ([]*pb.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.ListCategories(ctx, &pb.ListCategoriesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return resp.Categories, nil
+}
+
+// SearchProducts searches products by text and facet filters
+func (c *ProductClient) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	return c.client.SearchProducts(ctx, req)
+}
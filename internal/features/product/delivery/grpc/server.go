@@ -0,0 +1,353 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/product/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+type ProductServer struct {
+	pb.UnimplementedProductServiceServer
+	productUsecase usecase.Usecase
+}
+
+func NewProductServer(productUsecase usecase.Usecase) *ProductServer {
+	return &ProductServer{
+		productUsecase: productUsecase,
+	}
+}
+
+func (s *ProductServer) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	input, err := convertProductInput(req.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productUsecase.CreateProduct(ctx, input)
+	if err != nil {
+		return nil, productError(err, "failed to create product")
+	}
+
+	return &pb.CreateProductResponse{
+		Success: true,
+		Message: "Product created successfully",
+		Product: convertProductToPb(product),
+	}, nil
+}
+
+func (s *ProductServer) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+
+	input, err := convertProductInput(req.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productUsecase.UpdateProduct(ctx, productID, input)
+	if err != nil {
+		return nil, productError(err, "failed to update product")
+	}
+
+	return &pb.UpdateProductResponse{
+		Success: true,
+		Message: "Product updated successfully",
+		Product: convertProductToPb(product),
+	}, nil
+}
+
+func (s *ProductServer) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequest) (*pb.ArchiveProductResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+
+	product, err := s.productUsecase.ArchiveProduct(ctx, productID)
+	if err != nil {
+		return nil, productError(err, "failed to archive product")
+	}
+
+	return &pb.ArchiveProductResponse{
+		Success: true,
+		Message: "Product archived successfully",
+		Product: convertProductToPb(product),
+	}, nil
+}
+
+func (s *ProductServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+
+	product, err := s.productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, productError(err, "failed to get product")
+	}
+
+	return &pb.GetProductResponse{
+		Product: convertProductToPb(product),
+	}, nil
+}
+
+func (s *ProductServer) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	categoryID, err := parseOptionalID(req.CategoryId, "invalid category ID")
+	if err != nil {
+		return nil, err
+	}
+
+	productsResp, total, err := s.productUsecase.ListProducts(ctx, categoryID, req.IncludeArchived, req.Page, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list products")
+	}
+
+	products := make([]*pb.Product, len(productsResp))
+	for i, p := range productsResp {
+		products[i] = convertProductToPb(p)
+	}
+
+	return &pb.ListProductsResponse{
+		Products: products,
+		Total:    total,
+		Page:     req.Page,
+		Limit:    req.Limit,
+	}, nil
+}
+
+func (s *ProductServer) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	categoryIDs := make([]uuid.UUID, 0, len(req.CategoryIds))
+	for _, value := range req.CategoryIds {
+		categoryID, err := parseOptionalID(value, "invalid category ID")
+		if err != nil {
+			return nil, err
+		}
+		if categoryID != nil {
+			categoryIDs = append(categoryIDs, *categoryID)
+		}
+	}
+
+	resp, err := s.productUsecase.SearchProducts(ctx, usecase.SearchInput{
+		Query:       req.Query,
+		CategoryIDs: categoryIDs,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		InStock:     req.InStock,
+		Sort:        req.Sort,
+		Cursor:      req.Cursor,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		return nil, productError(err, "failed to search products")
+	}
+
+	products := make([]*pb.Product, len(resp.Products))
+	for i, p := range resp.Products {
+		products[i] = convertProductToPb(p)
+	}
+
+	return &pb.SearchProductsResponse{
+		Products:   products,
+		Total:      resp.Total,
+		Facets:     convertFacetsToPb(resp.Facets),
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+func (s *ProductServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	parentID, err := parseOptionalID(req.ParentId, "invalid parent category ID")
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.productUsecase.CreateCategory(ctx, usecase.CategoryInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		ParentID:    parentID,
+	})
+	if err != nil {
+		return nil, productError(err, "failed to create category")
+	}
+
+	return &pb.CreateCategoryResponse{
+		Success:  true,
+		Message:  "Category created successfully",
+		Category: convertCategoryToPb(category),
+	}, nil
+}
+
+func (s *ProductServer) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	categoriesResp, err := s.productUsecase.ListCategories(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list categories")
+	}
+
+	categories := make([]*pb.Category, len(categoriesResp))
+	for i, c := range categoriesResp {
+		categories[i] = convertCategoryToPb(c)
+	}
+
+	return &pb.ListCategoriesResponse{
+		Categories: categories,
+	}, nil
+}
+
+// productError maps usecase errors to gRPC status errors
+func productError(err error, message string) error {
+	switch err {
+	case usecase.ErrNotFound, usecase.ErrCategoryNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case usecase.ErrDuplicateSKU, usecase.ErrDuplicateSlug:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case usecase.ErrInvalidSKU, usecase.ErrInvalidName, usecase.ErrInvalidSlug,
+		usecase.ErrInvalidPrice, usecase.ErrInvalidCurrency, usecase.ErrInvalidStock, usecase.ErrInvalidImage,
+		usecase.ErrInvalidSort, usecase.ErrInvalidCursor, usecase.ErrInvalidPriceRange:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case usecase.ErrArchived:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
+
+// parseOptionalID parses an ID that may be left empty
+func parseOptionalID(value, message string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, message)
+	}
+	return &id, nil
+}
+
+func convertProductInput(product *pb.ProductInput) (usecase.ProductInput, error) {
+	if product == nil {
+		return usecase.ProductInput{}, status.Error(codes.InvalidArgument, "product is required")
+	}
+
+	categoryID, err := parseOptionalID(product.CategoryId, "invalid category ID")
+	if err != nil {
+		return usecase.ProductInput{}, err
+	}
+
+	images := make([]usecase.ImageInput, len(product.Images))
+	for i, image := range product.Images {
+		images[i] = usecase.ImageInput{
+			URL:      image.Url,
+			AltText:  image.AltText,
+			Position: int(image.Position),
+			Width:    int(image.Width),
+			Height:   int(image.Height),
+		}
+	}
+
+	return usecase.ProductInput{
+		SKU:         product.Sku,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       convertMoney(product.Price),
+		TaxCategory: product.TaxCategory,
+		Stock:       int(product.Stock),
+		CategoryID:  categoryID,
+		Images:      images,
+	}, nil
+}
+
+func convertMoney(m *pb.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+	return money.New(m.Amount, money.Currency(m.Currency))
+}
+
+func convertMoneyToPb(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
+
+func convertProductToPb(product *usecase.ProductResponse) *pb.Product {
+	images := make([]*pb.ProductImage, len(product.Images))
+	for i, image := range product.Images {
+		images[i] = &pb.ProductImage{
+			Id:       image.ID.String(),
+			Url:      image.URL,
+			AltText:  image.AltText,
+			Position: int32(image.Position),
+			Width:    int32(image.Width),
+			Height:   int32(image.Height),
+		}
+	}
+
+	pbProduct := &pb.Product{
+		Id:          product.ID.String(),
+		Sku:         product.SKU,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       convertMoneyToPb(product.Price),
+		TaxCategory: product.TaxCategory,
+		Stock:       int32(product.Stock),
+		Images:      images,
+		Status:      product.Status,
+		CreatedAt:   timestamppb.New(product.CreatedAt),
+		UpdatedAt:   timestamppb.New(product.UpdatedAt),
+	}
+	if product.Category != nil {
+		pbProduct.Category = convertCategoryToPb(product.Category)
+	}
+	if product.ArchivedAt != nil {
+		pbProduct.ArchivedAt = timestamppb.New(*product.ArchivedAt)
+	}
+	return pbProduct
+}
+
+func convertFacetsToPb(facets usecase.SearchFacets) *pb.SearchFacets {
+	result := &pb.SearchFacets{
+		Categories:  make([]*pb.CategoryFacet, len(facets.Categories)),
+		PriceRanges: make([]*pb.PriceRangeFacet, len(facets.PriceRanges)),
+		InStock:     facets.Availability.InStock,
+		OutOfStock:  facets.Availability.OutOfStock,
+	}
+	for i, facet := range facets.Categories {
+		result.Categories[i] = &pb.CategoryFacet{
+			Name:  facet.Name,
+			Count: facet.Count,
+		}
+		if facet.CategoryID != nil {
+			result.Categories[i].CategoryId = facet.CategoryID.String()
+		}
+	}
+	for i, facet := range facets.PriceRanges {
+		result.PriceRanges[i] = &pb.PriceRangeFacet{
+			Min:   facet.Min,
+			Max:   facet.Max,
+			Count: facet.Count,
+		}
+	}
+	return result
+}
+
+func convertCategoryToPb(category *usecase.CategoryResponse) *pb.Category {
+	pbCategory := &pb.Category{
+		Id:          category.ID.String(),
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+	}
+	if category.ParentID != nil {
+		pbCategory.ParentId = category.ParentID.String()
+	}
+	return pbCategory
+}
@@ -0,0 +1,260 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/dto/request"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
+	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+)
+
+type ProductHandler struct {
+	productUsecase usecase.Usecase
+	errorHandler   errors.ErrorHandler
+}
+
+func NewProductHandler(productUsecase usecase.Usecase) *ProductHandler {
+	return &ProductHandler{
+		productUsecase: productUsecase,
+		errorHandler:   errors.NewErrorHandler(),
+	}
+}
+
+// CreateProduct handles POST /products request
+func (h *ProductHandler) CreateProduct(c *fiber.Ctx) error {
+	var req request.ProductRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	input, err := convertProductRequest(&req)
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
+	}
+
+	resp, err := h.productUsecase.CreateProduct(c.Context(), input)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.Created(c, "Product created successfully", resp)
+}
+
+// UpdateProduct handles PUT /products/:id request
+func (h *ProductHandler) UpdateProduct(c *fiber.Ctx) error {
+	productID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid product ID"))
+	}
+
+	var req request.ProductRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	input, err := convertProductRequest(&req)
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
+	}
+
+	resp, err := h.productUsecase.UpdateProduct(c.Context(), productID, input)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Product updated successfully", resp)
+}
+
+// ArchiveProduct handles POST /products/:id/archive request
+func (h *ProductHandler) ArchiveProduct(c *fiber.Ctx) error {
+	productID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid product ID"))
+	}
+
+	resp, err := h.productUsecase.ArchiveProduct(c.Context(), productID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Product archived successfully", resp)
+}
+
+// GetProduct handles GET /products/:id request
+func (h *ProductHandler) GetProduct(c *fiber.Ctx) error {
+	productID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid product ID"))
+	}
+
+	resp, err := h.productUsecase.GetProduct(c.Context(), productID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Product retrieved successfully", resp)
+}
+
+// ListProducts handles GET /products request
+func (h *ProductHandler) ListProducts(c *fiber.Ctx) error {
+	var req request.ListProductsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	// Set default values if not provided
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+
+	categoryID, err := parseOptionalID(req.CategoryID, "Invalid category ID")
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
+	}
+
+	resp, total, err := h.productUsecase.ListProducts(c.Context(), categoryID, req.IncludeArchived, int32(req.Page), int32(req.Limit))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+
+	return httpresponse.OK(c, "Products retrieved successfully", fiber.Map{
+		"products": resp,
+		"total":    total,
+		"page":     req.Page,
+		"limit":    req.Limit,
+	})
+}
+
+// SearchProducts handles GET /products/search request
+func (h *ProductHandler) SearchProducts(c *fiber.Ctx) error {
+	var req request.SearchProductsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	var categoryIDs []uuid.UUID
+	for _, value := range strings.Split(req.CategoryIDs, ",") {
+		categoryID, err := parseOptionalID(strings.TrimSpace(value), "Invalid category ID")
+		if err != nil {
+			return h.errorHandler.Handle(c, err)
+		}
+		if categoryID != nil {
+			categoryIDs = append(categoryIDs, *categoryID)
+		}
+	}
+
+	resp, err := h.productUsecase.SearchProducts(c.Context(), usecase.SearchInput{
+		Query:       req.Query,
+		CategoryIDs: categoryIDs,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		InStock:     req.InStock,
+		Sort:        req.Sort,
+		Cursor:      req.Cursor,
+		Limit:       int32(req.Limit),
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Products retrieved successfully", resp)
+}
+
+// CreateCategory handles POST /categories request
+func (h *ProductHandler) CreateCategory(c *fiber.Ctx) error {
+	var req request.CreateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	parentID, err := parseOptionalID(req.ParentID, "Invalid parent category ID")
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
+	}
+
+	resp, err := h.productUsecase.CreateCategory(c.Context(), usecase.CategoryInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		ParentID:    parentID,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.Created(c, "Category created successfully", resp)
+}
+
+// ListCategories handles GET /categories request
+func (h *ProductHandler) ListCategories(c *fiber.Ctx) error {
+	resp, err := h.productUsecase.ListCategories(c.Context())
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+
+	return httpresponse.OK(c, "Categories retrieved successfully", resp)
+}
+
+// handleError maps usecase errors to HTTP errors
+func (h *ProductHandler) handleError(c *fiber.Ctx, err error) error {
+	switch err {
+	case usecase.ErrNotFound, usecase.ErrCategoryNotFound:
+		return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+	case usecase.ErrDuplicateSKU, usecase.ErrDuplicateSlug, usecase.ErrArchived:
+		return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
+	case usecase.ErrInvalidSKU, usecase.ErrInvalidName, usecase.ErrInvalidSlug,
+		usecase.ErrInvalidPrice, usecase.ErrInvalidCurrency, usecase.ErrInvalidStock, usecase.ErrInvalidImage,
+		usecase.ErrInvalidSort, usecase.ErrInvalidCursor, usecase.ErrInvalidPriceRange:
+		return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+	default:
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+}
+
+// parseOptionalID parses an ID that may be left empty
+func parseOptionalID(value, message string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.NewValidationError(message)
+	}
+	return &id, nil
+}
+
+func convertProductRequest(req *request.ProductRequest) (usecase.ProductInput, error) {
+	categoryID, err := parseOptionalID(req.CategoryID, "Invalid category ID")
+	if err != nil {
+		return usecase.ProductInput{}, err
+	}
+
+	images := make([]usecase.ImageInput, len(req.Images))
+	for i, image := range req.Images {
+		images[i] = usecase.ImageInput{
+			URL:      image.URL,
+			AltText:  image.AltText,
+			Position: image.Position,
+			Width:    image.Width,
+			Height:   image.Height,
+		}
+	}
+
+	return usecase.ProductInput{
+		SKU:         req.SKU,
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		TaxCategory: req.TaxCategory,
+		Stock:       req.Stock,
+		CategoryID:  categoryID,
+		Images:      images,
+	}, nil
+}
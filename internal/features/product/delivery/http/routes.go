@@ -0,0 +1,23 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRoutes registers all catalog-related routes. The catalog is read
+// by anyone and changed by admins only.
+func RegisterRoutes(router fiber.Router, handler *ProductHandler, adminMiddleware fiber.Handler) {
+	products := router.Group("/products")
+
+	products.Get("", handler.ListProducts)
+	products.Get("/search", handler.SearchProducts)
+	products.Get("/:id", handler.GetProduct)
+	products.Post("", adminMiddleware, handler.CreateProduct)
+	products.Put("/:id", adminMiddleware, handler.UpdateProduct)
+	products.Post("/:id/archive", adminMiddleware, handler.ArchiveProduct)
+
+	categories := router.Group("/categories")
+
+	categories.Get("", handler.ListCategories)
+	categories.Post("", adminMiddleware, handler.CreateCategory)
+}
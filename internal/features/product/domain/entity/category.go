@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category groups products; categories may be nested under a parent
+type Category struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" gorm:"type:uuid"`
+	Name        string     `json:"name" gorm:"type:varchar(255);not null"`
+	Slug        string     `json:"slug" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Description string     `json:"description" gorm:"type:text"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName returns the category table name
+func (Category) TableName() string {
+	return "categories"
+}
+
+// NewCategory creates a new category
+func NewCategory(name, slug, description string, parentID *uuid.UUID) *Category {
+	return &Category{
+		ID:          uuid.New(),
+		ParentID:    parentID,
+		Name:        name,
+		Slug:        slug,
+		Description: description,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// ProductStatus represents the status of a product in the catalog
+type ProductStatus string
+
+const (
+	ProductStatusActive   ProductStatus = "ACTIVE"
+	ProductStatusArchived ProductStatus = "ARCHIVED"
+)
+
+// DefaultTaxCategory is the tax category of products that are not given one
+const DefaultTaxCategory = "standard"
+
+// ProductImage is the metadata of a product image; the image itself is stored elsewhere
+type ProductImage struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ProductID uuid.UUID `json:"product_id" gorm:"type:uuid;not null"`
+	URL       string    `json:"url" gorm:"type:text;not null"`
+	AltText   string    `json:"alt_text" gorm:"type:varchar(255)"`
+	Position  int       `json:"position" gorm:"not null;default:0"`
+	Width     int       `json:"width"`
+	Height    int       `json:"height"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the product image table name
+func (ProductImage) TableName() string {
+	return "product_images"
+}
+
+// Product represents a product in the catalog. Stock is only written when the
+// product is created; afterwards it is owned by the inventory module.
+type Product struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	SKU         string         `json:"sku" gorm:"type:varchar(100);uniqueIndex;not null"`
+	Name        string         `json:"name" gorm:"type:varchar(255);not null"`
+	Description string         `json:"description" gorm:"type:text"`
+	Price       money.Money    `json:"price" gorm:"embedded;embeddedPrefix:price_"`
+	TaxCategory string         `json:"tax_category" gorm:"type:varchar(50);not null;default:'standard'"`
+	Stock       int            `json:"stock" gorm:"not null;default:0;<-:create"`
+	CategoryID  *uuid.UUID     `json:"category_id" gorm:"type:uuid"`
+	Category    *Category      `json:"category,omitempty" gorm:"foreignKey:CategoryID"`
+	Images      []ProductImage `json:"images" gorm:"foreignKey:ProductID"`
+	Status      ProductStatus  `json:"status" gorm:"type:varchar(20);not null"`
+	ArchivedAt  *time.Time     `json:"archived_at,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// TableName returns the product table name
+func (Product) TableName() string {
+	return "products"
+}
+
+// NewProduct creates a new active product
+func NewProduct(sku, name, description string, price money.Money, stock int, categoryID *uuid.UUID, images []ProductImage) *Product {
+	product := &Product{
+		ID:          uuid.New(),
+		SKU:         sku,
+		Name:        name,
+		Description: description,
+		Price:       price,
+		TaxCategory: DefaultTaxCategory,
+		Stock:       stock,
+		CategoryID:  categoryID,
+		Status:      ProductStatusActive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	product.SetImages(images)
+	return product
+}
+
+// SetTaxCategory sets the tax category of the product, or the default one
+func (p *Product) SetTaxCategory(category string) {
+	if category == "" {
+		category = DefaultTaxCategory
+	}
+	p.TaxCategory = category
+}
+
+// SetImages replaces the images of the product
+func (p *Product) SetImages(images []ProductImage) {
+	p.Images = make([]ProductImage, len(images))
+	for i, image := range images {
+		image.ID = uuid.New()
+		image.ProductID = p.ID
+		image.CreatedAt = time.Now()
+		p.Images[i] = image
+	}
+	p.UpdatedAt = time.Now()
+}
+
+// Archive removes the product from sale. Archived products keep their history
+// but can no longer be added to carts.
+func (p *Product) Archive() {
+	if p.IsArchived() {
+		return
+	}
+	now := time.Now()
+	p.Status = ProductStatusArchived
+	p.ArchivedAt = &now
+	p.UpdatedAt = now
+}
+
+// IsArchived reports whether the product was archived
+func (p *Product) IsArchived() bool {
+	return p.Status == ProductStatusArchived
+}
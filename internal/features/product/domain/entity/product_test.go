@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+func TestProduct_Archive(t *testing.T) {
+	product := NewProduct("SKU-1", "Mug", "", money.New(950, money.USD), 10, nil, nil)
+	assert.Equal(t, ProductStatusActive, product.Status)
+	assert.False(t, product.IsArchived())
+
+	product.Archive()
+	require.True(t, product.IsArchived())
+	archivedAt := product.ArchivedAt
+	require.NotNil(t, archivedAt)
+
+	// Archiving again keeps the original timestamp
+	product.Archive()
+	assert.Equal(t, archivedAt, product.ArchivedAt)
+}
+
+func TestProduct_SetImages(t *testing.T) {
+	product := NewProduct("SKU-1", "Mug", "", money.New(950, money.USD), 10, nil, []ProductImage{
+		{URL: "https://cdn.example.com/mug.png", Position: 1},
+	})
+	require.Len(t, product.Images, 1)
+	assert.Equal(t, product.ID, product.Images[0].ProductID)
+	firstID := product.Images[0].ID
+
+	product.SetImages([]ProductImage{{URL: "https://cdn.example.com/mug-2.png"}})
+	require.Len(t, product.Images, 1)
+	assert.NotEqual(t, firstID, product.Images[0].ID)
+	assert.Equal(t, "https://cdn.example.com/mug-2.png", product.Images[0].URL)
+}
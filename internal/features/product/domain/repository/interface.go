@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+)
+
+var (
+	ErrProductNotFound  = errors.New("product not found")
+	ErrCategoryNotFound = errors.New("category not found")
+)
+
+// ProductFilter narrows down a product listing
+type ProductFilter struct {
+	CategoryID *uuid.UUID
+	Status     entity.ProductStatus
+	Limit      int
+	Offset     int
+}
+
+// ProductRepository defines the interface for product data persistence
+type ProductRepository interface {
+	// Create saves a new product with its images
+	Create(ctx context.Context, product *entity.Product) error
+
+	// Update updates an existing product and replaces its images
+	Update(ctx context.Context, product *entity.Product) error
+
+	// GetByID retrieves a product by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Product, error)
+
+	// GetBySKU retrieves a product by its SKU
+	GetBySKU(ctx context.Context, sku string) (*entity.Product, error)
+
+	// List retrieves the products matching the filter and their total count
+	List(ctx context.Context, filter ProductFilter) ([]*entity.Product, int64, error)
+
+	// Search retrieves a page of active products matching the filter, with facet counts
+	Search(ctx context.Context, filter SearchFilter) (*SearchResult, error)
+}
+
+// CategoryRepository defines the interface for category data persistence
+type CategoryRepository interface {
+	// Create saves a new category
+	Create(ctx context.Context, category *entity.Category) error
+
+	// GetByID retrieves a category by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Category, error)
+
+	// GetBySlug retrieves a category by its slug
+	GetBySlug(ctx context.Context, slug string) (*entity.Category, error)
+
+	// List retrieves all categories
+	List(ctx context.Context) ([]*entity.Category, error)
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("invalid sort")
+)
+
+// SearchSort is an order search results can be returned in
+type SearchSort string
+
+const (
+	SortRelevance SearchSort = "relevance"
+	SortPriceAsc  SearchSort = "price_asc"
+	SortPriceDesc SearchSort = "price_desc"
+	SortNewest    SearchSort = "newest"
+)
+
+// PriceBuckets are the upper bounds of the price range facets in minor units;
+// the last range is open
+var PriceBuckets = []int64{2500, 5000, 10000, 25000}
+
+// ParseSearchSort validates a sort. Without a text query there is no
+// relevance, so it defaults to newest.
+func ParseSearchSort(value string, hasQuery bool) (SearchSort, error) {
+	switch SearchSort(value) {
+	case "", SortRelevance:
+		if hasQuery {
+			return SortRelevance, nil
+		}
+		return SortNewest, nil
+	case SortPriceAsc, SortPriceDesc, SortNewest:
+		return SearchSort(value), nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+// SearchFilter selects and orders the active products returned by Search
+type SearchFilter struct {
+	Query       string
+	CategoryIDs []uuid.UUID
+	MinPrice    *int64
+	MaxPrice    *int64
+	InStock     bool
+	Sort        SearchSort
+	After       *SearchCursor
+	Limit       int
+}
+
+// SearchHit is a product matching a search with its text relevance
+type SearchHit struct {
+	Product *entity.Product
+	Rank    float64
+}
+
+// CategoryFacet counts the matching products of a category; CategoryID is nil
+// for products without a category
+type CategoryFacet struct {
+	CategoryID *uuid.UUID
+	Name       string
+	Count      int64
+}
+
+// PriceRangeFacet counts the matching products priced in [Min, Max) minor
+// units; Max is nil for the last range
+type PriceRangeFacet struct {
+	Min   int64
+	Max   *int64
+	Count int64
+}
+
+// Facets counts the matching products per filter value. Each facet applies
+// every filter except its own, so its counts show what selecting a value yields.
+type Facets struct {
+	Categories  []CategoryFacet
+	PriceRanges []PriceRangeFacet
+	InStock     int64
+	OutOfStock  int64
+}
+
+// SearchResult is a page of search hits
+type SearchResult struct {
+	Hits   []SearchHit
+	Total  int64
+	Facets Facets
+}
+
+// SearchCursor points at the last hit of a page. The next page starts right
+// after it in the (sort key, ID) order.
+type SearchCursor struct {
+	Sort  SearchSort `json:"s"`
+	Rank  float64    `json:"r,omitempty"`
+	Price int64      `json:"p,omitempty"`
+	Time  time.Time  `json:"t,omitempty"`
+	ID    uuid.UUID  `json:"id"`
+}
+
+// SearchCursorFor returns the cursor pointing at hit for the given sort
+func SearchCursorFor(hit SearchHit, sort SearchSort) *SearchCursor {
+	c := &SearchCursor{Sort: sort, ID: hit.Product.ID}
+	switch sort {
+	case SortRelevance:
+		c.Rank = hit.Rank
+	case SortPriceAsc, SortPriceDesc:
+		c.Price = hit.Product.Price.Amount
+	default:
+		c.Time = hit.Product.CreatedAt
+	}
+	return c
+}
+
+// Encode returns the opaque string form of the cursor
+func (c *SearchCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeSearchCursor parses a cursor returned by Encode
+func DecodeSearchCursor(value string) (*SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c SearchCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := ParseSearchSort(string(c.Sort), true); err != nil || c.Sort == "" || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
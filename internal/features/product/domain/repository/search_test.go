@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+func TestParseSearchSort(t *testing.T) {
+	sort, err := ParseSearchSort("", true)
+	require.NoError(t, err)
+	assert.Equal(t, SortRelevance, sort)
+
+	sort, err = ParseSearchSort("", false)
+	require.NoError(t, err)
+	assert.Equal(t, SortNewest, sort)
+
+	sort, err = ParseSearchSort("price_desc", true)
+	require.NoError(t, err)
+	assert.Equal(t, SortPriceDesc, sort)
+
+	_, err = ParseSearchSort("name", false)
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
+
+func TestSearchCursor_RoundTrip(t *testing.T) {
+	hit := SearchHit{
+		Product: &entity.Product{
+			ID:        uuid.New(),
+			Price:     money.New(1999, money.USD),
+			CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		},
+		Rank: 0.0607927,
+	}
+
+	for _, sort := range []SearchSort{SortRelevance, SortPriceAsc, SortPriceDesc, SortNewest} {
+		cursor := SearchCursorFor(hit, sort)
+		decoded, err := DecodeSearchCursor(cursor.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, cursor.Sort, decoded.Sort)
+		assert.Equal(t, cursor.ID, decoded.ID)
+		assert.Equal(t, cursor.Rank, decoded.Rank)
+		assert.Equal(t, cursor.Price, decoded.Price)
+		assert.True(t, cursor.Time.Equal(decoded.Time))
+	}
+
+	_, err := DecodeSearchCursor("not a cursor")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = DecodeSearchCursor((&SearchCursor{Sort: "name", ID: uuid.New()}).Encode())
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
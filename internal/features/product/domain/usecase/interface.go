@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// Usecase defines the product catalog business logic interface
+type Usecase interface {
+	CreateProduct(ctx context.Context, input ProductInput) (*ProductResponse, error)
+	UpdateProduct(ctx context.Context, id uuid.UUID, input ProductInput) (*ProductResponse, error)
+	ArchiveProduct(ctx context.Context, id uuid.UUID) (*ProductResponse, error)
+	GetProduct(ctx context.Context, id uuid.UUID) (*ProductResponse, error)
+	ListProducts(ctx context.Context, categoryID *uuid.UUID, includeArchived bool, page, limit int32) ([]*ProductResponse, int64, error)
+	SearchProducts(ctx context.Context, input SearchInput) (*SearchResponse, error)
+	CreateCategory(ctx context.Context, input CategoryInput) (*CategoryResponse, error)
+	ListCategories(ctx context.Context) ([]*CategoryResponse, error)
+}
+
+// ProductInput holds the editable fields of a product. Stock is only used
+// when the product is created; afterwards it is changed through the inventory.
+type ProductInput struct {
+	SKU         string
+	Name        string
+	Description string
+	Price       money.Money
+	TaxCategory string
+	Stock       int
+	CategoryID  *uuid.UUID
+	Images      []ImageInput
+}
+
+// ImageInput holds the metadata of a product image
+type ImageInput struct {
+	URL      string
+	AltText  string
+	Position int
+	Width    int
+	Height   int
+}
+
+// CategoryInput holds the fields of a new category
+type CategoryInput struct {
+	Name        string
+	Slug        string
+	Description string
+	ParentID    *uuid.UUID
+}
+
+// SearchInput holds the query, facet filters, sort and page of a product search.
+// Sort is relevance, price_asc, price_desc or newest; Cursor is the NextCursor
+// of the previous page. Prices are in minor units.
+type SearchInput struct {
+	Query       string
+	CategoryIDs []uuid.UUID
+	MinPrice    *int64
+	MaxPrice    *int64
+	InStock     bool
+	Sort        string
+	Cursor      string
+	Limit       int32
+}
+
+type SearchResponse struct {
+	Products   []*ProductResponse `json:"products"`
+	Total      int64              `json:"total"`
+	Facets     SearchFacets       `json:"facets"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+type SearchFacets struct {
+	Categories   []CategoryFacet   `json:"categories"`
+	PriceRanges  []PriceRangeFacet `json:"price_ranges"`
+	Availability AvailabilityFacet `json:"availability"`
+}
+
+type CategoryFacet struct {
+	CategoryID *uuid.UUID `json:"category_id"`
+	Name       string     `json:"name"`
+	Count      int64      `json:"count"`
+}
+
+type PriceRangeFacet struct {
+	Min   int64  `json:"min"`
+	Max   *int64 `json:"max"`
+	Count int64  `json:"count"`
+}
+
+type AvailabilityFacet struct {
+	InStock    int64 `json:"in_stock"`
+	OutOfStock int64 `json:"out_of_stock"`
+}
+
+type ProductResponse struct {
+	ID          uuid.UUID         `json:"id"`
+	SKU         string            `json:"sku"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Price       money.Money       `json:"price"`
+	TaxCategory string            `json:"tax_category"`
+	Stock       int               `json:"stock"`
+	Category    *CategoryResponse `json:"category,omitempty"`
+	Images      []ImageResponse   `json:"images"`
+	Status      string            `json:"status"`
+	ArchivedAt  *time.Time        `json:"archived_at,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type ImageResponse struct {
+	ID       uuid.UUID `json:"id"`
+	URL      string    `json:"url"`
+	AltText  string    `json:"alt_text"`
+	Position int       `json:"position"`
+	Width    int       `json:"width"`
+	Height   int       `json:"height"`
+}
+
+type CategoryResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug"`
+	Description string     `json:"description"`
+}
+
+// Common errors
+var (
+	ErrNotFound          = NewError("product not found")
+	ErrCategoryNotFound  = NewError("category not found")
+	ErrDuplicateSKU      = NewError("product SKU already exists")
+	ErrDuplicateSlug     = NewError("category slug already exists")
+	ErrInvalidSKU        = NewError("product SKU is required")
+	ErrInvalidName       = NewError("name is required")
+	ErrInvalidSlug       = NewError("category slug is required")
+	ErrInvalidPrice      = NewError("price must not be negative")
+	ErrInvalidCurrency   = NewError("price must be in the base currency")
+	ErrInvalidStock      = NewError("stock must not be negative")
+	ErrInvalidImage      = NewError("image URL is required")
+	ErrArchived          = NewError("product is archived")
+	ErrInvalidSort       = NewError("invalid sort")
+	ErrInvalidCursor     = NewError("invalid cursor")
+	ErrInvalidPriceRange = NewError("invalid price range")
+)
+
+// Error represents a product catalog error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new product catalog error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
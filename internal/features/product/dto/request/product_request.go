@@ -0,0 +1,55 @@
+package request
+
+import "github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+
+// ProductImageRequest represents the metadata of a product image
+type ProductImageRequest struct {
+	URL      string `json:"url" validate:"required,url"`
+	AltText  string `json:"alt_text"`
+	Position int    `json:"position" validate:"min=0"`
+	Width    int    `json:"width" validate:"min=0"`
+	Height   int    `json:"height" validate:"min=0"`
+}
+
+// ProductRequest represents the request to create or update a product.
+// Price is in the minor units of its currency. TaxCategory defaults to
+// standard. Stock is only used when the product is created.
+type ProductRequest struct {
+	SKU         string                `json:"sku" validate:"required"`
+	Name        string                `json:"name" validate:"required"`
+	Description string                `json:"description"`
+	Price       money.Money           `json:"price"`
+	TaxCategory string                `json:"tax_category"`
+	Stock       int                   `json:"stock" validate:"min=0"`
+	CategoryID  string                `json:"category_id" validate:"omitempty,uuid"`
+	Images      []ProductImageRequest `json:"images" validate:"dive"`
+}
+
+// CreateCategoryRequest represents the request to create a category
+type CreateCategoryRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Slug        string `json:"slug" validate:"required"`
+	Description string `json:"description"`
+	ParentID    string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+// ListProductsRequest represents the request to list products
+type ListProductsRequest struct {
+	Page            int    `query:"page" validate:"min=1"`
+	Limit           int    `query:"limit" validate:"min=1,max=100"`
+	CategoryID      string `query:"category_id" validate:"omitempty,uuid"`
+	IncludeArchived bool   `query:"include_archived"`
+}
+
+// SearchProductsRequest represents the request to search products.
+// CategoryIDs is a comma separated list of category IDs; prices are in minor units.
+type SearchProductsRequest struct {
+	Query       string `query:"q"`
+	CategoryIDs string `query:"category_id"`
+	MinPrice    *int64 `query:"min_price" validate:"omitempty,min=0"`
+	MaxPrice    *int64 `query:"max_price" validate:"omitempty,min=0"`
+	InStock     bool   `query:"in_stock"`
+	Sort        string `query:"sort" validate:"omitempty,oneof=relevance price_asc price_desc newest"`
+	Cursor      string `query:"cursor"`
+	Limit       int    `query:"limit" validate:"min=0,max=100"`
+}
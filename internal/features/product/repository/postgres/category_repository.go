@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/repository"
+)
+
+// CategoryRepository implements the repository.CategoryRepository interface
+type CategoryRepository struct {
+	db *gorm.DB
+}
+
+// NewCategoryRepository creates a new PostgreSQL category repository
+func NewCategoryRepository(db *gorm.DB) repository.CategoryRepository {
+	return &CategoryRepository{
+		db: db,
+	}
+}
+
+// Create saves a new category
+func (r *CategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	return r.db.WithContext(ctx).Create(category).Error
+}
+
+// GetByID retrieves a category by its ID
+func (r *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// GetBySlug retrieves a category by its slug
+func (r *CategoryRepository) GetBySlug(ctx context.Context, slug string) (*entity.Category, error) {
+	return r.first(ctx, "slug = ?", slug)
+}
+
+// List retrieves all categories
+func (r *CategoryRepository) List(ctx context.Context) ([]*entity.Category, error) {
+	var categories []*entity.Category
+	if err := r.db.WithContext(ctx).Order("name").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *CategoryRepository) first(ctx context.Context, query string, args ...interface{}) (*entity.Category, error) {
+	var category entity.Category
+	err := r.db.WithContext(ctx).Where(query, args...).First(&category).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
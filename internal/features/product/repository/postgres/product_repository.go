@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/repository"
+)
+
+// ProductRepository implements the repository.ProductRepository interface
+type ProductRepository struct {
+	db *gorm.DB
+}
+
+// NewProductRepository creates a new PostgreSQL product repository
+func NewProductRepository(db *gorm.DB) repository.ProductRepository {
+	return &ProductRepository{
+		db: db,
+	}
+}
+
+// Create saves a new product with its images
+func (r *ProductRepository) Create(ctx context.Context, product *entity.Product) error {
+	return r.db.WithContext(ctx).Omit("Category").Create(product).Error
+}
+
+// Update updates an existing product and replaces its images. Stock is not
+// written; it is changed by the inventory module only.
+func (r *ProductRepository) Update(ctx context.Context, product *entity.Product) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Category", "Images").Save(product).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("product_id = ?", product.ID).Delete(&entity.ProductImage{}).Error; err != nil {
+			return err
+		}
+		if len(product.Images) == 0 {
+			return nil
+		}
+		return tx.Create(&product.Images).Error
+	})
+}
+
+// GetByID retrieves a product by its ID
+func (r *ProductRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// GetBySKU retrieves a product by its SKU
+func (r *ProductRepository) GetBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	return r.first(ctx, "sku = ?", sku)
+}
+
+// List retrieves the products matching the filter and their total count
+func (r *ProductRepository) List(ctx context.Context, filter repository.ProductFilter) ([]*entity.Product, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entity.Product{})
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var products []*entity.Product
+	err := query.
+		Preload("Category").
+		Preload("Images", imageOrder).
+		Order("created_at DESC, id").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&products).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return products, total, nil
+}
+
+func (r *ProductRepository) first(ctx context.Context, query string, args ...interface{}) (*entity.Product, error) {
+	var product entity.Product
+	err := r.db.WithContext(ctx).
+		Preload("Category").
+		Preload("Images", imageOrder).
+		Where(query, args...).
+		First(&product).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// imageOrder sorts preloaded images by their position
+func imageOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("position, created_at")
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/repository"
+)
+
+// searchFacet names the filter a facet count leaves out
+type searchFacet int
+
+const (
+	facetNone searchFacet = iota
+	facetCategory
+	facetPrice
+	facetStock
+)
+
+// tsQuery parses the shopper's query the way web search engines do: quoted
+// phrases, OR and -exclusions are understood
+const tsQuery = "websearch_to_tsquery('english', ?)"
+
+// Search retrieves a page of active products matching the filter, with facet counts
+func (r *ProductRepository) Search(ctx context.Context, filter repository.SearchFilter) (*repository.SearchResult, error) {
+	result := &repository.SearchResult{}
+	if err := r.searchQuery(ctx, filter, facetNone).Count(&result.Total).Error; err != nil {
+		return nil, err
+	}
+
+	hits, err := r.searchHits(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	result.Hits = hits
+
+	if result.Facets.Categories, err = r.categoryFacets(ctx, filter); err != nil {
+		return nil, err
+	}
+	if result.Facets.PriceRanges, err = r.priceFacets(ctx, filter); err != nil {
+		return nil, err
+	}
+	if err := r.stockFacets(ctx, filter, &result.Facets); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// searchHits returns the page of hits in sort order, keyset paginated on (sort key, id)
+func (r *ProductRepository) searchHits(ctx context.Context, filter repository.SearchFilter) ([]repository.SearchHit, error) {
+	rank := "0"
+	var rankArgs []interface{}
+	if filter.Query != "" {
+		rank = "ts_rank(p.search_vector, " + tsQuery + ")"
+		rankArgs = []interface{}{filter.Query}
+	}
+
+	// Sort key with its arguments, and the cursor value to continue after
+	var key string
+	var keyArgs []interface{}
+	comparison, direction := "<", "DESC"
+	var after interface{}
+	switch filter.Sort {
+	case repository.SortRelevance:
+		key, keyArgs = rank, rankArgs
+		if filter.After != nil {
+			after = filter.After.Rank
+		}
+	case repository.SortPriceAsc, repository.SortPriceDesc:
+		key = "p.price_amount"
+		if filter.Sort == repository.SortPriceAsc {
+			comparison, direction = ">", "ASC"
+		}
+		if filter.After != nil {
+			after = filter.After.Price
+		}
+	default:
+		key = "p.created_at"
+		if filter.After != nil {
+			after = filter.After.Time
+		}
+	}
+
+	query := r.searchQuery(ctx, filter, facetNone).
+		Select("p.id, "+rank+" AS rank", rankArgs...)
+	if filter.After != nil {
+		args := append(append([]interface{}{}, keyArgs...), after, filter.After.ID)
+		query = query.Where(fmt.Sprintf("(%s, p.id) %s (?, ?)", key, comparison), args...)
+	}
+	query = query.
+		Order(gorm.Expr(fmt.Sprintf("%s %s, p.id %s", key, direction, direction), keyArgs...)).
+		Limit(filter.Limit)
+
+	var rows []struct {
+		ID   uuid.UUID
+		Rank float64
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	var products []*entity.Product
+	err := r.db.WithContext(ctx).
+		Preload("Category").
+		Preload("Images", imageOrder).
+		Where("id IN ?", ids).
+		Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*entity.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+	hits := make([]repository.SearchHit, 0, len(rows))
+	for _, row := range rows {
+		// A product removed in between is skipped
+		if product, ok := byID[row.ID]; ok {
+			hits = append(hits, repository.SearchHit{Product: product, Rank: row.Rank})
+		}
+	}
+	return hits, nil
+}
+
+// categoryFacets counts the matching products per category
+func (r *ProductRepository) categoryFacets(ctx context.Context, filter repository.SearchFilter) ([]repository.CategoryFacet, error) {
+	var rows []struct {
+		CategoryID *uuid.UUID
+		Name       string
+		Count      int64
+	}
+	err := r.searchQuery(ctx, filter, facetCategory).
+		Select("p.category_id, COALESCE(c.name, '') AS name, COUNT(*) AS count").
+		Joins("LEFT JOIN categories c ON c.id = p.category_id").
+		Group("p.category_id, c.name").
+		Order("count DESC, name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	facets := make([]repository.CategoryFacet, len(rows))
+	for i, row := range rows {
+		facets[i] = repository.CategoryFacet{CategoryID: row.CategoryID, Name: row.Name, Count: row.Count}
+	}
+	return facets, nil
+}
+
+// priceFacets counts the matching products per price range; empty ranges are included
+func (r *ProductRepository) priceFacets(ctx context.Context, filter repository.SearchFilter) ([]repository.PriceRangeFacet, error) {
+	bounds := make([]string, len(repository.PriceBuckets))
+	for i, bound := range repository.PriceBuckets {
+		bounds[i] = strconv.FormatInt(bound, 10)
+	}
+
+	var rows []struct {
+		Bucket int
+		Count  int64
+	}
+	err := r.searchQuery(ctx, filter, facetPrice).
+		Select(fmt.Sprintf("width_bucket(p.price_amount, ARRAY[%s]::bigint[]) AS bucket, COUNT(*) AS count", strings.Join(bounds, ","))).
+		Group("bucket").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Bucket 0 holds prices below the first bound, bucket i prices in [bound i-1, bound i)
+	facets := make([]repository.PriceRangeFacet, len(repository.PriceBuckets)+1)
+	for i := range facets {
+		if i > 0 {
+			facets[i].Min = repository.PriceBuckets[i-1]
+		}
+		if i < len(repository.PriceBuckets) {
+			max := repository.PriceBuckets[i]
+			facets[i].Max = &max
+		}
+	}
+	for _, row := range rows {
+		facets[row.Bucket].Count = row.Count
+	}
+	return facets, nil
+}
+
+// stockFacets counts the matching products in and out of stock
+func (r *ProductRepository) stockFacets(ctx context.Context, filter repository.SearchFilter, facets *repository.Facets) error {
+	var row struct {
+		InStock    int64
+		OutOfStock int64
+	}
+	err := r.searchQuery(ctx, filter, facetStock).
+		Select("COUNT(*) FILTER (WHERE p.stock > 0) AS in_stock, COUNT(*) FILTER (WHERE p.stock <= 0) AS out_of_stock").
+		Scan(&row).Error
+	if err != nil {
+		return err
+	}
+
+	facets.InStock = row.InStock
+	facets.OutOfStock = row.OutOfStock
+	return nil
+}
+
+// searchQuery applies the filter conditions of a search, except the one of the given facet
+func (r *ProductRepository) searchQuery(ctx context.Context, filter repository.SearchFilter, except searchFacet) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Table("products p").
+		Where("p.status = ?", entity.ProductStatusActive)
+	if filter.Query != "" {
+		query = query.Where("p.search_vector @@ "+tsQuery, filter.Query)
+	}
+	if len(filter.CategoryIDs) > 0 && except != facetCategory {
+		query = query.Where("p.category_id IN ?", filter.CategoryIDs)
+	}
+	if except != facetPrice {
+		if filter.MinPrice != nil {
+			query = query.Where("p.price_amount >= ?", *filter.MinPrice)
+		}
+		if filter.MaxPrice != nil {
+			query = query.Where("p.price_amount <= ?", *filter.MaxPrice)
+		}
+	}
+	if filter.InStock && except != facetStock {
+		query = query.Where("p.stock > 0")
+	}
+	return query
+}
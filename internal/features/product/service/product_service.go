@@ -7,40 +7,36 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/repository/postgres"
 )
 
 type productService struct {
-	db *gorm.DB
+	productRepo repository.ProductRepository
 }
 
 // NewProductService creates a new product service
 func NewProductService(db *gorm.DB) usecase.ProductService {
 	return &productService{
-		db: db,
+		productRepo: postgres.NewProductRepository(db),
 	}
 }
 
-// GetProduct retrieves a product by ID
+// GetProduct retrieves a product on sale by ID; archived products are not found
 func (s *productService) GetProduct(ctx context.Context, id uuid.UUID) (*usecase.Product, error) {
-	var product struct {
-		ID    uuid.UUID `gorm:"column:id"`
-		Name  string    `gorm:"column:name"`
-		Price float64   `gorm:"column:price"`
-		Stock int       `gorm:"column:stock"`
-	}
-
-	err := s.db.WithContext(ctx).
-		Table("products").
-		Where("id = ?", id).
-		First(&product).Error
+	product, err := s.productRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if product.IsArchived() {
+		return nil, repository.ErrProductNotFound
+	}
 
 	return &usecase.Product{
-		ID:    product.ID,
-		Name:  product.Name,
-		Price: product.Price,
-		Stock: product.Stock,
+		ID:          product.ID,
+		Name:        product.Name,
+		Price:       product.Price,
+		TaxCategory: product.TaxCategory,
+		Stock:       product.Stock,
 	}, nil
 }
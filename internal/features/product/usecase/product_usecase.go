@@ -0,0 +1,394 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/product/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+type ProductUsecase struct {
+	productRepo  repository.ProductRepository
+	categoryRepo repository.CategoryRepository
+	baseCurrency money.Currency
+}
+
+// NewProductUsecase creates a new product usecase. Products are priced in
+// baseCurrency and converted to the shopper's currency in the cart.
+func NewProductUsecase(productRepo repository.ProductRepository, categoryRepo repository.CategoryRepository, baseCurrency money.Currency) *ProductUsecase {
+	return &ProductUsecase{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		baseCurrency: baseCurrency,
+	}
+}
+
+// CreateProduct adds a new product to the catalog
+func (u *ProductUsecase) CreateProduct(ctx context.Context, input usecase.ProductInput) (*usecase.ProductResponse, error) {
+	input.SKU = strings.TrimSpace(input.SKU)
+	if err := u.validateProduct(ctx, input); err != nil {
+		return nil, err
+	}
+	if input.Stock < 0 {
+		return nil, usecase.ErrInvalidStock
+	}
+	if err := u.checkSKU(ctx, input.SKU, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	product := entity.NewProduct(input.SKU, input.Name, input.Description, input.Price, input.Stock, input.CategoryID, convertImageInputs(input.Images))
+	product.SetTaxCategory(strings.TrimSpace(input.TaxCategory))
+	if err := u.productRepo.Create(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return u.GetProduct(ctx, product.ID)
+}
+
+// UpdateProduct replaces the editable fields of a product
+func (u *ProductUsecase) UpdateProduct(ctx context.Context, id uuid.UUID, input usecase.ProductInput) (*usecase.ProductResponse, error) {
+	product, err := u.getProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsArchived() {
+		return nil, usecase.ErrArchived
+	}
+
+	input.SKU = strings.TrimSpace(input.SKU)
+	if err := u.validateProduct(ctx, input); err != nil {
+		return nil, err
+	}
+	if err := u.checkSKU(ctx, input.SKU, product.ID); err != nil {
+		return nil, err
+	}
+
+	product.SKU = input.SKU
+	product.Name = input.Name
+	product.Description = input.Description
+	product.Price = input.Price
+	product.SetTaxCategory(strings.TrimSpace(input.TaxCategory))
+	product.CategoryID = input.CategoryID
+	product.Category = nil
+	product.SetImages(convertImageInputs(input.Images))
+	if err := u.productRepo.Update(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return u.GetProduct(ctx, product.ID)
+}
+
+// ArchiveProduct removes a product from sale; archiving twice is a no-op
+func (u *ProductUsecase) ArchiveProduct(ctx context.Context, id uuid.UUID) (*usecase.ProductResponse, error) {
+	product, err := u.getProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !product.IsArchived() {
+		product.Archive()
+		if err := u.productRepo.Update(ctx, product); err != nil {
+			return nil, err
+		}
+	}
+
+	return convertProduct(product), nil
+}
+
+// GetProduct retrieves a product by ID
+func (u *ProductUsecase) GetProduct(ctx context.Context, id uuid.UUID) (*usecase.ProductResponse, error) {
+	product, err := u.getProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertProduct(product), nil
+}
+
+// ListProducts retrieves a page of products, optionally of a single category
+func (u *ProductUsecase) ListProducts(ctx context.Context, categoryID *uuid.UUID, includeArchived bool, page, limit int32) ([]*usecase.ProductResponse, int64, error) {
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	filter := repository.ProductFilter{
+		CategoryID: categoryID,
+		Limit:      int(limit),
+		Offset:     int((page - 1) * limit),
+	}
+	if !includeArchived {
+		filter.Status = entity.ProductStatusActive
+	}
+
+	products, total, err := u.productRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*usecase.ProductResponse, len(products))
+	for i, product := range products {
+		result[i] = convertProduct(product)
+	}
+	return result, total, nil
+}
+
+// SearchProducts searches the active products by text and facet filters
+func (u *ProductUsecase) SearchProducts(ctx context.Context, input usecase.SearchInput) (*usecase.SearchResponse, error) {
+	query := strings.TrimSpace(input.Query)
+	sort, err := repository.ParseSearchSort(input.Sort, query != "")
+	if err != nil {
+		return nil, usecase.ErrInvalidSort
+	}
+	if (input.MinPrice != nil && *input.MinPrice < 0) ||
+		(input.MinPrice != nil && input.MaxPrice != nil && *input.MinPrice > *input.MaxPrice) {
+		return nil, usecase.ErrInvalidPriceRange
+	}
+
+	limit := int(input.Limit)
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	filter := repository.SearchFilter{
+		Query:       query,
+		CategoryIDs: input.CategoryIDs,
+		MinPrice:    input.MinPrice,
+		MaxPrice:    input.MaxPrice,
+		InStock:     input.InStock,
+		Sort:        sort,
+		Limit:       limit + 1,
+	}
+	if input.Cursor != "" {
+		cursor, err := repository.DecodeSearchCursor(input.Cursor)
+		if err != nil || cursor.Sort != sort {
+			return nil, usecase.ErrInvalidCursor
+		}
+		filter.After = cursor
+	}
+
+	result, err := u.productRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// The extra hit tells whether there is a next page
+	hits := result.Hits
+	resp := &usecase.SearchResponse{
+		Total:  result.Total,
+		Facets: convertFacets(result.Facets),
+	}
+	if len(hits) > limit {
+		hits = hits[:limit]
+		resp.NextCursor = repository.SearchCursorFor(hits[limit-1], sort).Encode()
+	}
+
+	resp.Products = make([]*usecase.ProductResponse, len(hits))
+	for i, hit := range hits {
+		resp.Products[i] = convertProduct(hit.Product)
+	}
+	return resp, nil
+}
+
+// CreateCategory adds a new category
+func (u *ProductUsecase) CreateCategory(ctx context.Context, input usecase.CategoryInput) (*usecase.CategoryResponse, error) {
+	input.Name = strings.TrimSpace(input.Name)
+	input.Slug = strings.ToLower(strings.TrimSpace(input.Slug))
+	if input.Name == "" {
+		return nil, usecase.ErrInvalidName
+	}
+	if input.Slug == "" {
+		return nil, usecase.ErrInvalidSlug
+	}
+
+	if _, err := u.categoryRepo.GetBySlug(ctx, input.Slug); err == nil {
+		return nil, usecase.ErrDuplicateSlug
+	} else if !errors.Is(err, repository.ErrCategoryNotFound) {
+		return nil, err
+	}
+	if input.ParentID != nil {
+		if err := u.checkCategory(ctx, *input.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	category := entity.NewCategory(input.Name, input.Slug, input.Description, input.ParentID)
+	if err := u.categoryRepo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return convertCategory(category), nil
+}
+
+// ListCategories retrieves all categories
+func (u *ProductUsecase) ListCategories(ctx context.Context) ([]*usecase.CategoryResponse, error) {
+	categories, err := u.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*usecase.CategoryResponse, len(categories))
+	for i, category := range categories {
+		result[i] = convertCategory(category)
+	}
+	return result, nil
+}
+
+func (u *ProductUsecase) getProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	product, err := u.productRepo.GetByID(ctx, id)
+	if errors.Is(err, repository.ErrProductNotFound) {
+		return nil, usecase.ErrNotFound
+	}
+	return product, err
+}
+
+// validateProduct checks the fields shared by create and update
+func (u *ProductUsecase) validateProduct(ctx context.Context, input usecase.ProductInput) error {
+	if input.SKU == "" {
+		return usecase.ErrInvalidSKU
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return usecase.ErrInvalidName
+	}
+	if input.Price.Currency != u.baseCurrency {
+		return usecase.ErrInvalidCurrency
+	}
+	if input.Price.IsNegative() {
+		return usecase.ErrInvalidPrice
+	}
+	for _, image := range input.Images {
+		if strings.TrimSpace(image.URL) == "" {
+			return usecase.ErrInvalidImage
+		}
+	}
+	if input.CategoryID != nil {
+		return u.checkCategory(ctx, *input.CategoryID)
+	}
+	return nil
+}
+
+// checkSKU fails if the SKU belongs to a product other than productID
+func (u *ProductUsecase) checkSKU(ctx context.Context, sku string, productID uuid.UUID) error {
+	existing, err := u.productRepo.GetBySKU(ctx, sku)
+	if errors.Is(err, repository.ErrProductNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.ID != productID {
+		return usecase.ErrDuplicateSKU
+	}
+	return nil
+}
+
+func (u *ProductUsecase) checkCategory(ctx context.Context, id uuid.UUID) error {
+	_, err := u.categoryRepo.GetByID(ctx, id)
+	if errors.Is(err, repository.ErrCategoryNotFound) {
+		return usecase.ErrCategoryNotFound
+	}
+	return err
+}
+
+func convertImageInputs(images []usecase.ImageInput) []entity.ProductImage {
+	result := make([]entity.ProductImage, len(images))
+	for i, image := range images {
+		result[i] = entity.ProductImage{
+			URL:      image.URL,
+			AltText:  image.AltText,
+			Position: image.Position,
+			Width:    image.Width,
+			Height:   image.Height,
+		}
+	}
+	return result
+}
+
+func convertProduct(product *entity.Product) *usecase.ProductResponse {
+	images := make([]usecase.ImageResponse, len(product.Images))
+	for i, image := range product.Images {
+		images[i] = usecase.ImageResponse{
+			ID:       image.ID,
+			URL:      image.URL,
+			AltText:  image.AltText,
+			Position: image.Position,
+			Width:    image.Width,
+			Height:   image.Height,
+		}
+	}
+
+	var category *usecase.CategoryResponse
+	if product.Category != nil {
+		category = convertCategory(product.Category)
+	}
+
+	return &usecase.ProductResponse{
+		ID:          product.ID,
+		SKU:         product.SKU,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		TaxCategory: product.TaxCategory,
+		Stock:       product.Stock,
+		Category:    category,
+		Images:      images,
+		Status:      string(product.Status),
+		ArchivedAt:  product.ArchivedAt,
+		CreatedAt:   product.CreatedAt,
+		UpdatedAt:   product.UpdatedAt,
+	}
+}
+
+func convertFacets(facets repository.Facets) usecase.SearchFacets {
+	result := usecase.SearchFacets{
+		Categories:  make([]usecase.CategoryFacet, len(facets.Categories)),
+		PriceRanges: make([]usecase.PriceRangeFacet, len(facets.PriceRanges)),
+		Availability: usecase.AvailabilityFacet{
+			InStock:    facets.InStock,
+			OutOfStock: facets.OutOfStock,
+		},
+	}
+	for i, facet := range facets.Categories {
+		result.Categories[i] = usecase.CategoryFacet{
+			CategoryID: facet.CategoryID,
+			Name:       facet.Name,
+			Count:      facet.Count,
+		}
+	}
+	for i, facet := range facets.PriceRanges {
+		result.PriceRanges[i] = usecase.PriceRangeFacet{
+			Min:   facet.Min,
+			Max:   facet.Max,
+			Count: facet.Count,
+		}
+	}
+	return result
+}
+
+func convertCategory(category *entity.Category) *usecase.CategoryResponse {
+	return &usecase.CategoryResponse{
+		ID:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/delivery/grpc/proto"
+)
+
+// PromotionClient represents the gRPC client for promotion service
+type PromotionClient struct {
+	client pb.PromotionServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewPromotionClient creates a new promotion gRPC client
+func NewPromotionClient(address string) (*PromotionClient, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	client := pb.NewPromotionServiceClient(conn)
+	return &PromotionClient{
+		client: client,
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the client connection
+func (c *PromotionClient) Close() error {
+	return c.conn.Close()
+}
+
+// CreatePromotion creates a new promotion
+func (c *PromotionClient) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.Promotion, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.CreatePromotion(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promotion: %w", err)
+	}
+
+	return resp.Promotion, nil
+}
+
+// GetPromotion retrieves a promotion by ID
+func (c *PromotionClient) GetPromotion(ctx context.Context, promotionID string) (*pb.Promotion, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.GetPromotion(ctx, &pb.GetPromotionRequest{PromotionId: promotionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get promotion: %w", err)
+	}
+
+	return resp.Promotion, nil
+}
+
+// ListPromotions retrieves all promotions
+func (c *PromotionClient) ListPromotions(ctx context.Context) ([]*pb.Promotion, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	resp, err := c.client.ListPromotions(ctx, &pb.ListPromotionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promotions: %w", err)
+	}
+
+	return resp.Promotions, nil
+}
+
+// PriceItems prices the items for a user with a coupon code applied
+func (c *PromotionClient) PriceItems(ctx context.Context, req *pb.PriceItemsRequest) (*pb.PriceItemsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	return c.client.PriceItems(ctx, req)
+}
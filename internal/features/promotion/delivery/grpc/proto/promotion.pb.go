@@ -0,0 +1,1028 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: internal/features/promotion/delivery/grpc/proto/promotion.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	ProductId     string                 `protobuf:"bytes,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BuyQuantity   int32                  `protobuf:"varint,7,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity   int32                  `protobuf:"varint,8,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	UsageLimit    int32                  `protobuf:"varint,10,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserLimit  int32                  `protobuf:"varint,11,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,16,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinOrderValue *Money                 `protobuf:"bytes,17,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Promotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Promotion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Promotion) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Promotion) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Promotion) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Promotion) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *Promotion) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Promotion) GetMinOrderValue() *Money {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return nil
+}
+
+type CreatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	ProductId     string                 `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BuyQuantity   int32                  `protobuf:"varint,6,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity   int32                  `protobuf:"varint,7,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	UsageLimit    int32                  `protobuf:"varint,9,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserLimit  int32                  `protobuf:"varint,10,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinOrderValue *Money                 `protobuf:"bytes,14,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetMinOrderValue() *Money {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Promotion     *Promotion             `protobuf:"bytes,3,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePromotionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreatePromotionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type GetPromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionRequest) Reset() {
+	*x = GetPromotionRequest{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionRequest) ProtoMessage() {}
+
+func (x *GetPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionRequest.ProtoReflect.Descriptor instead.
+func (*GetPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPromotionRequest) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+type GetPromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionResponse) Reset() {
+	*x = GetPromotionResponse{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionResponse) ProtoMessage() {}
+
+func (x *GetPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionResponse.ProtoReflect.Descriptor instead.
+func (*GetPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{6}
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type LineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItem) ProtoMessage() {}
+
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LineItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LineItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LineItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type DiscountLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountLine) Reset() {
+	*x = DiscountLine{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountLine) ProtoMessage() {}
+
+func (x *DiscountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountLine.ProtoReflect.Descriptor instead.
+func (*DiscountLine) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DiscountLine) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *DiscountLine) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DiscountLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DiscountLine) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type PriceItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Items         []*LineItem            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceItemsRequest) Reset() {
+	*x = PriceItemsRequest{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceItemsRequest) ProtoMessage() {}
+
+func (x *PriceItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceItemsRequest.ProtoReflect.Descriptor instead.
+func (*PriceItemsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PriceItemsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PriceItemsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PriceItemsRequest) GetItems() []*LineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PriceItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*DiscountLine        `protobuf:"bytes,2,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Subtotal      *Money                 `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Total         *Money                 `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceItemsResponse) Reset() {
+	*x = PriceItemsResponse{}
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceItemsResponse) ProtoMessage() {}
+
+func (x *PriceItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceItemsResponse.ProtoReflect.Descriptor instead.
+func (*PriceItemsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PriceItemsResponse) GetDiscounts() []*DiscountLine {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *PriceItemsResponse) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *PriceItemsResponse) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+var File_internal_features_promotion_delivery_grpc_proto_promotion_proto protoreflect.FileDescriptor
+
+const file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDesc = "" +
+	"\n" +
+	"?internal/features/promotion/delivery/grpc/proto/promotion.proto\x12\tpromotion\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xf5\x04\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x06 \x01(\tR\tproductId\x12!\n" +
+	"\fbuy_quantity\x18\a \x01(\x05R\vbuyQuantity\x12!\n" +
+	"\fget_quantity\x18\b \x01(\x05R\vgetQuantity\x12\x1f\n" +
+	"\vusage_limit\x18\n" +
+	" \x01(\x05R\n" +
+	"usageLimit\x12$\n" +
+	"\x0eper_user_limit\x18\v \x01(\x05R\fperUserLimit\x127\n" +
+	"\tstarts_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
+	"\x06amount\x18\x10 \x01(\v2\x10.promotion.MoneyR\x06amount\x128\n" +
+	"\x0fmin_order_value\x18\x11 \x01(\v2\x10.promotion.MoneyR\rminOrderValueJ\x04\b\t\x10\n" +
+	"\"\xfc\x03\n" +
+	"\x16CreatePromotionRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\tR\tproductId\x12!\n" +
+	"\fbuy_quantity\x18\x06 \x01(\x05R\vbuyQuantity\x12!\n" +
+	"\fget_quantity\x18\a \x01(\x05R\vgetQuantity\x12\x1f\n" +
+	"\vusage_limit\x18\t \x01(\x05R\n" +
+	"usageLimit\x12$\n" +
+	"\x0eper_user_limit\x18\n" +
+	" \x01(\x05R\fperUserLimit\x127\n" +
+	"\tstarts_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12(\n" +
+	"\x06amount\x18\r \x01(\v2\x10.promotion.MoneyR\x06amount\x128\n" +
+	"\x0fmin_order_value\x18\x0e \x01(\v2\x10.promotion.MoneyR\rminOrderValueJ\x04\b\b\x10\t\"\x81\x01\n" +
+	"\x17CreatePromotionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\tpromotion\x18\x03 \x01(\v2\x14.promotion.PromotionR\tpromotion\"8\n" +
+	"\x13GetPromotionRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\"J\n" +
+	"\x14GetPromotionResponse\x122\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x14.promotion.PromotionR\tpromotion\"\x17\n" +
+	"\x15ListPromotionsRequest\"N\n" +
+	"\x16ListPromotionsResponse\x124\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x14.promotion.PromotionR\n" +
+	"promotions\"s\n" +
+	"\bLineItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12&\n" +
+	"\x05price\x18\x04 \x01(\v2\x10.promotion.MoneyR\x05priceJ\x04\b\x02\x10\x03\"\x97\x01\n" +
+	"\fDiscountLine\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x06amount\x18\x05 \x01(\v2\x10.promotion.MoneyR\x06amountJ\x04\b\x04\x10\x05\"k\n" +
+	"\x11PriceItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12)\n" +
+	"\x05items\x18\x03 \x03(\v2\x13.promotion.LineItemR\x05items\"\xad\x01\n" +
+	"\x12PriceItemsResponse\x125\n" +
+	"\tdiscounts\x18\x02 \x03(\v2\x17.promotion.DiscountLineR\tdiscounts\x12,\n" +
+	"\bsubtotal\x18\x04 \x01(\v2\x10.promotion.MoneyR\bsubtotal\x12&\n" +
+	"\x05total\x18\x05 \x01(\v2\x10.promotion.MoneyR\x05totalJ\x04\b\x01\x10\x02J\x04\b\x03\x10\x042\xdf\x02\n" +
+	"\x10PromotionService\x12X\n" +
+	"\x0fCreatePromotion\x12!.promotion.CreatePromotionRequest\x1a\".promotion.CreatePromotionResponse\x12O\n" +
+	"\fGetPromotion\x12\x1e.promotion.GetPromotionRequest\x1a\x1f.promotion.GetPromotionResponse\x12U\n" +
+	"\x0eListPromotions\x12 .promotion.ListPromotionsRequest\x1a!.promotion.ListPromotionsResponse\x12I\n" +
+	"\n" +
+	"PriceItems\x12\x1c.promotion.PriceItemsRequest\x1a\x1d.promotion.PriceItemsResponseBXZVgithub.com/diki-haryadi/ecommerce-saga/internal/features/promotion/delivery/grpc/protob\x06proto3"
+
+var (
+	file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescOnce sync.Once
+	file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescData []byte
+)
+
+func file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescGZIP() []byte {
+	file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescOnce.Do(func() {
+		file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDesc), len(file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDesc)))
+	})
+	return file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDescData
+}
+
+var file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_features_promotion_delivery_grpc_proto_promotion_proto_goTypes = []any{
+	(*Money)(nil),                   // 0: promotion.Money
+	(*Promotion)(nil),               // 1: promotion.Promotion
+	(*CreatePromotionRequest)(nil),  // 2: promotion.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil), // 3: promotion.CreatePromotionResponse
+	(*GetPromotionRequest)(nil),     // 4: promotion.GetPromotionRequest
+	(*GetPromotionResponse)(nil),    // 5: promotion.GetPromotionResponse
+	(*ListPromotionsRequest)(nil),   // 6: promotion.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),  // 7: promotion.ListPromotionsResponse
+	(*LineItem)(nil),                // 8: promotion.LineItem
+	(*DiscountLine)(nil),            // 9: promotion.DiscountLine
+	(*PriceItemsRequest)(nil),       // 10: promotion.PriceItemsRequest
+	(*PriceItemsResponse)(nil),      // 11: promotion.PriceItemsResponse
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+}
+var file_internal_features_promotion_delivery_grpc_proto_promotion_proto_depIdxs = []int32{
+	12, // 0: promotion.Promotion.starts_at:type_name -> google.protobuf.Timestamp
+	12, // 1: promotion.Promotion.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 2: promotion.Promotion.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: promotion.Promotion.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: promotion.Promotion.amount:type_name -> promotion.Money
+	0,  // 5: promotion.Promotion.min_order_value:type_name -> promotion.Money
+	12, // 6: promotion.CreatePromotionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	12, // 7: promotion.CreatePromotionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: promotion.CreatePromotionRequest.amount:type_name -> promotion.Money
+	0,  // 9: promotion.CreatePromotionRequest.min_order_value:type_name -> promotion.Money
+	1,  // 10: promotion.CreatePromotionResponse.promotion:type_name -> promotion.Promotion
+	1,  // 11: promotion.GetPromotionResponse.promotion:type_name -> promotion.Promotion
+	1,  // 12: promotion.ListPromotionsResponse.promotions:type_name -> promotion.Promotion
+	0,  // 13: promotion.LineItem.price:type_name -> promotion.Money
+	0,  // 14: promotion.DiscountLine.amount:type_name -> promotion.Money
+	8,  // 15: promotion.PriceItemsRequest.items:type_name -> promotion.LineItem
+	9,  // 16: promotion.PriceItemsResponse.discounts:type_name -> promotion.DiscountLine
+	0,  // 17: promotion.PriceItemsResponse.subtotal:type_name -> promotion.Money
+	0,  // 18: promotion.PriceItemsResponse.total:type_name -> promotion.Money
+	2,  // 19: promotion.PromotionService.CreatePromotion:input_type -> promotion.CreatePromotionRequest
+	4,  // 20: promotion.PromotionService.GetPromotion:input_type -> promotion.GetPromotionRequest
+	6,  // 21: promotion.PromotionService.ListPromotions:input_type -> promotion.ListPromotionsRequest
+	10, // 22: promotion.PromotionService.PriceItems:input_type -> promotion.PriceItemsRequest
+	3,  // 23: promotion.PromotionService.CreatePromotion:output_type -> promotion.CreatePromotionResponse
+	5,  // 24: promotion.PromotionService.GetPromotion:output_type -> promotion.GetPromotionResponse
+	7,  // 25: promotion.PromotionService.ListPromotions:output_type -> promotion.ListPromotionsResponse
+	11, // 26: promotion.PromotionService.PriceItems:output_type -> promotion.PriceItemsResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_internal_features_promotion_delivery_grpc_proto_promotion_proto_init() }
+func file_internal_features_promotion_delivery_grpc_proto_promotion_proto_init() {
+	if File_internal_features_promotion_delivery_grpc_proto_promotion_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDesc), len(file_internal_features_promotion_delivery_grpc_proto_promotion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_features_promotion_delivery_grpc_proto_promotion_proto_goTypes,
+		DependencyIndexes: file_internal_features_promotion_delivery_grpc_proto_promotion_proto_depIdxs,
+		MessageInfos:      file_internal_features_promotion_delivery_grpc_proto_promotion_proto_msgTypes,
+	}.Build()
+	File_internal_features_promotion_delivery_grpc_proto_promotion_proto = out.File
+	file_internal_features_promotion_delivery_grpc_proto_promotion_proto_goTypes = nil
+	file_internal_features_promotion_delivery_grpc_proto_promotion_proto_depIdxs = nil
+}
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+type PromotionServer struct {
+	pb.UnimplementedPromotionServiceServer
+	promotionUsecase usecase.Usecase
+}
+
+func NewPromotionServer(promotionUsecase usecase.Usecase) *PromotionServer {
+	return &PromotionServer{
+		promotionUsecase: promotionUsecase,
+	}
+}
+
+func (s *PromotionServer) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.CreatePromotionResponse, error) {
+	var productID *uuid.UUID
+	if req.ProductId != "" {
+		id, err := uuid.Parse(req.ProductId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+		}
+		productID = &id
+	}
+
+	promotion, err := s.promotionUsecase.CreatePromotion(ctx, usecase.PromotionInput{
+		Code:          req.Code,
+		Description:   req.Description,
+		Type:          req.Type,
+		Value:         req.Value,
+		Amount:        convertMoney(req.Amount),
+		ProductID:     productID,
+		BuyQuantity:   int(req.BuyQuantity),
+		GetQuantity:   int(req.GetQuantity),
+		MinOrderValue: convertMoney(req.MinOrderValue),
+		UsageLimit:    int(req.UsageLimit),
+		PerUserLimit:  int(req.PerUserLimit),
+		StartsAt:      optionalTime(req.StartsAt),
+		EndsAt:        optionalTime(req.EndsAt),
+	})
+	if err != nil {
+		return nil, promotionError(err, "failed to create promotion")
+	}
+
+	return &pb.CreatePromotionResponse{
+		Success:   true,
+		Message:   "Promotion created successfully",
+		Promotion: convertPromotionToPb(promotion),
+	}, nil
+}
+
+func (s *PromotionServer) GetPromotion(ctx context.Context, req *pb.GetPromotionRequest) (*pb.GetPromotionResponse, error) {
+	promotionID, err := uuid.Parse(req.PromotionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid promotion ID")
+	}
+
+	promotion, err := s.promotionUsecase.GetPromotion(ctx, promotionID)
+	if err != nil {
+		return nil, promotionError(err, "failed to get promotion")
+	}
+
+	return &pb.GetPromotionResponse{
+		Promotion: convertPromotionToPb(promotion),
+	}, nil
+}
+
+func (s *PromotionServer) ListPromotions(ctx context.Context, req *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	promotionsResp, err := s.promotionUsecase.ListPromotions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list promotions")
+	}
+
+	promotions := make([]*pb.Promotion, len(promotionsResp))
+	for i, p := range promotionsResp {
+		promotions[i] = convertPromotionToPb(p)
+	}
+
+	return &pb.ListPromotionsResponse{
+		Promotions: promotions,
+	}, nil
+}
+
+func (s *PromotionServer) PriceItems(ctx context.Context, req *pb.PriceItemsRequest) (*pb.PriceItemsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	items := make([]usecase.LineItem, len(req.Items))
+	for i, item := range req.Items {
+		productID, err := uuid.Parse(item.ProductId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+		}
+		items[i] = usecase.LineItem{
+			ProductID: productID,
+			Price:     convertMoney(item.Price),
+			Quantity:  int(item.Quantity),
+		}
+	}
+
+	breakdown, err := s.promotionUsecase.PriceItems(ctx, userID, req.Code, items)
+	if err != nil {
+		return nil, promotionError(err, "failed to price items")
+	}
+
+	discounts := make([]*pb.DiscountLine, len(breakdown.Discounts))
+	for i, discount := range breakdown.Discounts {
+		discounts[i] = &pb.DiscountLine{
+			PromotionId: discount.PromotionID.String(),
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      convertMoneyToPb(discount.Amount),
+		}
+	}
+
+	return &pb.PriceItemsResponse{
+		Subtotal:  convertMoneyToPb(breakdown.Subtotal),
+		Discounts: discounts,
+		Total:     convertMoneyToPb(breakdown.Total),
+	}, nil
+}
+
+// promotionError maps usecase errors to gRPC status errors
+func promotionError(err error, message string) error {
+	switch err {
+	case usecase.ErrNotFound, usecase.ErrCouponNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case usecase.ErrDuplicateCode:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case usecase.ErrInvalidCode, usecase.ErrInvalidType, usecase.ErrInvalidValue,
+		usecase.ErrInvalidBuyXGetY, usecase.ErrInvalidLimit, usecase.ErrInvalidWindow,
+		usecase.ErrInvalidCurrency:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case usecase.ErrCouponNotActive, usecase.ErrMinOrderValue, usecase.ErrUsageLimitReached,
+		usecase.ErrCouponNotApplicable, usecase.ErrCouponCurrency:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
+
+func optionalTime(value *timestamppb.Timestamp) *time.Time {
+	if value == nil {
+		return nil
+	}
+	t := value.AsTime()
+	return &t
+}
+
+func optionalTimestamp(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+	return timestamppb.New(*value)
+}
+
+func convertMoney(m *pb.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+	return money.New(m.Amount, money.Currency(m.Currency))
+}
+
+func convertMoneyToPb(m money.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
+
+func convertPromotionToPb(promotion *usecase.PromotionResponse) *pb.Promotion {
+	result := &pb.Promotion{
+		Id:            promotion.ID.String(),
+		Code:          promotion.Code,
+		Description:   promotion.Description,
+		Type:          promotion.Type,
+		Value:         promotion.Value,
+		Amount:        convertMoneyToPb(promotion.Amount),
+		BuyQuantity:   int32(promotion.BuyQuantity),
+		GetQuantity:   int32(promotion.GetQuantity),
+		MinOrderValue: convertMoneyToPb(promotion.MinOrderValue),
+		UsageLimit:    int32(promotion.UsageLimit),
+		PerUserLimit:  int32(promotion.PerUserLimit),
+		StartsAt:      optionalTimestamp(promotion.StartsAt),
+		EndsAt:        optionalTimestamp(promotion.EndsAt),
+		CreatedAt:     timestamppb.New(promotion.CreatedAt),
+		UpdatedAt:     timestamppb.New(promotion.UpdatedAt),
+	}
+	if promotion.ProductID != nil {
+		result.ProductId = promotion.ProductID.String()
+	}
+	return result
+}
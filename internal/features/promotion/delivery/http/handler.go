@@ -0,0 +1,100 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/dto/request"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
+	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+)
+
+type PromotionHandler struct {
+	promotionUsecase usecase.Usecase
+	errorHandler     errors.ErrorHandler
+}
+
+func NewPromotionHandler(promotionUsecase usecase.Usecase) *PromotionHandler {
+	return &PromotionHandler{
+		promotionUsecase: promotionUsecase,
+		errorHandler:     errors.NewErrorHandler(),
+	}
+}
+
+// CreatePromotion handles POST /promotions request
+func (h *PromotionHandler) CreatePromotion(c *fiber.Ctx) error {
+	var req request.CreatePromotionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	var productID *uuid.UUID
+	if req.ProductID != "" {
+		id, err := uuid.Parse(req.ProductID)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid product ID"))
+		}
+		productID = &id
+	}
+
+	resp, err := h.promotionUsecase.CreatePromotion(c.Context(), usecase.PromotionInput{
+		Code:          req.Code,
+		Description:   req.Description,
+		Type:          req.Type,
+		Value:         req.Value,
+		Amount:        req.Amount,
+		ProductID:     productID,
+		BuyQuantity:   req.BuyQuantity,
+		GetQuantity:   req.GetQuantity,
+		MinOrderValue: req.MinOrderValue,
+		UsageLimit:    req.UsageLimit,
+		PerUserLimit:  req.PerUserLimit,
+		StartsAt:      req.StartsAt,
+		EndsAt:        req.EndsAt,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrDuplicateCode:
+			return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
+		case usecase.ErrInvalidCode, usecase.ErrInvalidType, usecase.ErrInvalidValue,
+			usecase.ErrInvalidBuyXGetY, usecase.ErrInvalidLimit, usecase.ErrInvalidWindow,
+			usecase.ErrInvalidCurrency:
+			return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.Created(c, "Promotion created successfully", resp)
+}
+
+// GetPromotion handles GET /promotions/:id request
+func (h *PromotionHandler) GetPromotion(c *fiber.Ctx) error {
+	promotionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid promotion ID"))
+	}
+
+	resp, err := h.promotionUsecase.GetPromotion(c.Context(), promotionID)
+	if err != nil {
+		switch err {
+		case usecase.ErrNotFound:
+			return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+		default:
+			return h.errorHandler.Handle(c, errors.NewInternalError(err))
+		}
+	}
+
+	return httpresponse.OK(c, "Promotion retrieved successfully", resp)
+}
+
+// ListPromotions handles GET /promotions request
+func (h *PromotionHandler) ListPromotions(c *fiber.Ctx) error {
+	resp, err := h.promotionUsecase.ListPromotions(c.Context())
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+
+	return httpresponse.OK(c, "Promotions retrieved successfully", resp)
+}
@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRoutes registers all promotion-related routes. Promotions are
+// created by admins only.
+func RegisterRoutes(router fiber.Router, handler *PromotionHandler, adminMiddleware fiber.Handler) {
+	promotions := router.Group("/promotions")
+
+	promotions.Get("", handler.ListPromotions)
+	promotions.Get("/:id", handler.GetPromotion)
+	promotions.Post("", adminMiddleware, handler.CreatePromotion)
+}
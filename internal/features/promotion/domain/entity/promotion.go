@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// PromotionType represents how a promotion discounts an order
+type PromotionType string
+
+const (
+	// PromotionTypePercentage takes Value percent off the subtotal
+	PromotionTypePercentage PromotionType = "PERCENTAGE"
+	// PromotionTypeFixed takes Amount off the subtotal
+	PromotionTypeFixed PromotionType = "FIXED"
+	// PromotionTypeBuyXGetY gives GetQuantity units of the product free for
+	// every BuyQuantity units bought
+	PromotionTypeBuyXGetY PromotionType = "BUY_X_GET_Y"
+)
+
+// Promotion is a coupon redeemed by its code. Zero limits mean unlimited and
+// a missing StartsAt or EndsAt leaves the validity window open on that side.
+type Promotion struct {
+	ID            uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Code          string        `json:"code" gorm:"type:varchar(50);not null;uniqueIndex"`
+	Description   string        `json:"description"`
+	Type          PromotionType `json:"type" gorm:"type:varchar(20);not null"`
+	Value         float64       `json:"value" gorm:"not null;default:0"`
+	Amount        money.Money   `json:"amount" gorm:"embedded;embeddedPrefix:amount_"`
+	ProductID     *uuid.UUID    `json:"product_id" gorm:"type:uuid"`
+	BuyQuantity   int           `json:"buy_quantity" gorm:"not null;default:0"`
+	GetQuantity   int           `json:"get_quantity" gorm:"not null;default:0"`
+	MinOrderValue money.Money   `json:"min_order_value" gorm:"embedded;embeddedPrefix:min_order_value_"`
+	UsageLimit    int           `json:"usage_limit" gorm:"not null;default:0"`
+	PerUserLimit  int           `json:"per_user_limit" gorm:"not null;default:0"`
+	StartsAt      *time.Time    `json:"starts_at"`
+	EndsAt        *time.Time    `json:"ends_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// Line is a product quantity priced by a promotion
+type Line struct {
+	ProductID uuid.UUID
+	Price     money.Money
+	Quantity  int
+}
+
+// NewPromotion creates a new promotion without limits or validity window
+func NewPromotion(code, description string, promotionType PromotionType, value float64, amount money.Money) *Promotion {
+	return &Promotion{
+		ID:          uuid.New(),
+		Code:        NormalizeCode(code),
+		Description: description,
+		Type:        promotionType,
+		Value:       value,
+		Amount:      amount,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// NormalizeCode returns the canonical form of a coupon code
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValidAt checks if the promotion can be redeemed at the given time
+func (p *Promotion) IsValidAt(now time.Time) bool {
+	if p.StartsAt != nil && now.Before(*p.StartsAt) {
+		return false
+	}
+	return p.EndsAt == nil || now.Before(*p.EndsAt)
+}
+
+// Discount returns the amount the promotion takes off the lines, never more
+// than their subtotal. Percentages are rounded half up to minor units.
+func (p *Promotion) Discount(lines []Line) (money.Money, error) {
+	subtotal, err := Subtotal(lines)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	discount := money.Zero(subtotal.Currency)
+	switch p.Type {
+	case PromotionTypePercentage:
+		discount = subtotal.Percent(p.Value, money.RoundHalfUp)
+	case PromotionTypeFixed:
+		discount = p.Amount
+	case PromotionTypeBuyXGetY:
+		for _, line := range lines {
+			if p.ProductID == nil || line.ProductID != *p.ProductID {
+				continue
+			}
+			free := line.Quantity / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+			if discount, err = discount.Add(line.Price.Mul(int64(free))); err != nil {
+				return money.Money{}, err
+			}
+		}
+	}
+
+	cmp, err := discount.Cmp(subtotal)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if cmp > 0 {
+		return subtotal, nil
+	}
+	return discount, nil
+}
+
+// Subtotal sums price times quantity of the lines
+func Subtotal(lines []Line) (money.Money, error) {
+	var subtotal money.Money
+	for _, line := range lines {
+		var err error
+		if subtotal, err = subtotal.Add(line.Price.Mul(int64(line.Quantity))); err != nil {
+			return money.Money{}, err
+		}
+	}
+	return subtotal, nil
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+func discount(t *testing.T, promotion *Promotion, lines []Line) money.Money {
+	t.Helper()
+	amount, err := promotion.Discount(lines)
+	require.NoError(t, err)
+	return amount
+}
+
+func TestPromotion_Discount(t *testing.T) {
+	mug, tee := uuid.New(), uuid.New()
+	lines := []Line{
+		{ProductID: mug, Price: money.New(999, money.USD), Quantity: 5},
+		{ProductID: tee, Price: money.New(2000, money.USD), Quantity: 1},
+	}
+
+	// 10% of 69.95 rounds half up to 7.00
+	percentage := NewPromotion("save10", "", PromotionTypePercentage, 10, money.Money{})
+	assert.Equal(t, "SAVE10", percentage.Code)
+	assert.Equal(t, money.New(700, money.USD), discount(t, percentage, lines))
+
+	// A fixed discount never exceeds the subtotal
+	fixed := NewPromotion("FIVE", "", PromotionTypeFixed, 0, money.New(500, money.USD))
+	assert.Equal(t, money.New(500, money.USD), discount(t, fixed, lines))
+	fixed.Amount = money.New(10000, money.USD)
+	assert.Equal(t, money.New(6995, money.USD), discount(t, fixed, lines))
+
+	// A fixed discount in another currency is refused
+	fixed.Amount = money.New(500, money.EUR)
+	_, err := fixed.Discount(lines)
+	assert.ErrorIs(t, err, money.ErrCurrencyMismatch)
+
+	// Buy 2 get 1: five mugs make one full group of three
+	bxgy := NewPromotion("MUGS", "", PromotionTypeBuyXGetY, 0, money.Money{})
+	bxgy.ProductID = &mug
+	bxgy.BuyQuantity, bxgy.GetQuantity = 2, 1
+	assert.Equal(t, money.New(999, money.USD), discount(t, bxgy, lines))
+	assert.Equal(t, money.Zero(money.USD), discount(t, bxgy, lines[1:]))
+}
+
+func TestPromotion_IsValidAt(t *testing.T) {
+	now := time.Now()
+	start, end := now.Add(-time.Hour), now.Add(time.Hour)
+
+	promotion := NewPromotion("WINDOW", "", PromotionTypeFixed, 0, money.New(500, money.USD))
+	assert.True(t, promotion.IsValidAt(now))
+
+	promotion.StartsAt, promotion.EndsAt = &start, &end
+	assert.True(t, promotion.IsValidAt(now))
+	assert.False(t, promotion.IsValidAt(start.Add(-time.Second)))
+	assert.False(t, promotion.IsValidAt(end))
+}
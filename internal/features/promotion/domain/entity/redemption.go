@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// RedemptionStatus represents the status of a coupon redemption
+type RedemptionStatus string
+
+const (
+	// RedemptionStatusReserved counts a use of the coupon by an order whose saga is running
+	RedemptionStatusReserved RedemptionStatus = "RESERVED"
+	// RedemptionStatusRedeemed marks the coupon used by a completed order
+	RedemptionStatusRedeemed RedemptionStatus = "REDEEMED"
+	// RedemptionStatusReleased marks a use given back after compensation
+	RedemptionStatusReleased RedemptionStatus = "RELEASED"
+)
+
+// Redemption is a use of a promotion by an order. Reserved and redeemed
+// redemptions count against the usage limits of the promotion.
+type Redemption struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	PromotionID uuid.UUID        `json:"promotion_id" gorm:"type:uuid;not null"`
+	UserID      uuid.UUID        `json:"user_id" gorm:"type:uuid;not null"`
+	OrderID     uuid.UUID        `json:"order_id" gorm:"type:uuid;not null"`
+	Amount      money.Money      `json:"amount" gorm:"embedded"`
+	Status      RedemptionStatus `json:"status" gorm:"type:varchar(20);not null"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// TableName returns the redemption table name
+func (Redemption) TableName() string {
+	return "promotion_redemptions"
+}
+
+// NewRedemption creates a new reserved redemption of a promotion by an order
+func NewRedemption(promotionID, userID, orderID uuid.UUID, amount money.Money) *Redemption {
+	return &Redemption{
+		ID:          uuid.New(),
+		PromotionID: promotionID,
+		UserID:      userID,
+		OrderID:     orderID,
+		Amount:      amount,
+		Status:      RedemptionStatusReserved,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/entity"
+)
+
+var (
+	ErrPromotionNotFound  = errors.New("promotion not found")
+	ErrUsageLimitReached  = errors.New("promotion usage limit reached")
+	ErrRedemptionReleased = errors.New("redemption already released")
+)
+
+// PromotionRepository defines the interface for promotion and redemption persistence
+type PromotionRepository interface {
+	// Create saves a new promotion
+	Create(ctx context.Context, promotion *entity.Promotion) error
+
+	// GetByID retrieves a promotion by its ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Promotion, error)
+
+	// GetByCode retrieves a promotion by its normalized code
+	GetByCode(ctx context.Context, code string) (*entity.Promotion, error)
+
+	// List retrieves all promotions, newest first
+	List(ctx context.Context) ([]*entity.Promotion, error)
+
+	// CountRedemptions counts the reserved and redeemed uses of a promotion,
+	// only those of the user if userID is set
+	CountRedemptions(ctx context.Context, promotionID uuid.UUID, userID *uuid.UUID) (int64, error)
+
+	// Reserve records the redemptions of an order, all or nothing, failing with
+	// ErrUsageLimitReached if one would take its promotion past a usage limit.
+	// Reserving an order that is already reserved changes nothing.
+	Reserve(ctx context.Context, orderID uuid.UUID, redemptions []*entity.Redemption) error
+
+	// Release gives the order's reserved redemptions back
+	Release(ctx context.Context, orderID uuid.UUID) error
+
+	// Commit marks the order's reserved redemptions as redeemed
+	Commit(ctx context.Context, orderID uuid.UUID) error
+
+	// GetRedemptions retrieves the redemptions of an order
+	GetRedemptions(ctx context.Context, orderID uuid.UUID) ([]*entity.Redemption, error)
+}
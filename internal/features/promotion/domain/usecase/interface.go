@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// Usecase defines the promotion business logic interface
+type Usecase interface {
+	CreatePromotion(ctx context.Context, input PromotionInput) (*PromotionResponse, error)
+	GetPromotion(ctx context.Context, id uuid.UUID) (*PromotionResponse, error)
+	ListPromotions(ctx context.Context) ([]*PromotionResponse, error)
+	PriceItems(ctx context.Context, userID uuid.UUID, code string, items []LineItem) (*Breakdown, error)
+	ReserveRedemptions(ctx context.Context, orderID, userID uuid.UUID, discounts []DiscountLine) error
+	ReleaseRedemptions(ctx context.Context, orderID uuid.UUID) error
+	CommitRedemptions(ctx context.Context, orderID uuid.UUID) error
+}
+
+// PromotionInput holds the fields of a new promotion. PERCENTAGE promotions
+// take Value percent off and FIXED ones take Amount off; BUY_X_GET_Y
+// promotions use ProductID, BuyQuantity and GetQuantity instead.
+type PromotionInput struct {
+	Code          string
+	Description   string
+	Type          string
+	Value         float64
+	Amount        money.Money
+	ProductID     *uuid.UUID
+	BuyQuantity   int
+	GetQuantity   int
+	MinOrderValue money.Money
+	UsageLimit    int
+	PerUserLimit  int
+	StartsAt      *time.Time
+	EndsAt        *time.Time
+}
+
+// LineItem is a product quantity to price
+type LineItem struct {
+	ProductID uuid.UUID
+	Price     money.Money
+	Quantity  int
+}
+
+// DiscountLine is the amount a promotion takes off an order
+type DiscountLine struct {
+	PromotionID uuid.UUID   `json:"promotion_id"`
+	Code        string      `json:"code"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+}
+
+// Breakdown is the price of a cart or order: the subtotal of its items, the
+// discounts taken off it and the resulting total
+type Breakdown struct {
+	Subtotal  money.Money    `json:"subtotal"`
+	Discounts []DiscountLine `json:"discounts"`
+	Total     money.Money    `json:"total"`
+}
+
+type PromotionResponse struct {
+	ID            uuid.UUID   `json:"id"`
+	Code          string      `json:"code"`
+	Description   string      `json:"description"`
+	Type          string      `json:"type"`
+	Value         float64     `json:"value,omitempty"`
+	Amount        money.Money `json:"amount"`
+	ProductID     *uuid.UUID  `json:"product_id,omitempty"`
+	BuyQuantity   int         `json:"buy_quantity,omitempty"`
+	GetQuantity   int         `json:"get_quantity,omitempty"`
+	MinOrderValue money.Money `json:"min_order_value"`
+	UsageLimit    int         `json:"usage_limit"`
+	PerUserLimit  int         `json:"per_user_limit"`
+	StartsAt      *time.Time  `json:"starts_at,omitempty"`
+	EndsAt        *time.Time  `json:"ends_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// Common errors
+var (
+	ErrNotFound            = NewError("promotion not found")
+	ErrDuplicateCode       = NewError("promotion code already exists")
+	ErrInvalidCode         = NewError("promotion code is required")
+	ErrInvalidType         = NewError("invalid promotion type")
+	ErrInvalidValue        = NewError("invalid promotion value")
+	ErrInvalidCurrency     = NewError("invalid currency")
+	ErrInvalidBuyXGetY     = NewError("buy-x-get-y promotions need a product and positive quantities")
+	ErrInvalidLimit        = NewError("limits must not be negative")
+	ErrInvalidWindow       = NewError("promotion must start before it ends")
+	ErrCouponNotFound      = NewError("coupon not found")
+	ErrCouponNotActive     = NewError("coupon is not valid at this time")
+	ErrMinOrderValue       = NewError("order value is below the coupon minimum")
+	ErrUsageLimitReached   = NewError("coupon usage limit reached")
+	ErrCouponNotApplicable = NewError("coupon does not apply to the items")
+	ErrCouponCurrency      = NewError("coupon is in another currency than the items")
+	ErrRedemptionReleased  = NewError("redemption already released")
+)
+
+// Error represents a promotion error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new promotion error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
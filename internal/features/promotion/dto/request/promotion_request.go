@@ -0,0 +1,24 @@
+package request
+
+import (
+	"time"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// CreatePromotionRequest represents the request to create a promotion
+type CreatePromotionRequest struct {
+	Code          string      `json:"code" validate:"required"`
+	Description   string      `json:"description"`
+	Type          string      `json:"type" validate:"required,oneof=PERCENTAGE FIXED BUY_X_GET_Y"`
+	Value         float64     `json:"value" validate:"min=0"`
+	Amount        money.Money `json:"amount"`
+	ProductID     string      `json:"product_id" validate:"omitempty,uuid"`
+	BuyQuantity   int         `json:"buy_quantity" validate:"min=0"`
+	GetQuantity   int         `json:"get_quantity" validate:"min=0"`
+	MinOrderValue money.Money `json:"min_order_value"`
+	UsageLimit    int         `json:"usage_limit" validate:"min=0"`
+	PerUserLimit  int         `json:"per_user_limit" validate:"min=0"`
+	StartsAt      *time.Time  `json:"starts_at"`
+	EndsAt        *time.Time  `json:"ends_at"`
+}
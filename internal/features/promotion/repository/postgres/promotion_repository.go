@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
+)
+
+// liveStatuses are the redemption statuses counted against usage limits
+var liveStatuses = []entity.RedemptionStatus{entity.RedemptionStatusReserved, entity.RedemptionStatusRedeemed}
+
+// PromotionRepository implements the repository.PromotionRepository interface.
+// Redemption writes join the inbox transaction carried by ctx, if any, so that
+// a step handled by the worker reserves a coupon exactly once.
+type PromotionRepository struct {
+	db *gorm.DB
+}
+
+// NewPromotionRepository creates a new PostgreSQL promotion repository
+func NewPromotionRepository(db *gorm.DB) repository.PromotionRepository {
+	return &PromotionRepository{
+		db: db,
+	}
+}
+
+// Create saves a new promotion
+func (r *PromotionRepository) Create(ctx context.Context, promotion *entity.Promotion) error {
+	return r.db.WithContext(ctx).Create(promotion).Error
+}
+
+// GetByID retrieves a promotion by its ID
+func (r *PromotionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Promotion, error) {
+	return first(r.db.WithContext(ctx), "id = ?", id)
+}
+
+// GetByCode retrieves a promotion by its normalized code
+func (r *PromotionRepository) GetByCode(ctx context.Context, code string) (*entity.Promotion, error) {
+	return first(r.db.WithContext(ctx), "code = ?", code)
+}
+
+// List retrieves all promotions, newest first
+func (r *PromotionRepository) List(ctx context.Context) ([]*entity.Promotion, error) {
+	var promotions []*entity.Promotion
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&promotions).Error; err != nil {
+		return nil, err
+	}
+	return promotions, nil
+}
+
+// CountRedemptions counts the reserved and redeemed uses of a promotion
+func (r *PromotionRepository) CountRedemptions(ctx context.Context, promotionID uuid.UUID, userID *uuid.UUID) (int64, error) {
+	return countRedemptions(r.db.WithContext(ctx), promotionID, userID)
+}
+
+// Reserve records the redemptions of an order within the usage limits of their promotions
+func (r *PromotionRepository) Reserve(ctx context.Context, orderID uuid.UUID, redemptions []*entity.Redemption) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Serialize reservations of the same order so that a redelivered
+		// command never reserves twice
+		if err := lockOrder(tx, orderID); err != nil {
+			return err
+		}
+
+		existing, err := findRedemptions(tx, orderID)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			if existing[0].Status == entity.RedemptionStatusReleased {
+				return repository.ErrRedemptionReleased
+			}
+			return nil
+		}
+
+		// Promotions are locked in ID order, so concurrent orders never deadlock
+		sort.Slice(redemptions, func(i, j int) bool {
+			return redemptions[i].PromotionID.String() < redemptions[j].PromotionID.String()
+		})
+		for _, redemption := range redemptions {
+			promotion, err := first(tx.Clauses(clause.Locking{Strength: "UPDATE"}), "id = ?", redemption.PromotionID)
+			if err != nil {
+				return err
+			}
+			if err := checkLimits(tx, promotion, redemption.UserID); err != nil {
+				return err
+			}
+			if err := tx.Create(redemption).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Release gives the order's reserved redemptions back
+func (r *PromotionRepository) Release(ctx context.Context, orderID uuid.UUID) error {
+	return r.setStatus(ctx, orderID, entity.RedemptionStatusReleased)
+}
+
+// Commit marks the order's reserved redemptions as redeemed
+func (r *PromotionRepository) Commit(ctx context.Context, orderID uuid.UUID) error {
+	return r.setStatus(ctx, orderID, entity.RedemptionStatusRedeemed)
+}
+
+// GetRedemptions retrieves the redemptions of an order
+func (r *PromotionRepository) GetRedemptions(ctx context.Context, orderID uuid.UUID) ([]*entity.Redemption, error) {
+	return findRedemptions(r.db.WithContext(ctx), orderID)
+}
+
+// setStatus moves the order's RESERVED redemptions to status
+func (r *PromotionRepository) setStatus(ctx context.Context, orderID uuid.UUID, status entity.RedemptionStatus) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOrder(tx, orderID); err != nil {
+			return err
+		}
+		return tx.Model(&entity.Redemption{}).
+			Where("order_id = ? AND status = ?", orderID, entity.RedemptionStatusReserved).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"updated_at": time.Now(),
+			}).Error
+	})
+}
+
+// checkLimits fails if one more use by the user takes the promotion past a usage limit
+func checkLimits(tx *gorm.DB, promotion *entity.Promotion, userID uuid.UUID) error {
+	if promotion.UsageLimit > 0 {
+		count, err := countRedemptions(tx, promotion.ID, nil)
+		if err != nil {
+			return err
+		}
+		if count >= int64(promotion.UsageLimit) {
+			return repository.ErrUsageLimitReached
+		}
+	}
+	if promotion.PerUserLimit > 0 {
+		count, err := countRedemptions(tx, promotion.ID, &userID)
+		if err != nil {
+			return err
+		}
+		if count >= int64(promotion.PerUserLimit) {
+			return repository.ErrUsageLimitReached
+		}
+	}
+	return nil
+}
+
+// lockOrder takes a transaction-scoped advisory lock on the order's redemptions
+func lockOrder(tx *gorm.DB, orderID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "redemptions:"+orderID.String()).Error
+}
+
+func countRedemptions(tx *gorm.DB, promotionID uuid.UUID, userID *uuid.UUID) (int64, error) {
+	query := tx.Model(&entity.Redemption{}).
+		Where("promotion_id = ? AND status IN ?", promotionID, liveStatuses)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func findRedemptions(tx *gorm.DB, orderID uuid.UUID) ([]*entity.Redemption, error) {
+	var redemptions []*entity.Redemption
+	if err := tx.Where("order_id = ?", orderID).Order("promotion_id").Find(&redemptions).Error; err != nil {
+		return nil, err
+	}
+	return redemptions, nil
+}
+
+func first(tx *gorm.DB, query string, args ...interface{}) (*entity.Promotion, error) {
+	var promotion entity.Promotion
+	err := tx.Where(query, args...).First(&promotion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrPromotionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/fx"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+type PromotionUsecase struct {
+	promotionRepo repository.PromotionRepository
+	minOrderValue money.Money
+	rates         fx.Provider
+}
+
+// NewPromotionUsecase creates a new promotion usecase. No coupon applies to an
+// order below minOrderValue, whatever the minimum of its promotion. Minimums
+// and fixed amounts are converted to the currency of the items at the rates
+// of rates.
+func NewPromotionUsecase(promotionRepo repository.PromotionRepository, minOrderValue money.Money, rates fx.Provider) *PromotionUsecase {
+	return &PromotionUsecase{
+		promotionRepo: promotionRepo,
+		minOrderValue: minOrderValue,
+		rates:         rates,
+	}
+}
+
+// CreatePromotion adds a new promotion
+func (u *PromotionUsecase) CreatePromotion(ctx context.Context, input usecase.PromotionInput) (*usecase.PromotionResponse, error) {
+	promotion := entity.NewPromotion(input.Code, input.Description, entity.PromotionType(input.Type), input.Value, input.Amount)
+	promotion.ProductID = input.ProductID
+	promotion.BuyQuantity = input.BuyQuantity
+	promotion.GetQuantity = input.GetQuantity
+	promotion.MinOrderValue = input.MinOrderValue
+	promotion.UsageLimit = input.UsageLimit
+	promotion.PerUserLimit = input.PerUserLimit
+	promotion.StartsAt = input.StartsAt
+	promotion.EndsAt = input.EndsAt
+
+	if err := validatePromotion(promotion); err != nil {
+		return nil, err
+	}
+
+	_, err := u.promotionRepo.GetByCode(ctx, promotion.Code)
+	if err == nil {
+		return nil, usecase.ErrDuplicateCode
+	}
+	if !errors.Is(err, repository.ErrPromotionNotFound) {
+		return nil, err
+	}
+
+	if err := u.promotionRepo.Create(ctx, promotion); err != nil {
+		return nil, err
+	}
+
+	return convertPromotion(promotion), nil
+}
+
+// GetPromotion retrieves a promotion by ID
+func (u *PromotionUsecase) GetPromotion(ctx context.Context, id uuid.UUID) (*usecase.PromotionResponse, error) {
+	promotion, err := u.promotionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return convertPromotion(promotion), nil
+}
+
+// ListPromotions retrieves all promotions
+func (u *PromotionUsecase) ListPromotions(ctx context.Context) ([]*usecase.PromotionResponse, error) {
+	promotions, err := u.promotionRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*usecase.PromotionResponse, len(promotions))
+	for i, promotion := range promotions {
+		result[i] = convertPromotion(promotion)
+	}
+	return result, nil
+}
+
+// PriceItems returns the breakdown of the items with the coupon applied for
+// the user. An empty code prices the items without discount.
+func (u *PromotionUsecase) PriceItems(ctx context.Context, userID uuid.UUID, code string, items []usecase.LineItem) (*usecase.Breakdown, error) {
+	lines := make([]entity.Line, len(items))
+	for i, item := range items {
+		lines[i] = entity.Line{
+			ProductID: item.ProductID,
+			Price:     item.Price,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	subtotal, err := entity.Subtotal(lines)
+	if err != nil {
+		return nil, err
+	}
+	breakdown := &usecase.Breakdown{
+		Subtotal:  subtotal,
+		Discounts: []usecase.DiscountLine{},
+	}
+	breakdown.Total = breakdown.Subtotal
+
+	code = entity.NormalizeCode(code)
+	if code == "" {
+		return breakdown, nil
+	}
+
+	promotion, err := u.promotionRepo.GetByCode(ctx, code)
+	if errors.Is(err, repository.ErrPromotionNotFound) {
+		return nil, usecase.ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !promotion.IsValidAt(time.Now()) {
+		return nil, usecase.ErrCouponNotActive
+	}
+	for _, minimum := range []money.Money{u.minOrderValue, promotion.MinOrderValue} {
+		if minimum, err = u.convert(ctx, minimum, subtotal.Currency); err != nil {
+			return nil, err
+		}
+		if err := checkMinimum(subtotal, minimum); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.checkLimits(ctx, promotion, userID); err != nil {
+		return nil, err
+	}
+	if promotion.Amount, err = u.convert(ctx, promotion.Amount, subtotal.Currency); err != nil {
+		return nil, err
+	}
+
+	amount, err := promotion.Discount(lines)
+	if errors.Is(err, money.ErrCurrencyMismatch) {
+		return nil, usecase.ErrCouponCurrency
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !amount.IsPositive() {
+		return nil, usecase.ErrCouponNotApplicable
+	}
+
+	breakdown.Discounts = append(breakdown.Discounts, usecase.DiscountLine{
+		PromotionID: promotion.ID,
+		Code:        promotion.Code,
+		Description: promotion.Description,
+		Amount:      amount,
+	})
+	if breakdown.Total, err = subtotal.Sub(amount); err != nil {
+		return nil, err
+	}
+	return breakdown, nil
+}
+
+// ReserveRedemptions counts the discounts of an order against the usage limits of their promotions
+func (u *PromotionUsecase) ReserveRedemptions(ctx context.Context, orderID, userID uuid.UUID, discounts []usecase.DiscountLine) error {
+	if len(discounts) == 0 {
+		return nil
+	}
+
+	redemptions := make([]*entity.Redemption, len(discounts))
+	for i, discount := range discounts {
+		redemptions[i] = entity.NewRedemption(discount.PromotionID, userID, orderID, discount.Amount)
+	}
+
+	return convertError(u.promotionRepo.Reserve(ctx, orderID, redemptions))
+}
+
+// ReleaseRedemptions gives the coupon uses of an order back
+func (u *PromotionUsecase) ReleaseRedemptions(ctx context.Context, orderID uuid.UUID) error {
+	return u.promotionRepo.Release(ctx, orderID)
+}
+
+// CommitRedemptions marks the coupon uses of an order as redeemed
+func (u *PromotionUsecase) CommitRedemptions(ctx context.Context, orderID uuid.UUID) error {
+	return u.promotionRepo.Commit(ctx, orderID)
+}
+
+// convert converts an amount of a promotion to the currency of the items. An
+// amount there is no rate for cannot be compared with the items.
+func (u *PromotionUsecase) convert(ctx context.Context, amount money.Money, currency money.Currency) (money.Money, error) {
+	if !amount.IsPositive() || currency == "" || amount.Currency == currency {
+		return amount, nil
+	}
+	converted, err := fx.Convert(ctx, u.rates, amount, currency, money.RoundHalfUp)
+	if errors.Is(err, fx.ErrRateNotFound) {
+		return money.Money{}, usecase.ErrCouponCurrency
+	}
+	return converted, err
+}
+
+// checkMinimum tells whether the subtotal reaches a minimum order value; a zero
+// minimum is met by any subtotal
+func checkMinimum(subtotal, minimum money.Money) error {
+	if !minimum.IsPositive() {
+		return nil
+	}
+	cmp, err := subtotal.Cmp(minimum)
+	if errors.Is(err, money.ErrCurrencyMismatch) {
+		return usecase.ErrCouponCurrency
+	}
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		return usecase.ErrMinOrderValue
+	}
+	return nil
+}
+
+// checkLimits tells early whether the user can still use the promotion. The
+// limits are enforced again when the order's saga reserves the redemption.
+func (u *PromotionUsecase) checkLimits(ctx context.Context, promotion *entity.Promotion, userID uuid.UUID) error {
+	if promotion.UsageLimit > 0 {
+		count, err := u.promotionRepo.CountRedemptions(ctx, promotion.ID, nil)
+		if err != nil {
+			return err
+		}
+		if count >= int64(promotion.UsageLimit) {
+			return usecase.ErrUsageLimitReached
+		}
+	}
+	if promotion.PerUserLimit > 0 {
+		count, err := u.promotionRepo.CountRedemptions(ctx, promotion.ID, &userID)
+		if err != nil {
+			return err
+		}
+		if count >= int64(promotion.PerUserLimit) {
+			return usecase.ErrUsageLimitReached
+		}
+	}
+	return nil
+}
+
+func validatePromotion(promotion *entity.Promotion) error {
+	if promotion.Code == "" {
+		return usecase.ErrInvalidCode
+	}
+
+	switch promotion.Type {
+	case entity.PromotionTypePercentage:
+		if promotion.Value <= 0 || promotion.Value > 100 {
+			return usecase.ErrInvalidValue
+		}
+	case entity.PromotionTypeFixed:
+		if !promotion.Amount.Currency.Valid() {
+			return usecase.ErrInvalidCurrency
+		}
+		if !promotion.Amount.IsPositive() {
+			return usecase.ErrInvalidValue
+		}
+	case entity.PromotionTypeBuyXGetY:
+		if promotion.ProductID == nil || promotion.BuyQuantity <= 0 || promotion.GetQuantity <= 0 {
+			return usecase.ErrInvalidBuyXGetY
+		}
+	default:
+		return usecase.ErrInvalidType
+	}
+
+	if promotion.MinOrderValue.IsNegative() || promotion.UsageLimit < 0 || promotion.PerUserLimit < 0 {
+		return usecase.ErrInvalidLimit
+	}
+	if promotion.MinOrderValue.IsPositive() && !promotion.MinOrderValue.Currency.Valid() {
+		return usecase.ErrInvalidCurrency
+	}
+	if promotion.StartsAt != nil && promotion.EndsAt != nil && !promotion.StartsAt.Before(*promotion.EndsAt) {
+		return usecase.ErrInvalidWindow
+	}
+	return nil
+}
+
+// convertError maps repository errors to usecase errors
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPromotionNotFound):
+		return usecase.ErrNotFound
+	case errors.Is(err, repository.ErrUsageLimitReached):
+		return usecase.ErrUsageLimitReached
+	case errors.Is(err, repository.ErrRedemptionReleased):
+		return usecase.ErrRedemptionReleased
+	default:
+		return err
+	}
+}
+
+func convertPromotion(promotion *entity.Promotion) *usecase.PromotionResponse {
+	return &usecase.PromotionResponse{
+		ID:            promotion.ID,
+		Code:          promotion.Code,
+		Description:   promotion.Description,
+		Type:          string(promotion.Type),
+		Value:         promotion.Value,
+		Amount:        promotion.Amount,
+		ProductID:     promotion.ProductID,
+		BuyQuantity:   promotion.BuyQuantity,
+		GetQuantity:   promotion.GetQuantity,
+		MinOrderValue: promotion.MinOrderValue,
+		UsageLimit:    promotion.UsageLimit,
+		PerUserLimit:  promotion.PerUserLimit,
+		StartsAt:      promotion.StartsAt,
+		EndsAt:        promotion.EndsAt,
+		CreatedAt:     promotion.CreatedAt,
+		UpdatedAt:     promotion.UpdatedAt,
+	}
+}
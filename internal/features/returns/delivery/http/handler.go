@@ -0,0 +1,157 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/dto/request"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
+	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+)
+
+type ReturnHandler struct {
+	returnUsecase usecase.Usecase
+	errorHandler  errors.ErrorHandler
+}
+
+func NewReturnHandler(returnUsecase usecase.Usecase) *ReturnHandler {
+	return &ReturnHandler{
+		returnUsecase: returnUsecase,
+		errorHandler:  errors.NewErrorHandler(),
+	}
+}
+
+// RequestReturn handles POST /returns request
+func (h *ReturnHandler) RequestReturn(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	var req request.CreateReturnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid request format"))
+	}
+
+	orderID, err := uuid.Parse(req.OrderID)
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order ID"))
+	}
+
+	items := make([]usecase.ReturnItem, len(req.Items))
+	for i, item := range req.Items {
+		orderItemID, err := uuid.Parse(item.OrderItemID)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order item ID"))
+		}
+		items[i] = usecase.ReturnItem{
+			OrderItemID: orderItemID,
+			Quantity:    item.Quantity,
+		}
+	}
+
+	resp, err := h.returnUsecase.RequestReturn(c.Context(), userID, orderID, usecase.ReturnInput{
+		Reason: req.Reason,
+		Items:  items,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.Created(c, "Return requested successfully", resp)
+}
+
+// GetReturn handles GET /returns/:id request
+func (h *ReturnHandler) GetReturn(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	returnID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid return ID"))
+	}
+
+	resp, err := h.returnUsecase.GetReturn(c.Context(), userID, returnID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Return retrieved successfully", resp)
+}
+
+// ListReturns handles GET /returns request
+func (h *ReturnHandler) ListReturns(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid user ID"))
+	}
+
+	resp, err := h.returnUsecase.ListReturns(c.Context(), userID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Returns retrieved successfully", resp)
+}
+
+// ApproveReturn handles POST /returns/:id/approve request
+func (h *ReturnHandler) ApproveReturn(c *fiber.Ctx) error {
+	returnID, req, err := h.parseReview(c)
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
+	}
+
+	resp, err := h.returnUsecase.ApproveReturn(c.Context(), returnID, req.Note)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Return approved successfully", resp)
+}
+
+// RejectReturn handles POST /returns/:id/reject request
+func (h *ReturnHandler) RejectReturn(c *fiber.Ctx) error {
+	returnID, req, err := h.parseReview(c)
+	if err != nil {
+		return h.errorHandler.Handle(c, err)
+	}
+
+	resp, err := h.returnUsecase.RejectReturn(c.Context(), returnID, req.Note)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return httpresponse.OK(c, "Return rejected successfully", resp)
+}
+
+func (h *ReturnHandler) parseReview(c *fiber.Ctx) (uuid.UUID, request.ReviewReturnRequest, error) {
+	var req request.ReviewReturnRequest
+	returnID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return uuid.Nil, req, errors.NewValidationError("Invalid return ID")
+	}
+
+	// The note is optional, so an empty body is fine
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return uuid.Nil, req, errors.NewValidationError("Invalid request format")
+		}
+	}
+	return returnID, req, nil
+}
+
+func (h *ReturnHandler) handleError(c *fiber.Ctx, err error) error {
+	switch err {
+	case usecase.ErrNotFound, usecase.ErrOrderNotFound:
+		return h.errorHandler.Handle(c, errors.NewNotFoundError(err.Error()))
+	case usecase.ErrOrderNotReturnable, usecase.ErrNotReviewable:
+		return h.errorHandler.Handle(c, errors.NewConflictError(err.Error()))
+	case usecase.ErrInvalidReason, usecase.ErrNoItems, usecase.ErrInvalidItem, usecase.ErrInvalidQuantity:
+		return h.errorHandler.Handle(c, errors.NewValidationError(err.Error()))
+	default:
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+}
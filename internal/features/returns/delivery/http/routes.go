@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// RegisterRoutes registers all return routes. Customers request returns,
+// which admins approve or reject.
+func RegisterRoutes(router fiber.Router, handler *ReturnHandler, adminMiddleware fiber.Handler) {
+	returns := router.Group("/returns")
+
+	returns.Get("", handler.ListReturns)
+	returns.Post("", handler.RequestReturn)
+	returns.Get("/:id", handler.GetReturn)
+	returns.Post("/:id/approve", adminMiddleware, handler.ApproveReturn)
+	returns.Post("/:id/reject", adminMiddleware, handler.RejectReturn)
+}
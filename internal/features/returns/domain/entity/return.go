@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// ReturnStatus represents the status of a return
+type ReturnStatus string
+
+const (
+	// ReturnStatusRequested is a return the customer asked for
+	ReturnStatusRequested ReturnStatus = "REQUESTED"
+	// ReturnStatusApproved is a return whose saga restocks and refunds the items
+	ReturnStatusApproved ReturnStatus = "APPROVED"
+	// ReturnStatusRejected is a return an admin turned down
+	ReturnStatusRejected ReturnStatus = "REJECTED"
+	// ReturnStatusCompleted is a return whose items were restocked and refunded
+	ReturnStatusCompleted ReturnStatus = "COMPLETED"
+	// ReturnStatusFailed is a return whose refund failed and was compensated
+	ReturnStatusFailed ReturnStatus = "FAILED"
+)
+
+// ReturnItem is a quantity of an order item sent back and the amount refunded for it
+type ReturnItem struct {
+	ID          uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ReturnID    uuid.UUID   `json:"return_id" gorm:"type:uuid;not null"`
+	OrderItemID uuid.UUID   `json:"order_item_id" gorm:"type:uuid;not null"`
+	ProductID   uuid.UUID   `json:"product_id" gorm:"type:uuid;not null"`
+	Quantity    int         `json:"quantity" gorm:"not null"`
+	Refund      money.Money `json:"refund" gorm:"embedded;embeddedPrefix:refund_"`
+}
+
+// TableName returns the return item table name
+func (ReturnItem) TableName() string {
+	return "order_return_items"
+}
+
+// Return is a request to send items of a delivered order back for a refund.
+// RefundAmount is the sum of the refunds of its items.
+type Return struct {
+	ID            uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID       uuid.UUID    `json:"order_id" gorm:"type:uuid;not null"`
+	UserID        uuid.UUID    `json:"user_id" gorm:"type:uuid;not null"`
+	Reason        string       `json:"reason" gorm:"type:text;not null"`
+	Status        ReturnStatus `json:"status" gorm:"type:varchar(50);not null"`
+	Items         []ReturnItem `json:"items" gorm:"foreignKey:ReturnID"`
+	RefundAmount  money.Money  `json:"refund_amount" gorm:"embedded;embeddedPrefix:refund_"`
+	Note          string       `json:"note" gorm:"type:text"`
+	FailureReason string       `json:"failure_reason" gorm:"type:text"`
+	ReviewedAt    *time.Time   `json:"reviewed_at"`
+	RefundedAt    *time.Time   `json:"refunded_at"`
+	CompletedAt   *time.Time   `json:"completed_at"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
+
+// TableName returns the return table name
+func (Return) TableName() string {
+	return "order_returns"
+}
+
+// NewReturn creates a new requested return of items of an order
+func NewReturn(orderID, userID uuid.UUID, reason string, items []ReturnItem) (*Return, error) {
+	ret := &Return{
+		ID:        uuid.New(),
+		OrderID:   orderID,
+		UserID:    userID,
+		Reason:    reason,
+		Status:    ReturnStatusRequested,
+		Items:     items,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	refunds := make([]money.Money, len(ret.Items))
+	for i := range ret.Items {
+		ret.Items[i].ID = uuid.New()
+		ret.Items[i].ReturnID = ret.ID
+		refunds[i] = ret.Items[i].Refund
+	}
+
+	var err error
+	if ret.RefundAmount, err = money.Sum(refunds...); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// IsOpen checks if the return still holds its items, i.e. it was neither
+// rejected nor failed
+func (r *Return) IsOpen() bool {
+	return r.Status != ReturnStatusRejected && r.Status != ReturnStatusFailed
+}
+
+// Returned sums the quantity of every order item held by the open returns
+func Returned(returns []*Return) map[uuid.UUID]int {
+	returned := make(map[uuid.UUID]int)
+	for _, ret := range returns {
+		if !ret.IsOpen() {
+			continue
+		}
+		for _, item := range ret.Items {
+			returned[item.OrderItemID] += item.Quantity
+		}
+	}
+	return returned
+}
+
+// Refund returns the amount refunded for quantity units of an order item
+// the customer paid paid for ordered units, before units of which are
+// already returned. Each return gets its share rounded down but the last
+// one gets the rest, so that all the returns of the item add up to paid.
+func Refund(paid money.Money, ordered, before, quantity int) money.Money {
+	upTo := paid.MulRat(big.NewRat(int64(before+quantity), int64(ordered)), money.RoundDown)
+	already := paid.MulRat(big.NewRat(int64(before), int64(ordered)), money.RoundDown)
+	return money.New(upTo.Amount-already.Amount, paid.Currency)
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+func TestRefund(t *testing.T) {
+	// 10.01 paid for 3 units refunded one at a time adds up to 10.01
+	paid := money.New(1001, money.USD)
+	assert.Equal(t, money.New(333, money.USD), Refund(paid, 3, 0, 1))
+	assert.Equal(t, money.New(334, money.USD), Refund(paid, 3, 1, 1))
+	assert.Equal(t, money.New(334, money.USD), Refund(paid, 3, 2, 1))
+
+	assert.Equal(t, paid, Refund(paid, 3, 0, 3))
+	assert.Equal(t, money.New(667, money.USD), Refund(paid, 3, 0, 2))
+}
+
+func TestReturned(t *testing.T) {
+	mug, tee := uuid.New(), uuid.New()
+	refund := money.New(100, money.USD)
+
+	open, err := NewReturn(uuid.New(), uuid.New(), "damaged", []ReturnItem{
+		{OrderItemID: mug, Quantity: 1, Refund: refund},
+		{OrderItemID: tee, Quantity: 2, Refund: refund},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, money.New(200, money.USD), open.RefundAmount)
+
+	rejected, err := NewReturn(uuid.New(), uuid.New(), "changed my mind", []ReturnItem{
+		{OrderItemID: mug, Quantity: 1, Refund: refund},
+	})
+	require.NoError(t, err)
+	rejected.Status = ReturnStatusRejected
+
+	assert.Equal(t, map[uuid.UUID]int{mug: 1, tee: 2}, Returned([]*Return{open, rejected}))
+}
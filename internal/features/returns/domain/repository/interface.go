@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/entity"
+)
+
+var (
+	ErrReturnNotFound = errors.New("return not found")
+	ErrOverReturned   = errors.New("returns exceed the ordered quantity")
+	ErrStatusChanged  = errors.New("return status changed")
+)
+
+// ReturnRepository defines the interface for return persistence
+type ReturnRepository interface {
+	// Create saves a new return, failing with ErrOverReturned if the open
+	// returns of its order would then hold more of an item than ordered,
+	// the quantity of every order item
+	Create(ctx context.Context, ret *entity.Return, ordered map[uuid.UUID]int) error
+
+	// GetByID retrieves a return with its items
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Return, error)
+
+	// ListByOrderID retrieves the returns of an order with their items,
+	// oldest first
+	ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entity.Return, error)
+
+	// ListByUserID retrieves the returns of a user with their items, newest
+	// first
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Return, error)
+
+	// UpdateStatus saves the status of the return with its review and
+	// failure details, failing with ErrStatusChanged if the return was no
+	// longer in status from
+	UpdateStatus(ctx context.Context, ret *entity.Return, from entity.ReturnStatus) error
+
+	// MarkRefunded records that the refund of the return was issued. It
+	// reports false if it was already recorded.
+	MarkRefunded(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// Complete moves an approved return to completed and adds its items to
+	// the returned quantities of the order items. Completing a return twice
+	// is a no-op.
+	Complete(ctx context.Context, id uuid.UUID) error
+}
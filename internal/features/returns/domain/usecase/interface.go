@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// Usecase defines the return business logic interface
+type Usecase interface {
+	RequestReturn(ctx context.Context, userID, orderID uuid.UUID, input ReturnInput) (*ReturnResponse, error)
+	GetReturn(ctx context.Context, userID, returnID uuid.UUID) (*ReturnResponse, error)
+	ListReturns(ctx context.Context, userID uuid.UUID) ([]*ReturnResponse, error)
+	ApproveReturn(ctx context.Context, returnID uuid.UUID, note string) (*ReturnResponse, error)
+	RejectReturn(ctx context.Context, returnID uuid.UUID, note string) (*ReturnResponse, error)
+}
+
+// ReturnInput describes the items of a delivered order the customer sends back
+type ReturnInput struct {
+	Reason string
+	Items  []ReturnItem
+}
+
+type ReturnItem struct {
+	OrderItemID uuid.UUID   `json:"order_item_id"`
+	ProductID   uuid.UUID   `json:"product_id"`
+	Quantity    int         `json:"quantity"`
+	Refund      money.Money `json:"refund"`
+}
+
+type ReturnResponse struct {
+	ID            uuid.UUID    `json:"id"`
+	OrderID       uuid.UUID    `json:"order_id"`
+	UserID        uuid.UUID    `json:"user_id"`
+	Reason        string       `json:"reason"`
+	Status        string       `json:"status"`
+	Items         []ReturnItem `json:"items"`
+	RefundAmount  money.Money  `json:"refund_amount"`
+	Note          string       `json:"note,omitempty"`
+	FailureReason string       `json:"failure_reason,omitempty"`
+	ReviewedAt    *time.Time   `json:"reviewed_at,omitempty"`
+	RefundedAt    *time.Time   `json:"refunded_at,omitempty"`
+	CompletedAt   *time.Time   `json:"completed_at,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
+
+// Common errors
+var (
+	ErrNotFound           = NewError("return not found")
+	ErrOrderNotFound      = NewError("order not found")
+	ErrOrderNotReturnable = NewError("only delivered orders can be returned")
+	ErrInvalidReason      = NewError("reason is required")
+	ErrNoItems            = NewError("at least one item is required")
+	ErrInvalidItem        = NewError("item is not part of the order")
+	ErrInvalidQuantity    = NewError("quantity exceeds what is left to return")
+	ErrNotReviewable      = NewError("return is no longer awaiting review")
+)
+
+// Error represents a return error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new return error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
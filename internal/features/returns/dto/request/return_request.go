@@ -0,0 +1,20 @@
+package request
+
+// CreateReturnRequest represents the request to send items of a delivered
+// order back
+type CreateReturnRequest struct {
+	OrderID string              `json:"order_id" validate:"required,uuid"`
+	Reason  string              `json:"reason" validate:"required"`
+	Items   []ReturnItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+// ReturnItemRequest represents a quantity of an order item to return
+type ReturnItemRequest struct {
+	OrderItemID string `json:"order_item_id" validate:"required,uuid"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+}
+
+// ReviewReturnRequest represents an admin's decision on a return
+type ReviewReturnRequest struct {
+	Note string `json:"note"`
+}
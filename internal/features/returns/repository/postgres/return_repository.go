@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/inbox"
+)
+
+// ReturnRepository implements the repository.ReturnRepository interface.
+// Writes join the inbox transaction carried by ctx, if any, so that a saga
+// step is applied exactly once.
+type ReturnRepository struct {
+	db *gorm.DB
+}
+
+// NewReturnRepository creates a new PostgreSQL return repository
+func NewReturnRepository(db *gorm.DB) repository.ReturnRepository {
+	return &ReturnRepository{
+		db: db,
+	}
+}
+
+// Create saves a new return within the ordered quantities
+func (r *ReturnRepository) Create(ctx context.Context, ret *entity.Return, ordered map[uuid.UUID]int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize returns of the same order so that two of them never
+		// take the same units
+		if err := lockOrder(tx, ret.OrderID); err != nil {
+			return err
+		}
+
+		existing, err := listByOrderID(tx, ret.OrderID)
+		if err != nil {
+			return err
+		}
+		for id, quantity := range entity.Returned(append(existing, ret)) {
+			if quantity > ordered[id] {
+				return repository.ErrOverReturned
+			}
+		}
+
+		return tx.Create(ret).Error
+	})
+}
+
+// GetByID retrieves a return with its items
+func (r *ReturnRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Return, error) {
+	var ret entity.Return
+	err := r.db.WithContext(ctx).Preload("Items").First(&ret, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrReturnNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// ListByOrderID retrieves the returns of an order, oldest first
+func (r *ReturnRepository) ListByOrderID(ctx context.Context, orderID uuid.UUID) ([]*entity.Return, error) {
+	return listByOrderID(r.db.WithContext(ctx), orderID)
+}
+
+// ListByUserID retrieves the returns of a user, newest first
+func (r *ReturnRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Return, error) {
+	var returns []*entity.Return
+	err := r.db.WithContext(ctx).
+		Preload("Items").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&returns).Error
+	if err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+// UpdateStatus saves the status of the return if it is still in status from
+func (r *ReturnRepository) UpdateStatus(ctx context.Context, ret *entity.Return, from entity.ReturnStatus) error {
+	result := inbox.Tx(ctx, r.db).Model(&entity.Return{}).
+		Where("id = ? AND status = ?", ret.ID, from).
+		Updates(map[string]interface{}{
+			"status":         ret.Status,
+			"note":           ret.Note,
+			"failure_reason": ret.FailureReason,
+			"reviewed_at":    ret.ReviewedAt,
+			"updated_at":     ret.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrStatusChanged
+	}
+	return nil
+}
+
+// MarkRefunded records that the refund of the return was issued
+func (r *ReturnRepository) MarkRefunded(ctx context.Context, id uuid.UUID) (bool, error) {
+	now := time.Now()
+	result := inbox.Tx(ctx, r.db).Model(&entity.Return{}).
+		Where("id = ? AND refunded_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"refunded_at": now,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Complete moves an approved return to completed and records its items as
+// returned on the order
+func (r *ReturnRepository) Complete(ctx context.Context, id uuid.UUID) error {
+	return inbox.Tx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&entity.Return{}).
+			Where("id = ? AND status = ?", id, entity.ReturnStatusApproved).
+			Updates(map[string]interface{}{
+				"status":       entity.ReturnStatusCompleted,
+				"completed_at": now,
+				"updated_at":   now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var items []entity.ReturnItem
+		if err := tx.Where("return_id = ?", id).Find(&items).Error; err != nil {
+			return err
+		}
+		for _, item := range items {
+			err := tx.Table("order_items").
+				Where("id = ?", item.OrderItemID).
+				Update("returned_quantity", gorm.Expr("returned_quantity + ?", item.Quantity)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func listByOrderID(tx *gorm.DB, orderID uuid.UUID) ([]*entity.Return, error) {
+	var returns []*entity.Return
+	err := tx.
+		Preload("Items").
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&returns).Error
+	if err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+func lockOrder(tx *gorm.DB, orderID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "returns:"+orderID.String()).Error
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	orderEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// ReturnSagas starts the saga restocking and refunding an approved return
+type ReturnSagas interface {
+	StartOrderReturnSaga(ctx context.Context, orderID, returnID uuid.UUID) error
+}
+
+type ReturnUsecase struct {
+	returnRepo repository.ReturnRepository
+	orderRepo  orderRepo.OrderRepository
+	sagas      ReturnSagas
+}
+
+// NewReturnUsecase creates a new return usecase. Approved returns are handed
+// over to sagas.
+func NewReturnUsecase(returnRepo repository.ReturnRepository, orderRepo orderRepo.OrderRepository, sagas ReturnSagas) *ReturnUsecase {
+	return &ReturnUsecase{
+		returnRepo: returnRepo,
+		orderRepo:  orderRepo,
+		sagas:      sagas,
+	}
+}
+
+// RequestReturn asks for items of a delivered order of the user to be taken
+// back. Each item is refunded its share of what the order was paid, after
+// discounts and taxes.
+func (u *ReturnUsecase) RequestReturn(ctx context.Context, userID, orderID uuid.UUID, input usecase.ReturnInput) (*usecase.ReturnResponse, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, usecase.ErrInvalidReason
+	}
+	if len(input.Items) == 0 {
+		return nil, usecase.ErrNoItems
+	}
+
+	order, err := u.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && (order == nil || order.UserID != userID)) {
+		return nil, usecase.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != orderEntity.OrderStatusDelivered {
+		return nil, usecase.ErrOrderNotReturnable
+	}
+
+	existing, err := u.returnRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := returnItems(order, input.Items, entity.Returned(existing))
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := entity.NewReturn(orderID, userID, reason, items)
+	if err != nil {
+		return nil, err
+	}
+	ordered := make(map[uuid.UUID]int, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.ID] += item.Quantity
+	}
+	if err := u.returnRepo.Create(ctx, ret, ordered); err != nil {
+		if errors.Is(err, repository.ErrOverReturned) {
+			return nil, usecase.ErrInvalidQuantity
+		}
+		return nil, err
+	}
+
+	return convertReturn(ret), nil
+}
+
+// GetReturn retrieves a return of the user
+func (u *ReturnUsecase) GetReturn(ctx context.Context, userID, returnID uuid.UUID) (*usecase.ReturnResponse, error) {
+	ret, err := u.getReturn(ctx, returnID)
+	if err != nil {
+		return nil, err
+	}
+	if ret.UserID != userID {
+		return nil, usecase.ErrNotFound
+	}
+	return convertReturn(ret), nil
+}
+
+// ListReturns retrieves the returns of the user, newest first
+func (u *ReturnUsecase) ListReturns(ctx context.Context, userID uuid.UUID) ([]*usecase.ReturnResponse, error) {
+	returns, err := u.returnRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*usecase.ReturnResponse, len(returns))
+	for i, ret := range returns {
+		result[i] = convertReturn(ret)
+	}
+	return result, nil
+}
+
+// ApproveReturn accepts a requested return and starts the saga restocking
+// its items and refunding the customer. The return goes back to review if
+// the saga cannot be started.
+func (u *ReturnUsecase) ApproveReturn(ctx context.Context, returnID uuid.UUID, note string) (*usecase.ReturnResponse, error) {
+	ret, err := u.review(ctx, returnID, entity.ReturnStatusApproved, note)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.sagas.StartOrderReturnSaga(ctx, ret.OrderID, ret.ID); err != nil {
+		ret.Status = entity.ReturnStatusRequested
+		ret.ReviewedAt = nil
+		ret.UpdatedAt = time.Now()
+		if revertErr := u.returnRepo.UpdateStatus(ctx, ret, entity.ReturnStatusApproved); revertErr != nil {
+			return nil, fmt.Errorf("failed to start return saga: %v, and to revert the approval: %w", err, revertErr)
+		}
+		return nil, fmt.Errorf("failed to start return saga: %w", err)
+	}
+
+	return convertReturn(ret), nil
+}
+
+// RejectReturn turns down a requested return, which frees its items to be
+// returned again
+func (u *ReturnUsecase) RejectReturn(ctx context.Context, returnID uuid.UUID, note string) (*usecase.ReturnResponse, error) {
+	ret, err := u.review(ctx, returnID, entity.ReturnStatusRejected, note)
+	if err != nil {
+		return nil, err
+	}
+	return convertReturn(ret), nil
+}
+
+// review moves a requested return to the given status
+func (u *ReturnUsecase) review(ctx context.Context, returnID uuid.UUID, status entity.ReturnStatus, note string) (*entity.Return, error) {
+	ret, err := u.getReturn(ctx, returnID)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Status != entity.ReturnStatusRequested {
+		return nil, usecase.ErrNotReviewable
+	}
+
+	now := time.Now()
+	ret.Status = status
+	ret.Note = strings.TrimSpace(note)
+	ret.ReviewedAt = &now
+	ret.UpdatedAt = now
+	if err := u.returnRepo.UpdateStatus(ctx, ret, entity.ReturnStatusRequested); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return nil, usecase.ErrNotReviewable
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (u *ReturnUsecase) getReturn(ctx context.Context, returnID uuid.UUID) (*entity.Return, error) {
+	ret, err := u.returnRepo.GetByID(ctx, returnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrReturnNotFound) {
+			return nil, usecase.ErrNotFound
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+// returnItems checks the requested items against what is left to return and
+// prices their refunds
+func returnItems(order *orderEntity.Order, requested []usecase.ReturnItem, returned map[uuid.UUID]int) ([]entity.ReturnItem, error) {
+	paid, err := paidShares(order)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[uuid.UUID]int, len(order.Items))
+	for i, item := range order.Items {
+		index[item.ID] = i
+	}
+
+	items := make([]entity.ReturnItem, 0, len(requested))
+	for _, req := range requested {
+		i, ok := index[req.OrderItemID]
+		if !ok {
+			return nil, usecase.ErrInvalidItem
+		}
+		item := order.Items[i]
+		before := returned[item.ID]
+		if req.Quantity <= 0 || before+req.Quantity > item.Quantity {
+			return nil, usecase.ErrInvalidQuantity
+		}
+		returned[item.ID] += req.Quantity
+
+		items = append(items, entity.ReturnItem{
+			OrderItemID: item.ID,
+			ProductID:   item.ProductID,
+			Quantity:    req.Quantity,
+			Refund:      entity.Refund(paid[i], item.Quantity, before, req.Quantity),
+		})
+	}
+	return items, nil
+}
+
+// paidShares splits the total the order was paid over its items in
+// proportion to their subtotals
+func paidShares(order *orderEntity.Order) ([]money.Money, error) {
+	weights := make([]int64, len(order.Items))
+	var total int64
+	for i, item := range order.Items {
+		weights[i] = item.Subtotal().Amount
+		total += weights[i]
+	}
+
+	if total == 0 || !order.TotalAmount.IsPositive() {
+		shares := make([]money.Money, len(order.Items))
+		for i := range shares {
+			shares[i] = money.Zero(order.TotalAmount.Currency)
+		}
+		return shares, nil
+	}
+	return order.TotalAmount.Allocate(weights)
+}
+
+func convertReturn(ret *entity.Return) *usecase.ReturnResponse {
+	items := make([]usecase.ReturnItem, len(ret.Items))
+	for i, item := range ret.Items {
+		items[i] = usecase.ReturnItem{
+			OrderItemID: item.OrderItemID,
+			ProductID:   item.ProductID,
+			Quantity:    item.Quantity,
+			Refund:      item.Refund,
+		}
+	}
+
+	return &usecase.ReturnResponse{
+		ID:            ret.ID,
+		OrderID:       ret.OrderID,
+		UserID:        ret.UserID,
+		Reason:        ret.Reason,
+		Status:        string(ret.Status),
+		Items:         items,
+		RefundAmount:  ret.RefundAmount,
+		Note:          ret.Note,
+		FailureReason: ret.FailureReason,
+		ReviewedAt:    ret.ReviewedAt,
+		RefundedAt:    ret.RefundedAt,
+		CompletedAt:   ret.CompletedAt,
+		CreatedAt:     ret.CreatedAt,
+		UpdatedAt:     ret.UpdatedAt,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // SagaClient represents the gRPC client for saga service
@@ -38,14 +39,14 @@ func (c *SagaClient) Close() error {
 }
 
 // StartOrderSaga starts a new order saga transaction
-func (c *SagaClient) StartOrderSaga(ctx context.Context, orderID, userID string, amount float64, paymentMethod string, metadata map[string]string) (*pb.SagaTransaction, error) {
+func (c *SagaClient) StartOrderSaga(ctx context.Context, orderID, userID string, amount money.Money, paymentMethod string, metadata map[string]string) (*pb.SagaTransaction, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
 	req := &pb.StartOrderSagaRequest{
 		OrderId:       orderID,
 		UserId:        userID,
-		Amount:        amount,
+		Amount:        &pb.Money{Amount: amount.Amount, Currency: string(amount.Currency)},
 		PaymentMethod: paymentMethod,
 		Metadata:      metadata,
 	}
@@ -94,18 +95,12 @@ func (c *SagaClient) CompensateTransaction(ctx context.Context, sagaID, stepID,
 	return resp.Transaction, nil
 }
 
-// ListSagaTransactions retrieves a list of saga transactions
-func (c *SagaClient) ListSagaTransactions(ctx context.Context, page, limit int32, status, transactionType string) (*pb.ListSagaTransactionsResponse, error) {
+// ListSagaTransactions retrieves a page of saga transactions; pass the returned
+// next cursor in req.Cursor to get the following page
+func (c *SagaClient) ListSagaTransactions(ctx context.Context, req *pb.ListSagaTransactionsRequest) (*pb.ListSagaTransactionsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
-	req := &pb.ListSagaTransactionsRequest{
-		Page:   page,
-		Limit:  limit,
-		Status: status,
-		Type:   transactionType,
-	}
-
 	return c.client.ListSagaTransactions(ctx, req)
 }
 
@@ -113,3 +108,15 @@ func (c *SagaClient) ListSagaTransactions(ctx context.Context, page, limit int32
 func WithToken(ctx context.Context, token string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, "authorization", fmt.Sprintf("Bearer %s", token))
 }
+
+// GetSagaTimeline retrieves the ordered event history of a saga transaction
+func (c *SagaClient) GetSagaTimeline(ctx context.Context, sagaID string) (*pb.GetSagaTimelineResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	req := &pb.GetSagaTimelineRequest{
+		SagaId: sagaID,
+	}
+
+	return c.client.GetSagaTimeline(ctx, req)
+}
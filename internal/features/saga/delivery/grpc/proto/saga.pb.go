@@ -32,6 +32,8 @@ type SagaTransaction struct {
 	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OrderId       string                 `protobuf:"bytes,8,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -115,6 +117,20 @@ func (x *SagaTransaction) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SagaTransaction) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SagaTransaction) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // SagaStep represents a step in the saga transaction
 type SagaStep struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -127,6 +143,7 @@ type SagaStep struct {
 	Payload            map[string]string      `protobuf:"bytes,7,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ErrorMessage       string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	ExecutedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	Retries            int32                  `protobuf:"varint,10,opt,name=retries,proto3" json:"retries,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -224,21 +241,81 @@ func (x *SagaStep) GetExecutedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SagaStep) GetRetries() int32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+// Money represents an amount in the minor units of an ISO 4217 currency
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_proto_saga_saga_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_saga_saga_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 // StartOrderSagaRequest represents the request to start an order saga
 type StartOrderSagaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartOrderSagaRequest) Reset() {
 	*x = StartOrderSagaRequest{}
-	mi := &file_proto_saga_saga_proto_msgTypes[2]
+	mi := &file_proto_saga_saga_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -250,7 +327,7 @@ func (x *StartOrderSagaRequest) String() string {
 func (*StartOrderSagaRequest) ProtoMessage() {}
 
 func (x *StartOrderSagaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[2]
+	mi := &file_proto_saga_saga_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +340,7 @@ func (x *StartOrderSagaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartOrderSagaRequest.ProtoReflect.Descriptor instead.
 func (*StartOrderSagaRequest) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{2}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StartOrderSagaRequest) GetOrderId() string {
@@ -280,13 +357,6 @@ func (x *StartOrderSagaRequest) GetUserId() string {
 	return ""
 }
 
-func (x *StartOrderSagaRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
 func (x *StartOrderSagaRequest) GetPaymentMethod() string {
 	if x != nil {
 		return x.PaymentMethod
@@ -301,6 +371,13 @@ func (x *StartOrderSagaRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *StartOrderSagaRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 // StartOrderSagaResponse represents the response after starting an order saga
 type StartOrderSagaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -313,7 +390,7 @@ type StartOrderSagaResponse struct {
 
 func (x *StartOrderSagaResponse) Reset() {
 	*x = StartOrderSagaResponse{}
-	mi := &file_proto_saga_saga_proto_msgTypes[3]
+	mi := &file_proto_saga_saga_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +402,7 @@ func (x *StartOrderSagaResponse) String() string {
 func (*StartOrderSagaResponse) ProtoMessage() {}
 
 func (x *StartOrderSagaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[3]
+	mi := &file_proto_saga_saga_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +415,7 @@ func (x *StartOrderSagaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartOrderSagaResponse.ProtoReflect.Descriptor instead.
 func (*StartOrderSagaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{3}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StartOrderSagaResponse) GetSuccess() bool {
@@ -372,7 +449,7 @@ type GetSagaStatusRequest struct {
 
 func (x *GetSagaStatusRequest) Reset() {
 	*x = GetSagaStatusRequest{}
-	mi := &file_proto_saga_saga_proto_msgTypes[4]
+	mi := &file_proto_saga_saga_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -384,7 +461,7 @@ func (x *GetSagaStatusRequest) String() string {
 func (*GetSagaStatusRequest) ProtoMessage() {}
 
 func (x *GetSagaStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[4]
+	mi := &file_proto_saga_saga_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +474,7 @@ func (x *GetSagaStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSagaStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetSagaStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{4}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetSagaStatusRequest) GetSagaId() string {
@@ -417,7 +494,7 @@ type GetSagaStatusResponse struct {
 
 func (x *GetSagaStatusResponse) Reset() {
 	*x = GetSagaStatusResponse{}
-	mi := &file_proto_saga_saga_proto_msgTypes[5]
+	mi := &file_proto_saga_saga_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +506,7 @@ func (x *GetSagaStatusResponse) String() string {
 func (*GetSagaStatusResponse) ProtoMessage() {}
 
 func (x *GetSagaStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[5]
+	mi := &file_proto_saga_saga_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +519,7 @@ func (x *GetSagaStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSagaStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetSagaStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{5}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetSagaStatusResponse) GetTransaction() *SagaTransaction {
@@ -464,7 +541,7 @@ type CompensateTransactionRequest struct {
 
 func (x *CompensateTransactionRequest) Reset() {
 	*x = CompensateTransactionRequest{}
-	mi := &file_proto_saga_saga_proto_msgTypes[6]
+	mi := &file_proto_saga_saga_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +553,7 @@ func (x *CompensateTransactionRequest) String() string {
 func (*CompensateTransactionRequest) ProtoMessage() {}
 
 func (x *CompensateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[6]
+	mi := &file_proto_saga_saga_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +566,7 @@ func (x *CompensateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompensateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CompensateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{6}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CompensateTransactionRequest) GetSagaId() string {
@@ -525,7 +602,7 @@ type CompensateTransactionResponse struct {
 
 func (x *CompensateTransactionResponse) Reset() {
 	*x = CompensateTransactionResponse{}
-	mi := &file_proto_saga_saga_proto_msgTypes[7]
+	mi := &file_proto_saga_saga_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +614,7 @@ func (x *CompensateTransactionResponse) String() string {
 func (*CompensateTransactionResponse) ProtoMessage() {}
 
 func (x *CompensateTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[7]
+	mi := &file_proto_saga_saga_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +627,7 @@ func (x *CompensateTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompensateTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CompensateTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{7}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CompensateTransactionResponse) GetSuccess() bool {
@@ -575,19 +652,28 @@ func (x *CompensateTransactionResponse) GetTransaction() *SagaTransaction {
 }
 
 // ListSagaTransactionsRequest represents the request to list saga transactions
+// Results are paged with the cursor returned as next_cursor by the previous call.
 type ListSagaTransactionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/saga/saga.proto.
+	Page        int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit       int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Status      string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Type        string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	OrderId     string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// created_at (default) or updated_at
+	SortBy        string `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc      bool   `protobuf:"varint,9,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	Cursor        string `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSagaTransactionsRequest) Reset() {
 	*x = ListSagaTransactionsRequest{}
-	mi := &file_proto_saga_saga_proto_msgTypes[8]
+	mi := &file_proto_saga_saga_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +685,7 @@ func (x *ListSagaTransactionsRequest) String() string {
 func (*ListSagaTransactionsRequest) ProtoMessage() {}
 
 func (x *ListSagaTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[8]
+	mi := &file_proto_saga_saga_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,9 +698,10 @@ func (x *ListSagaTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSagaTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*ListSagaTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{8}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{9}
 }
 
+// Deprecated: Marked as deprecated in proto/saga/saga.proto.
 func (x *ListSagaTransactionsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
@@ -643,20 +730,64 @@ func (x *ListSagaTransactionsRequest) GetType() string {
 	return ""
 }
 
+func (x *ListSagaTransactionsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListSagaTransactionsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListSagaTransactionsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListSagaTransactionsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListSagaTransactionsRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *ListSagaTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // ListSagaTransactionsResponse represents the response containing a list of transactions
 type ListSagaTransactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*SagaTransaction     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Transactions []*SagaTransaction     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total        int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Deprecated: Marked as deprecated in proto/saga/saga.proto.
+	Page          int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor    string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSagaTransactionsResponse) Reset() {
 	*x = ListSagaTransactionsResponse{}
-	mi := &file_proto_saga_saga_proto_msgTypes[9]
+	mi := &file_proto_saga_saga_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -668,7 +799,7 @@ func (x *ListSagaTransactionsResponse) String() string {
 func (*ListSagaTransactionsResponse) ProtoMessage() {}
 
 func (x *ListSagaTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_saga_saga_proto_msgTypes[9]
+	mi := &file_proto_saga_saga_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +812,7 @@ func (x *ListSagaTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSagaTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*ListSagaTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_saga_saga_proto_rawDescGZIP(), []int{9}
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListSagaTransactionsResponse) GetTransactions() []*SagaTransaction {
@@ -698,6 +829,7 @@ func (x *ListSagaTransactionsResponse) GetTotal() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/saga/saga.proto.
 func (x *ListSagaTransactionsResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
@@ -712,11 +844,233 @@ func (x *ListSagaTransactionsResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListSagaTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// SagaEvent represents one transition in the history of a saga transaction
+type SagaEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	StepId        string                 `protobuf:"bytes,3,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
+	StepName      string                 `protobuf:"bytes,4,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,5,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,6,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Attempt       int32                  `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Actor         string                 `protobuf:"bytes,8,opt,name=actor,proto3" json:"actor,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SagaEvent) Reset() {
+	*x = SagaEvent{}
+	mi := &file_proto_saga_saga_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SagaEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaEvent) ProtoMessage() {}
+
+func (x *SagaEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_saga_saga_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaEvent.ProtoReflect.Descriptor instead.
+func (*SagaEvent) Descriptor() ([]byte, []int) {
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SagaEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SagaEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetStepId() string {
+	if x != nil {
+		return x.StepId
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetStepName() string {
+	if x != nil {
+		return x.StepName
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *SagaEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SagaEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetSagaTimelineRequest represents the request to get a saga's event history
+type GetSagaTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSagaTimelineRequest) Reset() {
+	*x = GetSagaTimelineRequest{}
+	mi := &file_proto_saga_saga_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSagaTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaTimelineRequest) ProtoMessage() {}
+
+func (x *GetSagaTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_saga_saga_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetSagaTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSagaTimelineRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+// GetSagaTimelineResponse represents the response containing a saga's event history
+type GetSagaTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	Events        []*SagaEvent           `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSagaTimelineResponse) Reset() {
+	*x = GetSagaTimelineResponse{}
+	mi := &file_proto_saga_saga_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSagaTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaTimelineResponse) ProtoMessage() {}
+
+func (x *GetSagaTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_saga_saga_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetSagaTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_saga_saga_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSagaTimelineResponse) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *GetSagaTimelineResponse) GetEvents() []*SagaEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 var File_proto_saga_saga_proto protoreflect.FileDescriptor
 
 const file_proto_saga_saga_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/saga/saga.proto\x12\x04saga\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe7\x02\n" +
+	"\x15proto/saga/saga.proto\x12\x04saga\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x03\n" +
 	"\x0fSagaTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
@@ -726,10 +1080,12 @@ const file_proto_saga_saga_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\border_id\x18\b \x01(\tR\aorderId\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfe\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x03\n" +
 	"\bSagaStep\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -740,19 +1096,24 @@ const file_proto_saga_saga_proto_rawDesc = "" +
 	"\apayload\x18\a \x03(\v2\x1b.saga.SagaStep.PayloadEntryR\apayload\x12#\n" +
 	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12;\n" +
 	"\vexecuted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"executedAt\x1a:\n" +
+	"executedAt\x12\x18\n" +
+	"\aretries\x18\n" +
+	" \x01(\x05R\aretries\x1a:\n" +
 	"\fPayloadEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8e\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xa1\x02\n" +
 	"\x15StartOrderSagaRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12%\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12E\n" +
-	"\bmetadata\x18\x05 \x03(\v2).saga.StartOrderSagaRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x05 \x03(\v2).saga.StartOrderSagaRequest.MetadataEntryR\bmetadata\x12#\n" +
+	"\x06amount\x18\x06 \x01(\v2\v.saga.MoneyR\x06amount\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04\"\x85\x01\n" +
 	"\x16StartOrderSagaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
@@ -768,22 +1129,52 @@ const file_proto_saga_saga_proto_rawDesc = "" +
 	"\x1dCompensateTransactionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
-	"\vtransaction\x18\x03 \x01(\v2\x15.saga.SagaTransactionR\vtransaction\"s\n" +
-	"\x1bListSagaTransactionsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x15.saga.SagaTransactionR\vtransaction\"\xda\x02\n" +
+	"\x1bListSagaTransactionsRequest\x12\x16\n" +
+	"\x04page\x18\x01 \x01(\x05B\x02\x18\x01R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\"\x99\x01\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\t \x01(\bR\bsortDesc\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\"\xbe\x01\n" +
 	"\x1cListSagaTransactionsResponse\x129\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x15.saga.SagaTransactionR\ftransactions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit2\xe5\x02\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
+	"\x04page\x18\x03 \x01(\x05B\x02\x18\x01R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"\xb0\x02\n" +
+	"\tSagaEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x17\n" +
+	"\astep_id\x18\x03 \x01(\tR\x06stepId\x12\x1b\n" +
+	"\tstep_name\x18\x04 \x01(\tR\bstepName\x12\x1f\n" +
+	"\vfrom_status\x18\x05 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x06 \x01(\tR\btoStatus\x12\x18\n" +
+	"\aattempt\x18\a \x01(\x05R\aattempt\x12\x14\n" +
+	"\x05actor\x18\b \x01(\tR\x05actor\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"1\n" +
+	"\x16GetSagaTimelineRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\"[\n" +
+	"\x17GetSagaTimelineResponse\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12'\n" +
+	"\x06events\x18\x02 \x03(\v2\x0f.saga.SagaEventR\x06events2\xb5\x03\n" +
 	"\vSagaService\x12K\n" +
 	"\x0eStartOrderSaga\x12\x1b.saga.StartOrderSagaRequest\x1a\x1c.saga.StartOrderSagaResponse\x12H\n" +
 	"\rGetSagaStatus\x12\x1a.saga.GetSagaStatusRequest\x1a\x1b.saga.GetSagaStatusResponse\x12`\n" +
 	"\x15CompensateTransaction\x12\".saga.CompensateTransactionRequest\x1a#.saga.CompensateTransactionResponse\x12]\n" +
-	"\x14ListSagaTransactions\x12!.saga.ListSagaTransactionsRequest\x1a\".saga.ListSagaTransactionsResponseBSZQgithub.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/grpc/protob\x06proto3"
+	"\x14ListSagaTransactions\x12!.saga.ListSagaTransactionsRequest\x1a\".saga.ListSagaTransactionsResponse\x12N\n" +
+	"\x0fGetSagaTimeline\x12\x1c.saga.GetSagaTimelineRequest\x1a\x1d.saga.GetSagaTimelineResponseBSZQgithub.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/grpc/protob\x06proto3"
 
 var (
 	file_proto_saga_saga_proto_rawDescOnce sync.Once
@@ -797,48 +1188,59 @@ func file_proto_saga_saga_proto_rawDescGZIP() []byte {
 	return file_proto_saga_saga_proto_rawDescData
 }
 
-var file_proto_saga_saga_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_saga_saga_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_saga_saga_proto_goTypes = []any{
 	(*SagaTransaction)(nil),               // 0: saga.SagaTransaction
 	(*SagaStep)(nil),                      // 1: saga.SagaStep
-	(*StartOrderSagaRequest)(nil),         // 2: saga.StartOrderSagaRequest
-	(*StartOrderSagaResponse)(nil),        // 3: saga.StartOrderSagaResponse
-	(*GetSagaStatusRequest)(nil),          // 4: saga.GetSagaStatusRequest
-	(*GetSagaStatusResponse)(nil),         // 5: saga.GetSagaStatusResponse
-	(*CompensateTransactionRequest)(nil),  // 6: saga.CompensateTransactionRequest
-	(*CompensateTransactionResponse)(nil), // 7: saga.CompensateTransactionResponse
-	(*ListSagaTransactionsRequest)(nil),   // 8: saga.ListSagaTransactionsRequest
-	(*ListSagaTransactionsResponse)(nil),  // 9: saga.ListSagaTransactionsResponse
-	nil,                                   // 10: saga.SagaTransaction.MetadataEntry
-	nil,                                   // 11: saga.SagaStep.PayloadEntry
-	nil,                                   // 12: saga.StartOrderSagaRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
+	(*Money)(nil),                         // 2: saga.Money
+	(*StartOrderSagaRequest)(nil),         // 3: saga.StartOrderSagaRequest
+	(*StartOrderSagaResponse)(nil),        // 4: saga.StartOrderSagaResponse
+	(*GetSagaStatusRequest)(nil),          // 5: saga.GetSagaStatusRequest
+	(*GetSagaStatusResponse)(nil),         // 6: saga.GetSagaStatusResponse
+	(*CompensateTransactionRequest)(nil),  // 7: saga.CompensateTransactionRequest
+	(*CompensateTransactionResponse)(nil), // 8: saga.CompensateTransactionResponse
+	(*ListSagaTransactionsRequest)(nil),   // 9: saga.ListSagaTransactionsRequest
+	(*ListSagaTransactionsResponse)(nil),  // 10: saga.ListSagaTransactionsResponse
+	(*SagaEvent)(nil),                     // 11: saga.SagaEvent
+	(*GetSagaTimelineRequest)(nil),        // 12: saga.GetSagaTimelineRequest
+	(*GetSagaTimelineResponse)(nil),       // 13: saga.GetSagaTimelineResponse
+	nil,                                   // 14: saga.SagaTransaction.MetadataEntry
+	nil,                                   // 15: saga.SagaStep.PayloadEntry
+	nil,                                   // 16: saga.StartOrderSagaRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),         // 17: google.protobuf.Timestamp
 }
 var file_proto_saga_saga_proto_depIdxs = []int32{
 	1,  // 0: saga.SagaTransaction.steps:type_name -> saga.SagaStep
-	10, // 1: saga.SagaTransaction.metadata:type_name -> saga.SagaTransaction.MetadataEntry
-	13, // 2: saga.SagaTransaction.created_at:type_name -> google.protobuf.Timestamp
-	13, // 3: saga.SagaTransaction.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 4: saga.SagaStep.payload:type_name -> saga.SagaStep.PayloadEntry
-	13, // 5: saga.SagaStep.executed_at:type_name -> google.protobuf.Timestamp
-	12, // 6: saga.StartOrderSagaRequest.metadata:type_name -> saga.StartOrderSagaRequest.MetadataEntry
-	0,  // 7: saga.StartOrderSagaResponse.transaction:type_name -> saga.SagaTransaction
-	0,  // 8: saga.GetSagaStatusResponse.transaction:type_name -> saga.SagaTransaction
-	0,  // 9: saga.CompensateTransactionResponse.transaction:type_name -> saga.SagaTransaction
-	0,  // 10: saga.ListSagaTransactionsResponse.transactions:type_name -> saga.SagaTransaction
-	2,  // 11: saga.SagaService.StartOrderSaga:input_type -> saga.StartOrderSagaRequest
-	4,  // 12: saga.SagaService.GetSagaStatus:input_type -> saga.GetSagaStatusRequest
-	6,  // 13: saga.SagaService.CompensateTransaction:input_type -> saga.CompensateTransactionRequest
-	8,  // 14: saga.SagaService.ListSagaTransactions:input_type -> saga.ListSagaTransactionsRequest
-	3,  // 15: saga.SagaService.StartOrderSaga:output_type -> saga.StartOrderSagaResponse
-	5,  // 16: saga.SagaService.GetSagaStatus:output_type -> saga.GetSagaStatusResponse
-	7,  // 17: saga.SagaService.CompensateTransaction:output_type -> saga.CompensateTransactionResponse
-	9,  // 18: saga.SagaService.ListSagaTransactions:output_type -> saga.ListSagaTransactionsResponse
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	14, // 1: saga.SagaTransaction.metadata:type_name -> saga.SagaTransaction.MetadataEntry
+	17, // 2: saga.SagaTransaction.created_at:type_name -> google.protobuf.Timestamp
+	17, // 3: saga.SagaTransaction.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 4: saga.SagaStep.payload:type_name -> saga.SagaStep.PayloadEntry
+	17, // 5: saga.SagaStep.executed_at:type_name -> google.protobuf.Timestamp
+	16, // 6: saga.StartOrderSagaRequest.metadata:type_name -> saga.StartOrderSagaRequest.MetadataEntry
+	2,  // 7: saga.StartOrderSagaRequest.amount:type_name -> saga.Money
+	0,  // 8: saga.StartOrderSagaResponse.transaction:type_name -> saga.SagaTransaction
+	0,  // 9: saga.GetSagaStatusResponse.transaction:type_name -> saga.SagaTransaction
+	0,  // 10: saga.CompensateTransactionResponse.transaction:type_name -> saga.SagaTransaction
+	17, // 11: saga.ListSagaTransactionsRequest.created_from:type_name -> google.protobuf.Timestamp
+	17, // 12: saga.ListSagaTransactionsRequest.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 13: saga.ListSagaTransactionsResponse.transactions:type_name -> saga.SagaTransaction
+	17, // 14: saga.SagaEvent.created_at:type_name -> google.protobuf.Timestamp
+	11, // 15: saga.GetSagaTimelineResponse.events:type_name -> saga.SagaEvent
+	3,  // 16: saga.SagaService.StartOrderSaga:input_type -> saga.StartOrderSagaRequest
+	5,  // 17: saga.SagaService.GetSagaStatus:input_type -> saga.GetSagaStatusRequest
+	7,  // 18: saga.SagaService.CompensateTransaction:input_type -> saga.CompensateTransactionRequest
+	9,  // 19: saga.SagaService.ListSagaTransactions:input_type -> saga.ListSagaTransactionsRequest
+	12, // 20: saga.SagaService.GetSagaTimeline:input_type -> saga.GetSagaTimelineRequest
+	4,  // 21: saga.SagaService.StartOrderSaga:output_type -> saga.StartOrderSagaResponse
+	6,  // 22: saga.SagaService.GetSagaStatus:output_type -> saga.GetSagaStatusResponse
+	8,  // 23: saga.SagaService.CompensateTransaction:output_type -> saga.CompensateTransactionResponse
+	10, // 24: saga.SagaService.ListSagaTransactions:output_type -> saga.ListSagaTransactionsResponse
+	13, // 25: saga.SagaService.GetSagaTimeline:output_type -> saga.GetSagaTimelineResponse
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_saga_saga_proto_init() }
@@ -852,7 +1254,7 @@ func file_proto_saga_saga_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_saga_saga_proto_rawDesc), len(file_proto_saga_saga_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
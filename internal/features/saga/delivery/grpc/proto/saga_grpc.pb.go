@@ -23,6 +23,7 @@ const (
 	SagaService_GetSagaStatus_FullMethodName         = "/saga.SagaService/GetSagaStatus"
 	SagaService_CompensateTransaction_FullMethodName = "/saga.SagaService/CompensateTransaction"
 	SagaService_ListSagaTransactions_FullMethodName  = "/saga.SagaService/ListSagaTransactions"
+	SagaService_GetSagaTimeline_FullMethodName       = "/saga.SagaService/GetSagaTimeline"
 )
 
 // SagaServiceClient is the client API for SagaService service.
@@ -39,6 +40,8 @@ type SagaServiceClient interface {
 	CompensateTransaction(ctx context.Context, in *CompensateTransactionRequest, opts ...grpc.CallOption) (*CompensateTransactionResponse, error)
 	// ListSagaTransactions retrieves a list of saga transactions
 	ListSagaTransactions(ctx context.Context, in *ListSagaTransactionsRequest, opts ...grpc.CallOption) (*ListSagaTransactionsResponse, error)
+	// GetSagaTimeline retrieves the ordered event history of a saga transaction
+	GetSagaTimeline(ctx context.Context, in *GetSagaTimelineRequest, opts ...grpc.CallOption) (*GetSagaTimelineResponse, error)
 }
 
 type sagaServiceClient struct {
@@ -89,6 +92,16 @@ func (c *sagaServiceClient) ListSagaTransactions(ctx context.Context, in *ListSa
 	return out, nil
 }
 
+func (c *sagaServiceClient) GetSagaTimeline(ctx context.Context, in *GetSagaTimelineRequest, opts ...grpc.CallOption) (*GetSagaTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSagaTimelineResponse)
+	err := c.cc.Invoke(ctx, SagaService_GetSagaTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SagaServiceServer is the server API for SagaService service.
 // All implementations must embed UnimplementedSagaServiceServer
 // for forward compatibility.
@@ -103,6 +116,8 @@ type SagaServiceServer interface {
 	CompensateTransaction(context.Context, *CompensateTransactionRequest) (*CompensateTransactionResponse, error)
 	// ListSagaTransactions retrieves a list of saga transactions
 	ListSagaTransactions(context.Context, *ListSagaTransactionsRequest) (*ListSagaTransactionsResponse, error)
+	// GetSagaTimeline retrieves the ordered event history of a saga transaction
+	GetSagaTimeline(context.Context, *GetSagaTimelineRequest) (*GetSagaTimelineResponse, error)
 	mustEmbedUnimplementedSagaServiceServer()
 }
 
@@ -125,6 +140,9 @@ func (UnimplementedSagaServiceServer) CompensateTransaction(context.Context, *Co
 func (UnimplementedSagaServiceServer) ListSagaTransactions(context.Context, *ListSagaTransactionsRequest) (*ListSagaTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSagaTransactions not implemented")
 }
+func (UnimplementedSagaServiceServer) GetSagaTimeline(context.Context, *GetSagaTimelineRequest) (*GetSagaTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSagaTimeline not implemented")
+}
 func (UnimplementedSagaServiceServer) mustEmbedUnimplementedSagaServiceServer() {}
 func (UnimplementedSagaServiceServer) testEmbeddedByValue()                     {}
 
@@ -218,6 +236,24 @@ func _SagaService_ListSagaTransactions_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SagaService_GetSagaTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSagaTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SagaServiceServer).GetSagaTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SagaService_GetSagaTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SagaServiceServer).GetSagaTimeline(ctx, req.(*GetSagaTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SagaService_ServiceDesc is the grpc.ServiceDesc for SagaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +277,10 @@ var SagaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSagaTransactions",
 			Handler:    _SagaService_ListSagaTransactions_Handler,
 		},
+		{
+			MethodName: "GetSagaTimeline",
+			Handler:    _SagaService_GetSagaTimeline_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/saga/saga.proto",
@@ -10,6 +10,7 @@ import (
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga"
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type SagaServer struct {
@@ -34,7 +35,7 @@ func (s *SagaServer) StartOrderSaga(ctx context.Context, req *pb.StartOrderSagaR
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
 
-	sagaResp, err := s.sagaUsecase.StartOrderSaga(ctx, orderID, userID, req.Amount, req.PaymentMethod, req.Metadata)
+	sagaResp, err := s.sagaUsecase.StartOrderSaga(ctx, orderID, userID, convertMoney(req.Amount), req.PaymentMethod, req.Metadata)
 	if err != nil {
 		var errStatus error
 		switch err {
@@ -95,6 +96,8 @@ func (s *SagaServer) CompensateTransaction(ctx context.Context, req *pb.Compensa
 			errStatus = status.Error(codes.NotFound, err.Error())
 		case saga.ErrInvalidStep:
 			errStatus = status.Error(codes.FailedPrecondition, err.Error())
+		case saga.ErrSagaBusy:
+			errStatus = status.Error(codes.Aborted, err.Error())
 		default:
 			errStatus = status.Error(codes.Internal, "failed to compensate transaction")
 		}
@@ -109,24 +112,109 @@ func (s *SagaServer) CompensateTransaction(ctx context.Context, req *pb.Compensa
 }
 
 func (s *SagaServer) ListSagaTransactions(ctx context.Context, req *pb.ListSagaTransactionsRequest) (*pb.ListSagaTransactionsResponse, error) {
-	transactions, total, err := s.sagaUsecase.ListSagaTransactions(ctx, req.Page, req.Limit, req.Status, saga.Type(req.Type))
+	filter := saga.ListFilter{
+		Status:   req.Status,
+		Type:     saga.Type(req.Type),
+		SortBy:   req.SortBy,
+		SortDesc: req.SortDesc,
+		Cursor:   req.Cursor,
+		Limit:    int(req.Limit),
+	}
+	if req.OrderId != "" {
+		orderID, err := uuid.Parse(req.OrderId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+		}
+		filter.OrderID = &orderID
+	}
+	if req.CreatedFrom != nil {
+		createdFrom := req.CreatedFrom.AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+	if req.CreatedTo != nil {
+		createdTo := req.CreatedTo.AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	result, err := s.sagaUsecase.ListSagaTransactions(ctx, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to list transactions")
+		var errStatus error
+		switch err {
+		case saga.ErrInvalidFilter:
+			errStatus = status.Error(codes.InvalidArgument, err.Error())
+		default:
+			errStatus = status.Error(codes.Internal, "failed to list transactions")
+		}
+		return nil, errStatus
 	}
 
-	pbTransactions := make([]*pb.SagaTransaction, len(transactions))
-	for i, t := range transactions {
+	pbTransactions := make([]*pb.SagaTransaction, len(result.Sagas))
+	for i, t := range result.Sagas {
 		pbTransactions[i] = convertSagaTransactionToPb(t)
 	}
 
 	return &pb.ListSagaTransactionsResponse{
 		Transactions: pbTransactions,
-		Total:        int32(total),
-		Page:         req.Page,
-		Limit:        req.Limit,
+		Total:        int32(result.Total),
+		Limit:        int32(len(pbTransactions)),
+		NextCursor:   result.NextCursor,
+	}, nil
+}
+
+func (s *SagaServer) GetSagaTimeline(ctx context.Context, req *pb.GetSagaTimelineRequest) (*pb.GetSagaTimelineResponse, error) {
+	sagaID, err := uuid.Parse(req.SagaId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid saga ID")
+	}
+
+	events, err := s.sagaUsecase.GetSagaTimeline(ctx, sagaID)
+	if err != nil {
+		var errStatus error
+		switch err {
+		case saga.ErrNotFound:
+			errStatus = status.Error(codes.NotFound, err.Error())
+		default:
+			errStatus = status.Error(codes.Internal, "failed to get saga timeline")
+		}
+		return nil, errStatus
+	}
+
+	pbEvents := make([]*pb.SagaEvent, len(events))
+	for i, event := range events {
+		pbEvents[i] = convertSagaEventToPb(event)
+	}
+
+	return &pb.GetSagaTimelineResponse{
+		SagaId: sagaID.String(),
+		Events: pbEvents,
 	}, nil
 }
 
+func convertMoney(m *pb.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+	return money.New(m.Amount, money.Currency(m.Currency))
+}
+
+func convertSagaEventToPb(e *saga.SagaEvent) *pb.SagaEvent {
+	event := &pb.SagaEvent{
+		Sequence:   e.Sequence,
+		Type:       e.Type,
+		StepName:   e.StepName,
+		FromStatus: e.FromStatus,
+		ToStatus:   e.ToStatus,
+		Attempt:    int32(e.Attempt),
+		Actor:      e.Actor,
+		Error:      e.Error,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+	if e.StepID != nil {
+		event.StepId = e.StepID.String()
+	}
+	return event
+}
+
 func convertSagaTransactionToPb(s *saga.SagaResponse) *pb.SagaTransaction {
 	steps := make([]*pb.SagaStep, len(s.Steps))
 	for i, step := range s.Steps {
@@ -148,13 +236,16 @@ func convertSagaTransactionToPb(s *saga.SagaResponse) *pb.SagaTransaction {
 			Payload:            payload,
 			ErrorMessage:       step.ErrorMessage,
 			ExecutedAt:         timestamppb.New(step.ExecutedAt),
+			Retries:            int32(step.Retries),
 		}
 	}
 
 	return &pb.SagaTransaction{
 		Id:        s.ID.String(),
 		Type:      string(s.Type),
+		OrderId:   s.OrderID.String(),
 		Status:    string(s.Status),
+		Error:     s.Error,
 		Steps:     steps,
 		Metadata:  s.Metadata,
 		CreatedAt: timestamppb.New(s.CreatedAt),
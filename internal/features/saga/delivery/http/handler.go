@@ -1,9 +1,12 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
 	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/errors"
 	httpresponse "github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
@@ -49,3 +52,68 @@ func (h *SagaHandler) GetSagaStatus(c *fiber.Ctx) error {
 
 	return httpresponse.OK(c, "Saga status retrieved successfully", status)
 }
+
+// GetSagaTimeline handles GET /sagas/:id/timeline request
+func (h *SagaHandler) GetSagaTimeline(c *fiber.Ctx) error {
+	sagaID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return h.errorHandler.Handle(c, errors.NewValidationError("Invalid saga ID"))
+	}
+
+	events, err := h.sagaUsecase.GetSagaTimeline(c.Context(), sagaID)
+	if err != nil {
+		if err == saga.ErrNotFound {
+			return h.errorHandler.Handle(c, errors.NewNotFoundError("Saga not found"))
+		}
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+
+	return httpresponse.OK(c, "Saga timeline retrieved successfully", fiber.Map{
+		"saga_id": sagaID,
+		"events":  events,
+	})
+}
+
+// ListSagas handles GET /sagas request
+func (h *SagaHandler) ListSagas(c *fiber.Ctx) error {
+	filter := saga.ListFilter{
+		Status:   c.Query("status"),
+		Type:     saga.Type(c.Query("type")),
+		SortBy:   c.Query("sort_by"),
+		SortDesc: c.Query("sort_order") == "desc",
+		Cursor:   c.Query("cursor"),
+		Limit:    c.QueryInt("limit"),
+	}
+
+	if orderID := c.Query("order_id"); orderID != "" {
+		id, err := uuid.Parse(orderID)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid order ID"))
+		}
+		filter.OrderID = &id
+	}
+	if from := c.Query("created_from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid created_from, expected RFC3339"))
+		}
+		filter.CreatedFrom = &t
+	}
+	if to := c.Query("created_to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid created_to, expected RFC3339"))
+		}
+		filter.CreatedTo = &t
+	}
+
+	result, err := h.sagaUsecase.ListSagaTransactions(c.Context(), filter)
+	if err != nil {
+		if err == saga.ErrInvalidFilter {
+			return h.errorHandler.Handle(c, errors.NewValidationError("Invalid sort field or cursor"))
+		}
+		return h.errorHandler.Handle(c, errors.NewInternalError(err))
+	}
+
+	return httpresponse.OK(c, "Sagas retrieved successfully", result)
+}
@@ -9,6 +9,8 @@ func RegisterRoutes(router fiber.Router, handler *SagaHandler, authMiddleware fi
 	sagas := router.Group("/sagas")
 	sagas.Use(authMiddleware)
 
+	sagas.Get("/", handler.ListSagas)
 	sagas.Post("/order-payment", handler.StartOrderPaymentSaga)
 	sagas.Get("/:id", handler.GetSagaStatus)
+	sagas.Get("/:id/timeline", handler.GetSagaTimeline)
 }
@@ -0,0 +1,255 @@
+package definition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+var (
+	ErrUnknownSagaType   = errors.New("unknown saga type")
+	ErrUnknownStep       = errors.New("unknown saga step")
+	ErrDuplicateSagaType = errors.New("saga type already registered")
+	ErrMissingAction     = errors.New("saga step has no action handler")
+)
+
+// StepCommand is the message exchanged for a single step execution or
+// compensation. The orchestrator publishes it and the worker consumes it.
+// MessageID stays the same when the command is redelivered.
+type StepCommand struct {
+	MessageID uuid.UUID       `json:"message_id"`
+	SagaID    uuid.UUID       `json:"saga_id"`
+	OrderID   uuid.UUID       `json:"order_id"`
+	Step      entity.SagaStep `json:"step"`
+}
+
+// StepHandler executes or compensates a single saga step
+type StepHandler func(ctx context.Context, cmd *StepCommand) error
+
+// CompletionHandler runs once every step of a saga has succeeded, before the
+// saga is stored as COMPLETED. It may run again if storing the saga fails.
+type CompletionHandler func(ctx context.Context, saga *entity.Saga) error
+
+// RetryPolicy describes how a failed step is retried before compensation
+type RetryPolicy struct {
+	MaxAttempts     int           `json:"max_attempts"`
+	InitialInterval time.Duration `json:"initial_interval"`
+	MaxInterval     time.Duration `json:"max_interval"`
+}
+
+// DefaultRetryPolicy mirrors the saga.retry defaults in config.yaml
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:     3,
+	InitialInterval: time.Second,
+	MaxInterval:     30 * time.Second,
+}
+
+// StepDefinition declares a single step of a saga
+type StepDefinition struct {
+	Name         entity.StepType
+	Action       StepHandler
+	Compensation StepHandler
+	Timeout      time.Duration
+	Retry        RetryPolicy
+	// defaultRetry is set when the step declares no retry policy and follows
+	// the default policy of the registry
+	defaultRetry bool
+}
+
+// HasCompensation reports whether the step can be undone
+func (s *StepDefinition) HasCompensation() bool {
+	return s.Compensation != nil
+}
+
+// SagaDefinition declares a saga type as an ordered list of steps
+type SagaDefinition struct {
+	Type       entity.SagaType
+	Steps      []StepDefinition
+	OnComplete CompletionHandler
+}
+
+// Complete runs the completion handler of the saga, if any
+func (d *SagaDefinition) Complete(ctx context.Context, saga *entity.Saga) error {
+	if d.OnComplete == nil {
+		return nil
+	}
+	return d.OnComplete(ctx, saga)
+}
+
+// FirstStep returns the first step of the saga
+func (d *SagaDefinition) FirstStep() *StepDefinition {
+	if len(d.Steps) == 0 {
+		return nil
+	}
+	return &d.Steps[0]
+}
+
+// Step returns the definition of the named step
+func (d *SagaDefinition) Step(name entity.StepType) (*StepDefinition, error) {
+	for i := range d.Steps {
+		if d.Steps[i].Name == name {
+			return &d.Steps[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s in %s", ErrUnknownStep, name, d.Type)
+}
+
+// NextStep returns the step following the named step, or nil if it is the last one
+func (d *SagaDefinition) NextStep(name entity.StepType) (*StepDefinition, error) {
+	for i := range d.Steps {
+		if d.Steps[i].Name != name {
+			continue
+		}
+		if i+1 < len(d.Steps) {
+			return &d.Steps[i+1], nil
+		}
+		return nil, nil
+	}
+	return nil, fmt.Errorf("%w: %s in %s", ErrUnknownStep, name, d.Type)
+}
+
+// NewSteps creates the pending saga steps for the definition, all carrying the same payload
+func (d *SagaDefinition) NewSteps(payload []byte) []entity.SagaStep {
+	steps := make([]entity.SagaStep, len(d.Steps))
+	for i, step := range d.Steps {
+		steps[i] = entity.SagaStep{
+			Name:    step.Name,
+			Payload: payload,
+		}
+	}
+	return steps
+}
+
+// Registry holds the saga definitions known to a process.
+// Register, Bind and SetDefaultRetryPolicy are meant to be called during startup only.
+type Registry struct {
+	definitions  map[entity.SagaType]*SagaDefinition
+	types        []entity.SagaType
+	defaultRetry RetryPolicy
+}
+
+// NewRegistry creates a registry with all built-in saga definitions
+func NewRegistry() *Registry {
+	r := &Registry{
+		definitions:  make(map[entity.SagaType]*SagaDefinition),
+		defaultRetry: DefaultRetryPolicy,
+	}
+	for _, def := range builtinDefinitions() {
+		if err := r.Register(def); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Register adds a saga definition to the registry
+func (r *Registry) Register(def SagaDefinition) error {
+	if _, ok := r.definitions[def.Type]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateSagaType, def.Type)
+	}
+	steps := make([]StepDefinition, len(def.Steps))
+	copy(steps, def.Steps)
+	for i := range steps {
+		if steps[i].Retry == (RetryPolicy{}) {
+			steps[i].Retry = r.defaultRetry
+			steps[i].defaultRetry = true
+		}
+	}
+	def.Steps = steps
+
+	r.definitions[def.Type] = &def
+	r.types = append(r.types, def.Type)
+	return nil
+}
+
+// Bind attaches the action and compensation handlers for a step type to
+// every saga definition containing that step
+func (r *Registry) Bind(name entity.StepType, action, compensation StepHandler) {
+	for _, def := range r.definitions {
+		for i := range def.Steps {
+			if def.Steps[i].Name == name {
+				def.Steps[i].Action = action
+				def.Steps[i].Compensation = compensation
+			}
+		}
+	}
+}
+
+// OnComplete sets the handler run when a saga of the given type completes
+func (r *Registry) OnComplete(sagaType entity.SagaType, handler CompletionHandler) {
+	if def, ok := r.definitions[sagaType]; ok {
+		def.OnComplete = handler
+	}
+}
+
+// SetDefaultRetryPolicy replaces the retry policy of every step that does not
+// declare its own, typically with the saga.retry section of the config
+func (r *Registry) SetDefaultRetryPolicy(policy RetryPolicy) {
+	for _, def := range r.definitions {
+		for i := range def.Steps {
+			if def.Steps[i].defaultRetry {
+				def.Steps[i].Retry = policy
+			}
+		}
+	}
+	r.defaultRetry = policy
+}
+
+// Get returns the definition of a saga type
+func (r *Registry) Get(sagaType entity.SagaType) (*SagaDefinition, error) {
+	def, ok := r.definitions[sagaType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSagaType, sagaType)
+	}
+	return def, nil
+}
+
+// Definitions returns all registered definitions in registration order
+func (r *Registry) Definitions() []*SagaDefinition {
+	defs := make([]*SagaDefinition, len(r.types))
+	for i, t := range r.types {
+		defs[i] = r.definitions[t]
+	}
+	return defs
+}
+
+// Steps returns every distinct step across all definitions, in declaration order
+func (r *Registry) Steps() []*StepDefinition {
+	seen := make(map[entity.StepType]bool)
+	var steps []*StepDefinition
+	for _, def := range r.Definitions() {
+		for i := range def.Steps {
+			if seen[def.Steps[i].Name] {
+				continue
+			}
+			seen[def.Steps[i].Name] = true
+			steps = append(steps, &def.Steps[i])
+		}
+	}
+	return steps
+}
+
+// Validate checks that every registered step has an action handler bound
+func (r *Registry) Validate() error {
+	for _, step := range r.Steps() {
+		if step.Action == nil {
+			return fmt.Errorf("%w: %s", ErrMissingAction, step.Name)
+		}
+	}
+	return nil
+}
+
+// StepTopic returns the topic on which step commands are published
+func StepTopic(name entity.StepType) string {
+	return fmt.Sprintf("saga.%s", name)
+}
+
+// CompensationTopic returns the topic on which compensation commands are published
+func CompensationTopic(name entity.StepType) string {
+	return fmt.Sprintf("saga.compensation.%s", name)
+}
@@ -0,0 +1,117 @@
+package definition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+func TestSagaDefinition_NextStep(t *testing.T) {
+	def := OrderPayment()
+
+	next, err := def.NextStep(entity.StepCreateOrder)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StepProcessPayment, next.Name)
+
+	next, err = def.NextStep(entity.StepProcessPayment)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StepUpdateInventory, next.Name)
+
+	next, err = def.NextStep(entity.StepUpdateInventory)
+	require.NoError(t, err)
+	assert.Nil(t, next)
+
+	_, err = def.NextStep("UNKNOWN")
+	assert.ErrorIs(t, err, ErrUnknownStep)
+}
+
+func TestRegistry_BindAndValidate(t *testing.T) {
+	r := NewRegistry()
+	assert.ErrorIs(t, r.Validate(), ErrMissingAction)
+
+	noop := func(ctx context.Context, cmd *StepCommand) error { return nil }
+	r.Bind(entity.StepCreateOrder, noop, nil)
+	r.Bind(entity.StepProcessPayment, noop, noop)
+	r.Bind(entity.StepUpdateInventory, noop, noop)
+	assert.ErrorIs(t, r.Validate(), ErrMissingAction)
+
+	r.Bind(entity.StepAcceptReturn, noop, noop)
+	r.Bind(entity.StepRestockReturn, noop, noop)
+	r.Bind(entity.StepRefundReturn, noop, nil)
+	require.NoError(t, r.Validate())
+
+	def, err := r.Get(entity.SagaTypeOrderPayment)
+	require.NoError(t, err)
+	step, err := def.Step(entity.StepCreateOrder)
+	require.NoError(t, err)
+	assert.False(t, step.HasCompensation())
+
+	// Bound handlers must not leak into freshly declared definitions
+	assert.Nil(t, OrderPayment().Steps[0].Action)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := NewRegistry()
+	assert.ErrorIs(t, r.Register(OrderPayment()), ErrDuplicateSagaType)
+
+	_, err := r.Get("UNKNOWN")
+	assert.ErrorIs(t, err, ErrUnknownSagaType)
+
+	steps := r.Steps()
+	require.Len(t, steps, 6)
+	assert.Equal(t, "saga.CREATE_ORDER", StepTopic(steps[0].Name))
+	assert.Equal(t, "saga.compensation.CREATE_ORDER", CompensationTopic(steps[0].Name))
+	assert.Equal(t, entity.StepAcceptReturn, steps[3].Name)
+}
+
+func TestRegistry_OnComplete(t *testing.T) {
+	r := NewRegistry()
+	def, err := r.Get(entity.SagaTypeOrderPayment)
+	require.NoError(t, err)
+
+	// A saga without a completion handler completes as is
+	saga := entity.NewSaga(def.Type, uuid.New(), def.NewSteps(nil))
+	require.NoError(t, def.Complete(context.Background(), saga))
+
+	var completed uuid.UUID
+	r.OnComplete(entity.SagaTypeOrderPayment, func(ctx context.Context, saga *entity.Saga) error {
+		completed = saga.OrderID
+		return nil
+	})
+	require.NoError(t, def.Complete(context.Background(), saga))
+	assert.Equal(t, saga.OrderID, completed)
+}
+
+func TestRegistry_SetDefaultRetryPolicy(t *testing.T) {
+	r := NewRegistry()
+	// A step declaring the default policy explicitly keeps it
+	require.NoError(t, r.Register(SagaDefinition{
+		Type: "EXPLICIT",
+		Steps: []StepDefinition{
+			{Name: "DECLARED", Retry: DefaultRetryPolicy},
+			{Name: "INHERITED"},
+		},
+	}))
+
+	policy := RetryPolicy{MaxAttempts: 5, InitialInterval: time.Millisecond, MaxInterval: time.Second}
+	r.SetDefaultRetryPolicy(policy)
+
+	def, err := r.Get("EXPLICIT")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRetryPolicy, def.Steps[0].Retry)
+	assert.Equal(t, policy, def.Steps[1].Retry)
+
+	// Steps registered later follow the new default
+	require.NoError(t, r.Register(SagaDefinition{Type: "LATER", Steps: []StepDefinition{{Name: "INHERITED"}}}))
+	def, err = r.Get("LATER")
+	require.NoError(t, err)
+	assert.Equal(t, policy, def.Steps[0].Retry)
+	r.SetDefaultRetryPolicy(DefaultRetryPolicy)
+	assert.Equal(t, DefaultRetryPolicy, def.Steps[0].Retry)
+}
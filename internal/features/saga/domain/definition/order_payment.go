@@ -0,0 +1,39 @@
+package definition
+
+import (
+	"time"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+// builtinDefinitions lists every saga flow the system knows about.
+// New flows are declared in their own file and added here.
+func builtinDefinitions() []SagaDefinition {
+	return []SagaDefinition{
+		OrderPayment(),
+		OrderReturn(),
+	}
+}
+
+// OrderPayment declares the order-payment saga: confirm the order, charge
+// the customer and then update the inventory. Steps use the registry's
+// default retry policy.
+func OrderPayment() SagaDefinition {
+	return SagaDefinition{
+		Type: entity.SagaTypeOrderPayment,
+		Steps: []StepDefinition{
+			{
+				Name:    entity.StepCreateOrder,
+				Timeout: 30 * time.Second,
+			},
+			{
+				Name:    entity.StepProcessPayment,
+				Timeout: time.Minute,
+			},
+			{
+				Name:    entity.StepUpdateInventory,
+				Timeout: 30 * time.Second,
+			},
+		},
+	}
+}
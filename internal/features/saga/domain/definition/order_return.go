@@ -0,0 +1,48 @@
+package definition
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+// ReturnPayload is carried by every step of an order-return saga
+type ReturnPayload struct {
+	ReturnID uuid.UUID `json:"return_id"`
+}
+
+// ParseReturnPayload reads the payload of an order-return saga step
+func ParseReturnPayload(step entity.SagaStep) (ReturnPayload, error) {
+	var payload ReturnPayload
+	if err := json.Unmarshal(step.Payload, &payload); err != nil {
+		return ReturnPayload{}, Permanent(fmt.Errorf("invalid return payload: %w", err))
+	}
+	return payload, nil
+}
+
+// OrderReturn declares the order-return saga: take the approved return on,
+// put its items back in stock and then refund the customer. A failed refund
+// takes the items out of stock again and fails the return.
+func OrderReturn() SagaDefinition {
+	return SagaDefinition{
+		Type: entity.SagaTypeOrderReturn,
+		Steps: []StepDefinition{
+			{
+				Name:    entity.StepAcceptReturn,
+				Timeout: 30 * time.Second,
+			},
+			{
+				Name:    entity.StepRestockReturn,
+				Timeout: 30 * time.Second,
+			},
+			{
+				Name:    entity.StepRefundReturn,
+				Timeout: time.Minute,
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package definition
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// permanentError marks a step failure that must not be retried
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as non-retryable, so the step is compensated immediately
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsRetryable reports whether a step failure is transient.
+// Errors are transient unless marked with Permanent or caused by cancellation.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+	return !errors.Is(err, context.Canceled)
+}
+
+// CanRetry reports whether another attempt is allowed after the given number of retries
+func (p RetryPolicy) CanRetry(retries int) bool {
+	return retries+1 < p.MaxAttempts
+}
+
+// Backoff returns the jittered delay before the given retry (1-based).
+// The delay doubles with every retry up to MaxInterval and is then
+// randomised to between half and all of that value.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	if p.InitialInterval <= 0 {
+		return 0
+	}
+
+	delay := p.InitialInterval
+	for i := 1; i < retry; i++ {
+		delay *= 2
+		if p.MaxInterval > 0 && delay >= p.MaxInterval {
+			delay = p.MaxInterval
+			break
+		}
+	}
+	if p.MaxInterval > 0 && delay > p.MaxInterval {
+		delay = p.MaxInterval
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
@@ -0,0 +1,57 @@
+package definition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: time.Second,
+		MaxInterval:     5 * time.Second,
+	}
+
+	tests := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{retry: 1, max: time.Second},
+		{retry: 2, max: 2 * time.Second},
+		{retry: 3, max: 4 * time.Second},
+		{retry: 4, max: 5 * time.Second},
+		{retry: 10, max: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("retry %d", tt.retry), func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				delay := policy.Backoff(tt.retry)
+				assert.GreaterOrEqual(t, delay, tt.max/2)
+				assert.LessOrEqual(t, delay, tt.max)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_CanRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3}
+
+	assert.True(t, policy.CanRetry(0))
+	assert.True(t, policy.CanRetry(1))
+	assert.False(t, policy.CanRetry(2))
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.False(t, IsRetryable(nil))
+	assert.True(t, IsRetryable(errors.New("connection refused")))
+	assert.True(t, IsRetryable(context.DeadlineExceeded))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(Permanent(errors.New("order not found"))))
+	assert.False(t, IsRetryable(fmt.Errorf("step failed: %w", Permanent(errors.New("invalid order status")))))
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SagaEventType represents a transition recorded in the saga event log
+type SagaEventType string
+
+const (
+	EventSagaCreated             SagaEventType = "SAGA_CREATED"
+	EventSagaStatusChanged       SagaEventType = "SAGA_STATUS_CHANGED"
+	EventStepStarted             SagaEventType = "STEP_STARTED"
+	EventStepSucceeded           SagaEventType = "STEP_SUCCEEDED"
+	EventStepFailed              SagaEventType = "STEP_FAILED"
+	EventStepTimedOut            SagaEventType = "STEP_TIMED_OUT"
+	EventStepRetryScheduled      SagaEventType = "STEP_RETRY_SCHEDULED"
+	EventStepCompensationStarted SagaEventType = "STEP_COMPENSATION_STARTED"
+	EventStepCompensated         SagaEventType = "STEP_COMPENSATED"
+	EventStepCompensationFailed  SagaEventType = "STEP_COMPENSATION_FAILED"
+	EventStepStatusChanged       SagaEventType = "STEP_STATUS_CHANGED"
+)
+
+// SagaEvent is an entry of the append-only saga event log. Events are recorded
+// by the Saga methods changing its state and stored when the saga is saved.
+type SagaEvent struct {
+	ID         uuid.UUID     `json:"id" gorm:"type:uuid;primary_key"`
+	Sequence   int64         `json:"sequence" gorm:"autoIncrement;not null;<-:false"`
+	SagaID     uuid.UUID     `json:"saga_id" gorm:"type:uuid;not null"`
+	StepID     *uuid.UUID    `json:"step_id,omitempty" gorm:"type:uuid"`
+	StepName   StepType      `json:"step_name,omitempty" gorm:"type:varchar(255)"`
+	Type       SagaEventType `json:"type" gorm:"type:varchar(50);not null"`
+	FromStatus string        `json:"from_status,omitempty" gorm:"type:varchar(50)"`
+	ToStatus   string        `json:"to_status,omitempty" gorm:"type:varchar(50)"`
+	Attempt    int           `json:"attempt,omitempty" gorm:"not null;default:0"`
+	Actor      string        `json:"actor" gorm:"type:varchar(100);not null"`
+	Error      string        `json:"error,omitempty" gorm:"type:text"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// TableName returns the saga event table name
+func (SagaEvent) TableName() string {
+	return "saga_events"
+}
+
+// stepEventType returns the event recorded when a step moves to status
+func stepEventType(status StepStatus) SagaEventType {
+	switch status {
+	case StepStatusSuccess, StepStatusCompleted:
+		return EventStepSucceeded
+	case StepStatusFailed:
+		return EventStepFailed
+	case StepStatusTimedOut:
+		return EventStepTimedOut
+	case StepStatusCompensated:
+		return EventStepCompensated
+	case StepStatusCompensationFailed:
+		return EventStepCompensationFailed
+	default:
+		return EventStepStatusChanged
+	}
+}
@@ -15,6 +15,7 @@ const (
 	SagaStatusCompleted    SagaStatus = "COMPLETED"
 	SagaStatusFailed       SagaStatus = "FAILED"
 	SagaStatusCompensating SagaStatus = "COMPENSATING"
+	SagaStatusCompensated  SagaStatus = "COMPENSATED"
 )
 
 // StepStatus represents the status of a saga step
@@ -22,11 +23,16 @@ type StepStatus string
 
 const (
 	StepStatusPending     StepStatus = "PENDING"
+	StepStatusRetrying    StepStatus = "RETRYING"
 	StepStatusSuccess     StepStatus = "SUCCESS"
 	StepStatusFailed      StepStatus = "FAILED"
 	StepStatusCancelled   StepStatus = "CANCELLED"
 	StepStatusCompleted   StepStatus = "COMPLETED"
 	StepStatusCompensated StepStatus = "COMPENSATED"
+	StepStatusTimedOut    StepStatus = "TIMED_OUT"
+	// StepStatusCompensationFailed is reported for a failed compensation, and
+	// kept by a step whose compensation failed for good
+	StepStatusCompensationFailed StepStatus = "COMPENSATION_FAILED"
 )
 
 // StepType represents the type of step in the saga
@@ -36,6 +42,9 @@ const (
 	StepCreateOrder     StepType = "CREATE_ORDER"
 	StepProcessPayment  StepType = "PROCESS_PAYMENT"
 	StepUpdateInventory StepType = "UPDATE_INVENTORY"
+	StepAcceptReturn    StepType = "ACCEPT_RETURN"
+	StepRestockReturn   StepType = "RESTOCK_RETURN"
+	StepRefundReturn    StepType = "REFUND_RETURN"
 )
 
 // SagaType represents the type of saga
@@ -43,33 +52,71 @@ type SagaType string
 
 const (
 	SagaTypeOrderPayment SagaType = "ORDER_PAYMENT"
+	SagaTypeOrderReturn  SagaType = "ORDER_RETURN"
+)
+
+// SagaExecutor tells which component drives a saga
+type SagaExecutor string
+
+const (
+	// SagaExecutorInProcess sagas run their steps inside SagaUsecase
+	SagaExecutorInProcess SagaExecutor = "IN_PROCESS"
+	// SagaExecutorMessaging sagas send their steps to the workers through the outbox
+	SagaExecutorMessaging SagaExecutor = "MESSAGING"
 )
 
 // SagaStep represents a step in the saga
 type SagaStep struct {
-	ID           uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	SagaID       uuid.UUID  `json:"saga_id" gorm:"type:uuid;not null"`
-	Name         StepType   `json:"name" gorm:"type:varchar(255);not null"`
-	Status       StepStatus `json:"status" gorm:"type:varchar(50);not null"`
-	Order        int        `json:"order" gorm:"not null"`
-	Payload      []byte     `json:"payload" gorm:"type:jsonb"`
-	ErrorMessage string     `json:"error_message,omitempty" gorm:"type:text"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
-}
-
-// Saga represents a distributed transaction
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	SagaID        uuid.UUID  `json:"saga_id" gorm:"type:uuid;not null"`
+	Name          StepType   `json:"name" gorm:"type:varchar(255);not null"`
+	Status        StepStatus `json:"status" gorm:"type:varchar(50);not null"`
+	Order         int        `json:"order" gorm:"not null"`
+	Payload       []byte     `json:"payload" gorm:"type:jsonb"`
+	ErrorMessage  string     `json:"error_message,omitempty" gorm:"type:text"`
+	Retries       int        `json:"retries" gorm:"not null;default:0"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeadlineAt    *time.Time `json:"deadline_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// IsRetryDue checks if a retried step may be attempted again
+func (s *SagaStep) IsRetryDue() bool {
+	return s.NextAttemptAt == nil || !time.Now().Before(*s.NextAttemptAt)
+}
+
+// IsPastDeadline checks if a started step has not finished within its deadline
+func (s *SagaStep) IsPastDeadline() bool {
+	return s.Status == StepStatusPending && s.DeadlineAt != nil && time.Now().After(*s.DeadlineAt)
+}
+
+// Saga represents a distributed transaction. It is the single persisted saga
+// model, driven either in-process by SagaUsecase or through messages by SagaOrchestrator.
 type Saga struct {
-	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	Type      SagaType   `json:"type" gorm:"type:varchar(50);not null"`
-	Status    SagaStatus `json:"status" gorm:"type:varchar(50);not null"`
-	Steps     []SagaStep `json:"steps" gorm:"foreignKey:SagaID"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	ID         uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Type       SagaType      `json:"type" gorm:"type:varchar(50);not null"`
+	OrderID    uuid.UUID     `json:"order_id" gorm:"type:uuid;not null"`
+	Status     SagaStatus    `json:"status" gorm:"type:varchar(50);not null"`
+	Executor   SagaExecutor  `json:"executor" gorm:"type:varchar(20);not null;default:IN_PROCESS"`
+	Steps      []SagaStep    `json:"steps" gorm:"foreignKey:SagaID"`
+	Error      string        `json:"error,omitempty" gorm:"type:text"`
+	Timeout    time.Duration `json:"timeout" gorm:"not null"` // deadline of steps whose definition sets none
+	MaxRetries int           `json:"max_retries" gorm:"not null"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+
+	// The lease is only written on create; afterwards it is taken, renewed and
+	// released through the repository so that saving the saga never overwrites it
+	LeaseOwner     string     `json:"lease_owner,omitempty" gorm:"type:varchar(255);<-:create"`
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty" gorm:"<-:create"`
+
+	// events holds the transitions not yet written to the event log
+	events []SagaEvent
 }
 
 // NewSaga creates a new saga
-func NewSaga(sagaType SagaType, steps []SagaStep) *Saga {
+func NewSaga(sagaType SagaType, orderID uuid.UUID, steps []SagaStep) *Saga {
 	now := time.Now()
 	sagaID := uuid.New()
 
@@ -83,64 +130,131 @@ func NewSaga(sagaType SagaType, steps []SagaStep) *Saga {
 		steps[i].UpdatedAt = now
 	}
 
-	return &Saga{
-		ID:        sagaID,
-		Type:      sagaType,
-		Status:    SagaStatusPending,
-		Steps:     steps,
-		CreatedAt: now,
-		UpdatedAt: now,
+	saga := &Saga{
+		ID:         sagaID,
+		Type:       sagaType,
+		OrderID:    orderID,
+		Status:     SagaStatusPending,
+		Executor:   SagaExecutorInProcess,
+		Steps:      steps,
+		Timeout:    5 * time.Minute,
+		MaxRetries: 3,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	saga.record(SagaEvent{Type: EventSagaCreated, ToStatus: string(SagaStatusPending)})
+	return saga
+}
+
+// StartStep records that an attempt of a step has been started and must
+// finish within timeout. A zero timeout leaves the attempt without deadline.
+func (s *Saga) StartStep(stepID uuid.UUID, timeout time.Duration) {
+	step := s.GetStepByID(stepID)
+	if step == nil {
+		return
+	}
+
+	step.DeadlineAt = nil
+	if timeout > 0 {
+		deadline := time.Now().Add(timeout)
+		step.DeadlineAt = &deadline
+	}
+	s.recordStep(step, EventStepStarted, "", "", "")
+}
+
+// StartCompensation records that the compensation of a step has been started
+func (s *Saga) StartCompensation(stepID uuid.UUID) {
+	if step := s.GetStepByID(stepID); step != nil {
+		step.NextAttemptAt = nil
+		s.recordStep(step, EventStepCompensationStarted, "", "", "")
 	}
 }
 
+// ScheduleCompensationRetry records a failed compensation of a step and when
+// it is started again
+func (s *Saga) ScheduleCompensationRetry(stepID uuid.UUID, nextAttemptAt time.Time, errorMessage string) {
+	step := s.GetStepByID(stepID)
+	if step == nil {
+		return
+	}
+
+	s.recordStep(step, EventStepCompensationFailed, step.Status, step.Status, errorMessage)
+	step.Retries++
+	step.NextAttemptAt = &nextAttemptAt
+	step.ErrorMessage = errorMessage
+	step.UpdatedAt = time.Now()
+	s.UpdatedAt = time.Now()
+}
+
 // UpdateStepStatus updates the status of a step
 func (s *Saga) UpdateStepStatus(stepID uuid.UUID, status StepStatus, errorMessage string) {
 	for i := range s.Steps {
 		if s.Steps[i].ID == stepID {
+			if s.Steps[i].Status != status {
+				s.recordStep(&s.Steps[i], stepEventType(status), s.Steps[i].Status, status, errorMessage)
+			}
 			s.Steps[i].Status = status
 			s.Steps[i].ErrorMessage = errorMessage
 			s.Steps[i].UpdatedAt = time.Now()
 			break
 		}
 	}
-	s.UpdateStatus()
+	s.UpdatedAt = time.Now()
 }
 
-// UpdateStatus updates the saga status based on step statuses
-func (s *Saga) UpdateStatus() {
-	allCompleted := true
-	anyFailed := false
-	anyCompensated := false
-
-	for _, step := range s.Steps {
-		if step.Status == StepStatusFailed {
-			anyFailed = true
+// ScheduleStepRetry records a failed attempt of a step and when it runs next
+func (s *Saga) ScheduleStepRetry(stepID uuid.UUID, status StepStatus, nextAttemptAt time.Time, errorMessage string) {
+	for i := range s.Steps {
+		if s.Steps[i].ID == stepID {
+			s.recordStep(&s.Steps[i], EventStepRetryScheduled, s.Steps[i].Status, status, errorMessage)
+			s.Steps[i].Status = status
+			s.Steps[i].Retries++
+			s.Steps[i].NextAttemptAt = &nextAttemptAt
+			s.Steps[i].ErrorMessage = errorMessage
+			s.Steps[i].UpdatedAt = time.Now()
 			break
 		}
-		if step.Status == StepStatusCompensated {
-			anyCompensated = true
-		}
-		if step.Status != StepStatusCompleted {
-			allCompleted = false
-		}
 	}
+	s.UpdatedAt = time.Now()
+}
 
-	if anyFailed {
-		s.Status = SagaStatusFailed
-	} else if anyCompensated {
-		s.Status = SagaStatusCompensating
-	} else if allCompleted {
-		s.Status = SagaStatusCompleted
+// SetStatus sets the saga status
+func (s *Saga) SetStatus(status SagaStatus) {
+	if s.Status != status {
+		s.record(SagaEvent{
+			Type:       EventSagaStatusChanged,
+			FromStatus: string(s.Status),
+			ToStatus:   string(status),
+			Error:      s.Error,
+		})
 	}
-
+	s.Status = status
 	s.UpdatedAt = time.Now()
 }
 
-// GetNextStep gets the next pending step
+// Fail marks the saga as failed with the given error
+func (s *Saga) Fail(err error) {
+	if err != nil {
+		s.Error = err.Error()
+	}
+	s.SetStatus(SagaStatusFailed)
+}
+
+// Abort fails a saga that is stopped from outside, e.g. because its order is
+// cancelled. A started step that has not reported back is marked TIMED_OUT:
+// its outcome is unknown, so it is compensated with the completed steps.
+func (s *Saga) Abort(err error) {
+	if step := s.GetNextStep(); step != nil && step.Status == StepStatusPending && step.DeadlineAt != nil {
+		s.UpdateStepStatus(step.ID, StepStatusTimedOut, err.Error())
+	}
+	s.Fail(err)
+}
+
+// GetNextStep gets the next pending or retrying step
 func (s *Saga) GetNextStep() *SagaStep {
-	for _, step := range s.Steps {
-		if step.Status == StepStatusPending {
-			return &step
+	for i := range s.Steps {
+		if s.Steps[i].Status == StepStatusPending || s.Steps[i].Status == StepStatusRetrying {
+			return &s.Steps[i]
 		}
 	}
 	return nil
@@ -148,14 +262,53 @@ func (s *Saga) GetNextStep() *SagaStep {
 
 // GetStepByID gets a step by its ID
 func (s *Saga) GetStepByID(stepID uuid.UUID) *SagaStep {
-	for _, step := range s.Steps {
-		if step.ID == stepID {
-			return &step
+	for i := range s.Steps {
+		if s.Steps[i].ID == stepID {
+			return &s.Steps[i]
 		}
 	}
 	return nil
 }
 
+// CompensationSteps returns the steps to undo in the order they are undone:
+// completed steps, and timed out steps whose outcome is unknown
+func (s *Saga) CompensationSteps() []*SagaStep {
+	var steps []*SagaStep
+	for i := len(s.Steps) - 1; i >= 0; i-- {
+		if s.Steps[i].Status == StepStatusCompleted || s.Steps[i].Status == StepStatusTimedOut {
+			steps = append(steps, &s.Steps[i])
+		}
+	}
+	return steps
+}
+
+// DueCompensations returns the steps whose failed compensation is due to be
+// started again
+func (s *Saga) DueCompensations() []*SagaStep {
+	if s.Status != SagaStatusCompensating {
+		return nil
+	}
+	var steps []*SagaStep
+	for _, step := range s.CompensationSteps() {
+		if step.NextAttemptAt != nil && step.IsRetryDue() {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// IsCurrentAttempt checks if step is the attempt of the next step the saga is waiting for
+func (s *Saga) IsCurrentAttempt(step SagaStep) bool {
+	if s.Status != SagaStatusPending && s.Status != SagaStatusProcessing {
+		return false
+	}
+	next := s.GetNextStep()
+	return next != nil &&
+		next.ID == step.ID &&
+		next.Retries == step.Retries &&
+		next.Status == StepStatusPending
+}
+
 // IsCompleted checks if the saga is completed
 func (s *Saga) IsCompleted() bool {
 	return s.Status == SagaStatusCompleted
@@ -171,79 +324,47 @@ func (s *Saga) IsCompensating() bool {
 	return s.Status == SagaStatusCompensating
 }
 
-type SagaStepResult struct {
-	Step      SagaStep    `json:"step"`
-	Status    StepStatus  `json:"status"`
-	Error     string      `json:"error,omitempty"`
-	Timestamp time.Time   `json:"timestamp"`
-	Retries   int         `json:"retries"`
-	Metadata  interface{} `json:"metadata,omitempty"`
-}
-
-type SagaTransaction struct {
-	ID                uuid.UUID        `json:"id"`
-	OrderID           uuid.UUID        `json:"order_id"`
-	Status            SagaStatus       `json:"status"`
-	Steps             []SagaStepResult `json:"steps"`
-	CompensationSteps []SagaStep       `json:"compensation_steps"`
-	CurrentStep       SagaStep         `json:"current_step"`
-	Error             string           `json:"error,omitempty"`
-	CreatedAt         time.Time        `json:"created_at"`
-	UpdatedAt         time.Time        `json:"updated_at"`
-	Timeout           time.Duration    `json:"timeout"`
-	MaxRetries        int              `json:"max_retries"`
-}
-
-func NewSagaTransaction(orderID uuid.UUID) *SagaTransaction {
-	return &SagaTransaction{
-		ID:                uuid.New(),
-		OrderID:           orderID,
-		Status:            SagaStatusPending,
-		Steps:             make([]SagaStepResult, 0),
-		CompensationSteps: make([]SagaStep, 0),
-		CurrentStep:       SagaStep{Name: StepCreateOrder, Status: StepStatusPending},
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
-		Timeout:           5 * time.Minute,
-		MaxRetries:        3,
-	}
-}
-
-func (s *SagaTransaction) AddStepResult(step SagaStep, status StepStatus, err error) {
-	result := SagaStepResult{
-		Step:      step,
-		Status:    status,
-		Timestamp: time.Now(),
-	}
-	if err != nil {
-		result.Error = err.Error()
-	}
-	s.Steps = append(s.Steps, result)
-	s.UpdatedAt = time.Now()
+// Events returns the transitions recorded since the saga was loaded or last saved
+func (s *Saga) Events() []SagaEvent {
+	return s.events
 }
 
-func (s *SagaTransaction) AddCompensationStep(step SagaStep) {
-	s.CompensationSteps = append(s.CompensationSteps, step)
+// ClearEvents forgets the recorded transitions once they are stored
+func (s *Saga) ClearEvents() {
+	s.events = nil
 }
 
-func (s *SagaTransaction) UpdateStatus(status SagaStatus) {
-	s.Status = status
-	s.UpdatedAt = time.Now()
-}
-
-func (s *SagaTransaction) SetCurrentStep(step SagaStep) {
-	s.CurrentStep = step
-	s.UpdatedAt = time.Now()
+// record appends an event to the transitions not yet stored
+func (s *Saga) record(event SagaEvent) {
+	event.ID = uuid.New()
+	event.SagaID = s.ID
+	event.CreatedAt = time.Now()
+	s.events = append(s.events, event)
 }
 
-func (s *SagaTransaction) IsCompleted() bool {
-	return s.Status == SagaStatusCompleted
+// recordStep appends an event about an attempt of a step
+func (s *Saga) recordStep(step *SagaStep, eventType SagaEventType, from, to StepStatus, errorMessage string) {
+	stepID := step.ID
+	event := SagaEvent{
+		StepID:     &stepID,
+		StepName:   step.Name,
+		Type:       eventType,
+		FromStatus: string(from),
+		ToStatus:   string(to),
+		Attempt:    step.Retries + 1,
+		Error:      errorMessage,
+	}
+	s.record(event)
 }
 
-func (s *SagaTransaction) IsFailed() bool {
-	return s.Status == SagaStatusFailed
+// Lease marks the saga as run by owner until ttl has passed
+func (s *Saga) Lease(owner string, ttl time.Duration) {
+	expiresAt := time.Now().Add(ttl)
+	s.LeaseOwner = owner
+	s.LeaseExpiresAt = &expiresAt
 }
 
-func (s *SagaTransaction) IsTimeout() bool {
-	return time.Since(s.UpdatedAt) > s.Timeout
+// IsLeased checks if some instance holds an unexpired lease on the saga
+func (s *Saga) IsLeased() bool {
+	return s.LeaseExpiresAt != nil && time.Now().Before(*s.LeaseExpiresAt)
 }
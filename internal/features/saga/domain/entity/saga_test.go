@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSaga() *Saga {
+	return NewSaga(SagaTypeOrderPayment, uuid.New(), []SagaStep{
+		{Name: StepCreateOrder},
+		{Name: StepProcessPayment},
+		{Name: StepUpdateInventory},
+	})
+}
+
+func TestSaga_CompensationSteps(t *testing.T) {
+	saga := newTestSaga()
+	saga.UpdateStepStatus(saga.Steps[0].ID, StepStatusCompleted, "")
+	saga.UpdateStepStatus(saga.Steps[1].ID, StepStatusCompleted, "")
+	saga.UpdateStepStatus(saga.Steps[2].ID, StepStatusFailed, "out of stock")
+
+	steps := saga.CompensationSteps()
+	require.Len(t, steps, 2)
+	assert.Equal(t, StepProcessPayment, steps[0].Name)
+	assert.Equal(t, StepCreateOrder, steps[1].Name)
+}
+
+func TestSaga_IsCurrentAttempt(t *testing.T) {
+	saga := newTestSaga()
+	saga.SetStatus(SagaStatusProcessing)
+	first := saga.Steps[0]
+
+	assert.True(t, saga.IsCurrentAttempt(first))
+	assert.False(t, saga.IsCurrentAttempt(saga.Steps[1]))
+
+	// A retry is scheduled: results of the earlier attempt are stale
+	saga.ScheduleStepRetry(first.ID, StepStatusRetrying, first.CreatedAt, "timeout")
+	assert.False(t, saga.IsCurrentAttempt(first))
+
+	// The retry is dispatched
+	saga.UpdateStepStatus(first.ID, StepStatusPending, "")
+	assert.False(t, saga.IsCurrentAttempt(first))
+	assert.True(t, saga.IsCurrentAttempt(saga.Steps[0]))
+}
+
+func TestSaga_Events(t *testing.T) {
+	saga := newTestSaga()
+	step := saga.Steps[0]
+
+	saga.SetStatus(SagaStatusProcessing)
+	saga.StartStep(step.ID, time.Minute)
+	saga.ScheduleStepRetry(step.ID, StepStatusRetrying, step.CreatedAt, "timeout")
+	saga.UpdateStepStatus(step.ID, StepStatusPending, "")
+	saga.StartStep(step.ID, time.Minute)
+	saga.UpdateStepStatus(step.ID, StepStatusCompleted, "")
+	saga.SetStatus(SagaStatusProcessing)
+
+	var types []SagaEventType
+	for _, event := range saga.Events() {
+		assert.Equal(t, saga.ID, event.SagaID)
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []SagaEventType{
+		EventSagaCreated,
+		EventSagaStatusChanged,
+		EventStepStarted,
+		EventStepRetryScheduled,
+		EventStepStatusChanged,
+		EventStepStarted,
+		EventStepSucceeded,
+	}, types)
+
+	retry := saga.Events()[3]
+	assert.Equal(t, 1, retry.Attempt)
+	assert.Equal(t, "timeout", retry.Error)
+	assert.Equal(t, 2, saga.Events()[6].Attempt)
+
+	saga.ClearEvents()
+	assert.Empty(t, saga.Events())
+}
+
+func TestSaga_IsLeased(t *testing.T) {
+	saga := newTestSaga()
+	assert.False(t, saga.IsLeased())
+
+	saga.Lease("instance-1", time.Minute)
+	assert.True(t, saga.IsLeased())
+	assert.Equal(t, "instance-1", saga.LeaseOwner)
+
+	saga.Lease("instance-1", -time.Second)
+	assert.False(t, saga.IsLeased())
+}
+
+func TestSaga_StepDeadline(t *testing.T) {
+	saga := newTestSaga()
+	step := &saga.Steps[0]
+
+	saga.StartStep(step.ID, time.Minute)
+	require.NotNil(t, step.DeadlineAt)
+	assert.False(t, step.IsPastDeadline())
+
+	saga.StartStep(step.ID, -time.Second)
+	assert.Nil(t, step.DeadlineAt)
+
+	past := time.Now().Add(-time.Second)
+	step.DeadlineAt = &past
+	assert.True(t, step.IsPastDeadline())
+
+	// Timed out steps are compensated since their outcome is unknown
+	saga.UpdateStepStatus(saga.Steps[1].ID, StepStatusCompleted, "")
+	saga.UpdateStepStatus(step.ID, StepStatusTimedOut, "step timeout")
+	assert.False(t, step.IsPastDeadline())
+	assert.Len(t, saga.CompensationSteps(), 2)
+	assert.Equal(t, EventStepTimedOut, saga.Events()[len(saga.Events())-1].Type)
+}
+
+func TestSaga_Abort(t *testing.T) {
+	saga := newTestSaga()
+	saga.SetStatus(SagaStatusProcessing)
+	saga.UpdateStepStatus(saga.Steps[0].ID, StepStatusCompleted, "")
+	saga.StartStep(saga.Steps[1].ID, time.Minute)
+
+	// The payment in flight may have been taken, so it is undone as well
+	saga.Abort(errors.New("cancelled by customer"))
+	assert.True(t, saga.IsFailed())
+	assert.Equal(t, "cancelled by customer", saga.Error)
+	assert.Equal(t, StepStatusTimedOut, saga.Steps[1].Status)
+	assert.Equal(t, StepStatusPending, saga.Steps[2].Status)
+	assert.Len(t, saga.CompensationSteps(), 2)
+}
+
+func TestSaga_DueCompensations(t *testing.T) {
+	saga := newTestSaga()
+	saga.UpdateStepStatus(saga.Steps[0].ID, StepStatusCompleted, "")
+	saga.UpdateStepStatus(saga.Steps[1].ID, StepStatusFailed, "card declined")
+	step := &saga.Steps[0]
+
+	// Only failed compensations of a compensating saga are due
+	saga.ScheduleCompensationRetry(step.ID, time.Now().Add(-time.Second), "connection refused")
+	assert.Empty(t, saga.DueCompensations())
+
+	saga.SetStatus(SagaStatusCompensating)
+	require.Len(t, saga.DueCompensations(), 1)
+	assert.Equal(t, 1, step.Retries)
+	assert.Equal(t, "connection refused", step.ErrorMessage)
+	assert.Equal(t, StepStatusCompleted, step.Status)
+	assert.Equal(t, EventStepCompensationFailed, saga.Events()[len(saga.Events())-2].Type)
+
+	saga.ScheduleCompensationRetry(step.ID, time.Now().Add(time.Minute), "connection refused")
+	assert.Empty(t, saga.DueCompensations())
+
+	// Starting the compensation again clears the schedule
+	saga.StartCompensation(step.ID)
+	assert.Nil(t, step.NextAttemptAt)
+	assert.Empty(t, saga.DueCompensations())
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 type Status string
@@ -22,16 +24,40 @@ const (
 
 // Usecase defines the saga orchestrator interface
 type Usecase interface {
-	StartOrderSaga(ctx context.Context, orderID, userID uuid.UUID, amount float64, paymentMethod string, metadata map[string]string) (*SagaResponse, error)
+	StartOrderSaga(ctx context.Context, orderID, userID uuid.UUID, amount money.Money, paymentMethod string, metadata map[string]string) (*SagaResponse, error)
 	GetSagaStatus(ctx context.Context, sagaID uuid.UUID) (*SagaResponse, error)
 	CompensateTransaction(ctx context.Context, sagaID, stepID uuid.UUID, reason string) (*SagaResponse, error)
-	ListSagaTransactions(ctx context.Context, page, limit int32, status string, sagaType Type) ([]*SagaResponse, int64, error)
+	ListSagaTransactions(ctx context.Context, filter ListFilter) (*ListResult, error)
+	GetSagaTimeline(ctx context.Context, sagaID uuid.UUID) ([]*SagaEvent, error)
+}
+
+// ListFilter selects saga transactions. Results are ordered by SortBy
+// (created_at or updated_at) and paged with the opaque Cursor of the previous page.
+type ListFilter struct {
+	Status      string
+	Type        Type
+	OrderID     *uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      string
+	SortDesc    bool
+	Cursor      string
+	Limit       int
+}
+
+// ListResult is one page of saga transactions
+type ListResult struct {
+	Sagas      []*SagaResponse `json:"sagas"`
+	Total      int64           `json:"total"`
+	NextCursor string          `json:"next_cursor,omitempty"`
 }
 
 type SagaResponse struct {
 	ID        uuid.UUID         `json:"id"`
 	Type      Type              `json:"type"`
+	OrderID   uuid.UUID         `json:"order_id"`
 	Status    Status            `json:"status"`
+	Error     string            `json:"error,omitempty"`
 	Steps     []SagaStep        `json:"steps"`
 	Metadata  map[string]string `json:"metadata"`
 	CreatedAt time.Time         `json:"created_at"`
@@ -47,14 +73,31 @@ type SagaStep struct {
 	CompensationAction string                 `json:"compensation_action"`
 	Payload            map[string]interface{} `json:"payload"`
 	ErrorMessage       string                 `json:"error_message,omitempty"`
+	Retries            int                    `json:"retries"`
 	ExecutedAt         time.Time              `json:"executed_at"`
 }
 
+// SagaEvent is one transition in the history of a saga
+type SagaEvent struct {
+	Sequence   int64      `json:"sequence"`
+	Type       string     `json:"type"`
+	StepID     *uuid.UUID `json:"step_id,omitempty"`
+	StepName   string     `json:"step_name,omitempty"`
+	FromStatus string     `json:"from_status,omitempty"`
+	ToStatus   string     `json:"to_status,omitempty"`
+	Attempt    int        `json:"attempt,omitempty"`
+	Actor      string     `json:"actor"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
 // Common errors
 var (
 	ErrNotFound      = NewError("saga not found")
 	ErrAlreadyExists = NewError("saga already exists")
 	ErrInvalidStep   = NewError("invalid step order")
+	ErrInvalidFilter = NewError("invalid list filter")
+	ErrSagaBusy      = NewError("saga is being processed")
 )
 
 // Error represents a saga error
@@ -0,0 +1,28 @@
+package repository
+
+import "context"
+
+// Actors recorded in the saga event log
+const (
+	ActorSystem       = "system"
+	ActorAPI          = "api"
+	ActorExecutor     = "executor"
+	ActorRecovery     = "recovery"
+	ActorOrchestrator = "orchestrator"
+	ActorWorker       = "worker"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context whose saga changes are recorded as made by actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored by WithActor, or ActorSystem
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return ActorSystem
+}
@@ -2,27 +2,36 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
 )
 
+// ErrSagaNotFound is returned when a saga does not exist
+var ErrSagaNotFound = errors.New("saga not found")
+
 // SagaRepository defines the interface for saga data persistence
 type SagaRepository interface {
-	// Create saves a new saga to the database
-	Create(ctx context.Context, saga *entity.Saga) error
+	// Create saves a new saga and its steps, together with the outbox messages it produced
+	Create(ctx context.Context, saga *entity.Saga, messages ...*outbox.Message) error
 
 	// GetByID retrieves a saga by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Saga, error)
 
-	// Update updates an existing saga in the database
-	Update(ctx context.Context, saga *entity.Saga) error
+	// GetByOrderID retrieves the saga of an order
+	GetByOrderID(ctx context.Context, orderID uuid.UUID) (*entity.Saga, error)
+
+	// GetByOrderIDAndType retrieves the most recent saga of the given type of an order
+	GetByOrderIDAndType(ctx context.Context, orderID uuid.UUID, sagaType entity.SagaType) (*entity.Saga, error)
 
-	// UpdateStepStatus updates the status of a saga step
-	UpdateStepStatus(ctx context.Context, sagaID, stepID uuid.UUID, status entity.StepStatus, errorMessage string) error
+	// Update saves the saga and its steps, together with the outbox messages it produced
+	Update(ctx context.Context, saga *entity.Saga, messages ...*outbox.Message) error
 
-	// GetPendingSagas retrieves all pending sagas
+	// GetPendingSagas retrieves all pending and processing sagas
 	GetPendingSagas(ctx context.Context) ([]*entity.Saga, error)
 
 	// GetFailedSagas retrieves all failed sagas
@@ -30,4 +39,25 @@ type SagaRepository interface {
 
 	// GetCompensatingSagas retrieves all compensating sagas
 	GetCompensatingSagas(ctx context.Context) ([]*entity.Saga, error)
+
+	// List retrieves one page of sagas matching the filter and the total number of matches
+	List(ctx context.Context, filter ListFilter) ([]*entity.Saga, int64, error)
+
+	// GetEvents retrieves the event log of a saga in the order it was written
+	GetEvents(ctx context.Context, sagaID uuid.UUID) ([]*entity.SagaEvent, error)
+
+	// ClaimDueSagas leases up to limit message-driven PENDING and PROCESSING sagas
+	// that have a step past its deadline or due for retry, and COMPENSATING sagas
+	// with a failed compensation due for retry, that no other instance holds a
+	// lease on, to owner and returns them
+	ClaimDueSagas(ctx context.Context, owner string, ttl time.Duration, limit int) ([]*entity.Saga, error)
+
+	// AcquireLease takes the lease of a saga for owner if no unexpired lease is held on it
+	AcquireLease(ctx context.Context, sagaID uuid.UUID, owner string, ttl time.Duration) (bool, error)
+
+	// RenewLease extends the lease owner holds on a saga; it reports false once the lease is lost
+	RenewLease(ctx context.Context, sagaID uuid.UUID, owner string, ttl time.Duration) (bool, error)
+
+	// ReleaseLease gives up the lease owner holds on a saga
+	ReleaseLease(ctx context.Context, sagaID uuid.UUID, owner string) error
 }
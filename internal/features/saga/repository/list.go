@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+var (
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidSortField = errors.New("invalid sort field")
+)
+
+// SortField is a saga column sagas can be listed by
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+)
+
+// ParseSortField validates a sort field, defaulting to created_at
+func ParseSortField(field string) (SortField, error) {
+	switch SortField(field) {
+	case "":
+		return SortByCreatedAt, nil
+	case SortByCreatedAt, SortByUpdatedAt:
+		return SortField(field), nil
+	default:
+		return "", ErrInvalidSortField
+	}
+}
+
+// ListFilter selects and orders the sagas returned by List
+type ListFilter struct {
+	Status      entity.SagaStatus
+	Type        entity.SagaType
+	OrderID     *uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      SortField
+	SortDesc    bool
+	After       *Cursor
+	Limit       int
+}
+
+// Cursor points at the last saga of a page. The next page starts right after
+// it in the (sort field, ID) order.
+type Cursor struct {
+	SortBy SortField `json:"s"`
+	Time   time.Time `json:"t"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// CursorFor returns the cursor pointing at saga for the given sort field
+func CursorFor(saga *entity.Saga, sortBy SortField) *Cursor {
+	c := &Cursor{SortBy: sortBy, Time: saga.CreatedAt, ID: saga.ID}
+	if sortBy == SortByUpdatedAt {
+		c.Time = saga.UpdatedAt
+	}
+	return c
+}
+
+// Encode returns the opaque string form of the cursor
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor returned by Encode
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := ParseSortField(string(c.SortBy)); err != nil || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	saga := &entity.Saga{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	cursor, err := DecodeCursor(CursorFor(saga, SortByUpdatedAt).Encode())
+	require.NoError(t, err)
+	assert.Equal(t, SortByUpdatedAt, cursor.SortBy)
+	assert.Equal(t, saga.ID, cursor.ID)
+	assert.True(t, saga.UpdatedAt.Equal(cursor.Time))
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	_, err := DecodeCursor("not-a-cursor")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = DecodeCursor((&Cursor{SortBy: "status", ID: uuid.New()}).Encode())
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
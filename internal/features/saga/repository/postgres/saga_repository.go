@@ -2,11 +2,16 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
 )
 
 // SagaRepository implements the repository.SagaRepository interface
@@ -21,111 +26,341 @@ func NewSagaRepository(db *gorm.DB) *SagaRepository {
 	}
 }
 
-// Create saves a new saga to the database
-func (r *SagaRepository) Create(ctx context.Context, saga *entity.Saga) error {
-	return r.db.WithContext(ctx).Create(saga).Error
+// Create saves a new saga and its steps, together with its recorded events and
+// the outbox messages it produced
+func (r *SagaRepository) Create(ctx context.Context, saga *entity.Saga, messages ...*outbox.Message) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(saga).Error; err != nil {
+			return err
+		}
+		if err := r.appendEvents(ctx, tx, saga); err != nil {
+			return err
+		}
+		return outbox.Add(tx, messages...)
+	})
+	if err != nil {
+		return err
+	}
+
+	saga.ClearEvents()
+	return nil
 }
 
 // GetByID retrieves a saga by its ID
 func (r *SagaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Saga, error) {
 	var saga entity.Saga
-	err := r.db.WithContext(ctx).
-		Preload("Steps", func(db *gorm.DB) *gorm.DB {
-			return db.Order("\"order\" ASC")
-		}).
+	err := r.withSteps(r.db.WithContext(ctx)).
 		First(&saga, "id = ?", id).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSagaNotFound
+		}
 		return nil, err
 	}
 	return &saga, nil
 }
 
-// Update updates an existing saga in the database
-func (r *SagaRepository) Update(ctx context.Context, saga *entity.Saga) error {
-	return r.db.WithContext(ctx).Save(saga).Error
+// GetByOrderID retrieves the most recent saga of an order
+func (r *SagaRepository) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*entity.Saga, error) {
+	var saga entity.Saga
+	err := r.withSteps(r.db.WithContext(ctx)).
+		Where("order_id = ?", orderID).
+		Order("created_at DESC").
+		First(&saga).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSagaNotFound
+		}
+		return nil, err
+	}
+	return &saga, nil
 }
 
-// UpdateStepStatus updates the status of a saga step
-func (r *SagaRepository) UpdateStepStatus(ctx context.Context, sagaID, stepID uuid.UUID, status entity.StepStatus, errorMessage string) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Update step status
-		err := tx.Model(&entity.SagaStep{}).
-			Where("id = ? AND saga_id = ?", stepID, sagaID).
-			Updates(map[string]interface{}{
-				"status":        status,
-				"error_message": errorMessage,
-			}).Error
-		if err != nil {
-			return err
+// GetByOrderIDAndType retrieves the most recent saga of the given type of an order
+func (r *SagaRepository) GetByOrderIDAndType(ctx context.Context, orderID uuid.UUID, sagaType entity.SagaType) (*entity.Saga, error) {
+	var saga entity.Saga
+	err := r.withSteps(r.db.WithContext(ctx)).
+		Where("order_id = ? AND type = ?", orderID, sagaType).
+		Order("created_at DESC").
+		First(&saga).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSagaNotFound
 		}
+		return nil, err
+	}
+	return &saga, nil
+}
 
-		// Get saga to update its status
-		saga, err := r.GetByID(ctx, sagaID)
-		if err != nil {
+// Update saves the saga and its steps, together with its recorded events and
+// the outbox messages it produced
+func (r *SagaRepository) Update(ctx context.Context, saga *entity.Saga, messages ...*outbox.Message) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{FullSaveAssociations: true}).Save(saga).Error; err != nil {
 			return err
 		}
-
-		// Update saga status
-		saga.UpdateStatus()
-		return tx.Save(saga).Error
+		if err := r.appendEvents(ctx, tx, saga); err != nil {
+			return err
+		}
+		return outbox.Add(tx, messages...)
 	})
+	if err != nil {
+		return err
+	}
+
+	saga.ClearEvents()
+	return nil
 }
 
-// GetPendingSagas retrieves all pending sagas
-func (r *SagaRepository) GetPendingSagas(ctx context.Context) ([]*entity.Saga, error) {
-	var sagas []*entity.Saga
+// GetEvents retrieves the event log of a saga in the order it was written
+func (r *SagaRepository) GetEvents(ctx context.Context, sagaID uuid.UUID) ([]*entity.SagaEvent, error) {
+	var events []*entity.SagaEvent
 	err := r.db.WithContext(ctx).
-		Preload("Steps", func(db *gorm.DB) *gorm.DB {
-			return db.Order("\"order\" ASC")
-		}).
-		Where("status = ?", entity.SagaStatusPending).
+		Where("saga_id = ?", sagaID).
+		Order("sequence ASC").
+		Find(&events).Error
+	return events, err
+}
+
+// ClaimDueSagas leases up to limit message-driven PENDING and PROCESSING sagas
+// that have a step past its deadline or due for retry, and COMPENSATING sagas
+// with a failed compensation due for retry, that no other instance holds a
+// lease on, to owner and returns them. Rows locked by a concurrent claim are
+// skipped, so replicas claiming at once get disjoint sagas.
+func (r *SagaRepository) ClaimDueSagas(ctx context.Context, owner string, ttl time.Duration, limit int) ([]*entity.Saga, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Raw(
+		`UPDATE sagas SET lease_owner = ?, lease_expires_at = NOW() + make_interval(secs => ?)
+		WHERE id IN (
+			SELECT s.id FROM sagas s
+			WHERE s.executor = ?
+				AND (s.lease_expires_at IS NULL OR s.lease_expires_at < NOW())
+				AND ((s.status IN ? AND EXISTS (
+					SELECT 1 FROM saga_steps st
+					WHERE st.saga_id = s.id AND (
+						(st.status = ? AND st.deadline_at < NOW())
+						OR (st.status = ? AND (st.next_attempt_at IS NULL OR st.next_attempt_at <= NOW()))
+					)
+				)) OR (s.status = ? AND EXISTS (
+					SELECT 1 FROM saga_steps st
+					WHERE st.saga_id = s.id AND st.status IN ? AND st.next_attempt_at <= NOW()
+				)))
+			ORDER BY s.updated_at ASC
+			LIMIT ?
+			FOR UPDATE OF s SKIP LOCKED
+		)
+		RETURNING id`,
+		owner, ttl.Seconds(),
+		entity.SagaExecutorMessaging, []entity.SagaStatus{entity.SagaStatusPending, entity.SagaStatusProcessing},
+		entity.StepStatusPending, entity.StepStatusRetrying,
+		entity.SagaStatusCompensating, []entity.StepStatus{entity.StepStatusCompleted, entity.StepStatusTimedOut},
+		limit,
+	).Scan(&ids).Error
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	var sagas []*entity.Saga
+	err = r.withSteps(r.db.WithContext(ctx)).
+		Where("id IN ?", ids).
+		Order("updated_at ASC").
 		Find(&sagas).Error
 	return sagas, err
 }
 
+// AcquireLease takes the lease of a saga for owner if no unexpired lease is held on it.
+// Expiry is checked against the database clock so instances need not agree on time.
+func (r *SagaRepository) AcquireLease(ctx context.Context, sagaID uuid.UUID, owner string, ttl time.Duration) (bool, error) {
+	result := r.db.WithContext(ctx).Exec(
+		`UPDATE sagas SET lease_owner = ?, lease_expires_at = NOW() + make_interval(secs => ?)
+		WHERE id = ? AND (lease_expires_at IS NULL OR lease_expires_at < NOW())`,
+		owner, ttl.Seconds(), sagaID,
+	)
+	return result.RowsAffected == 1, result.Error
+}
+
+// RenewLease extends the lease owner holds on a saga; it reports false once the lease is lost
+func (r *SagaRepository) RenewLease(ctx context.Context, sagaID uuid.UUID, owner string, ttl time.Duration) (bool, error) {
+	result := r.db.WithContext(ctx).Exec(
+		`UPDATE sagas SET lease_expires_at = NOW() + make_interval(secs => ?)
+		WHERE id = ? AND lease_owner = ?`,
+		ttl.Seconds(), sagaID, owner,
+	)
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReleaseLease gives up the lease owner holds on a saga
+func (r *SagaRepository) ReleaseLease(ctx context.Context, sagaID uuid.UUID, owner string) error {
+	return r.db.WithContext(ctx).Exec(
+		`UPDATE sagas SET lease_owner = NULL, lease_expires_at = NULL WHERE id = ? AND lease_owner = ?`,
+		sagaID, owner,
+	).Error
+}
+
+// appendEvents writes the events recorded by the saga, attributed to the actor of ctx
+func (r *SagaRepository) appendEvents(ctx context.Context, tx *gorm.DB, saga *entity.Saga) error {
+	events := saga.Events()
+	if len(events) == 0 {
+		return nil
+	}
+
+	actor := repository.ActorFromContext(ctx)
+	for i := range events {
+		if events[i].Actor == "" {
+			events[i].Actor = actor
+		}
+	}
+	return tx.Create(&events).Error
+}
+
+// GetPendingSagas retrieves all pending and processing sagas
+func (r *SagaRepository) GetPendingSagas(ctx context.Context) ([]*entity.Saga, error) {
+	return r.findByStatus(ctx, entity.SagaStatusPending, entity.SagaStatusProcessing)
+}
+
 // GetFailedSagas retrieves all failed sagas
 func (r *SagaRepository) GetFailedSagas(ctx context.Context) ([]*entity.Saga, error) {
-	var sagas []*entity.Saga
-	err := r.db.WithContext(ctx).
-		Preload("Steps", func(db *gorm.DB) *gorm.DB {
-			return db.Order("\"order\" ASC")
-		}).
-		Where("status = ?", entity.SagaStatusFailed).
-		Find(&sagas).Error
-	return sagas, err
+	return r.findByStatus(ctx, entity.SagaStatusFailed)
 }
 
 // GetCompensatingSagas retrieves all compensating sagas
 func (r *SagaRepository) GetCompensatingSagas(ctx context.Context) ([]*entity.Saga, error) {
+	return r.findByStatus(ctx, entity.SagaStatusCompensating)
+}
+
+// List retrieves one page of sagas matching the filter and the total number of matches
+func (r *SagaRepository) List(ctx context.Context, filter repository.ListFilter) ([]*entity.Saga, int64, error) {
+	sortBy, err := repository.ParseSortField(string(filter.SortBy))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := r.filtered(ctx, filter).Model(&entity.Saga{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Keyset pagination on (sort field, id), which is stable while sagas are added
+	query := r.filtered(ctx, filter)
+	direction, comparison := "ASC", ">"
+	if filter.SortDesc {
+		direction, comparison = "DESC", "<"
+	}
+	if filter.After != nil {
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", sortBy, comparison), filter.After.Time, filter.After.ID)
+	}
+
 	var sagas []*entity.Saga
-	err := r.db.WithContext(ctx).
-		Preload("Steps", func(db *gorm.DB) *gorm.DB {
-			return db.Order("\"order\" ASC")
-		}).
-		Where("status = ?", entity.SagaStatusCompensating).
+	err = r.withSteps(query).
+		Order(fmt.Sprintf("%s %s, id %s", sortBy, direction, direction)).
+		Limit(filter.Limit).
+		Find(&sagas).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return sagas, total, nil
+}
+
+// filtered applies the filter conditions of a saga listing
+func (r *SagaRepository) filtered(ctx context.Context, filter repository.ListFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.OrderID != nil {
+		query = query.Where("order_id = ?", *filter.OrderID)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+	return query
+}
+
+func (r *SagaRepository) findByStatus(ctx context.Context, statuses ...entity.SagaStatus) ([]*entity.Saga, error) {
+	var sagas []*entity.Saga
+	err := r.withSteps(r.db.WithContext(ctx)).
+		Where("status IN ?", statuses).
+		Order("created_at ASC").
 		Find(&sagas).Error
 	return sagas, err
 }
 
-// Setup creates necessary indexes for the saga tables
-func (r *SagaRepository) Setup(ctx context.Context) error {
-	// Create indexes
-	err := r.db.WithContext(ctx).Exec(`
-		CREATE INDEX IF NOT EXISTS idx_sagas_status ON sagas(status);
-		CREATE INDEX IF NOT EXISTS idx_saga_steps_saga_id ON saga_steps(saga_id);
-		CREATE INDEX IF NOT EXISTS idx_saga_steps_status ON saga_steps(status);
-	`).Error
-	return err
+// withSteps preloads the saga steps in execution order
+func (r *SagaRepository) withSteps(db *gorm.DB) *gorm.DB {
+	return db.Preload("Steps", func(db *gorm.DB) *gorm.DB {
+		return db.Order("\"order\" ASC")
+	})
 }
 
-func (r *SagaRepository) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*entity.Saga, error) {
-	var saga entity.Saga
-	err := r.db.WithContext(ctx).
-		Preload("Steps").
-		Where("order_id = ?", orderID).
-		First(&saga).Error
-	if err != nil {
-		return nil, err
+// Migrations returns the database migrations for the saga tables.
+// They mirror the sagas migrations in the migrations directory.
+func (r *SagaRepository) Migrations() []string {
+	return []string{
+		`CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`,
+		`
+		CREATE TABLE IF NOT EXISTS sagas (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			type VARCHAR(50) NOT NULL,
+			order_id UUID NOT NULL,
+			status VARCHAR(50) NOT NULL,
+			error TEXT,
+			timeout BIGINT NOT NULL,
+			max_retries INTEGER NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		`,
+		`
+		CREATE TABLE IF NOT EXISTS saga_steps (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			saga_id UUID NOT NULL REFERENCES sagas(id) ON DELETE CASCADE,
+			name VARCHAR(255) NOT NULL,
+			status VARCHAR(50) NOT NULL,
+			"order" INTEGER NOT NULL,
+			payload JSONB,
+			error_message TEXT,
+			retries INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP WITH TIME ZONE,
+			deadline_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		`,
+		`CREATE INDEX IF NOT EXISTS idx_sagas_status ON sagas(status);`,
+		`CREATE INDEX IF NOT EXISTS idx_sagas_order_id ON sagas(order_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_saga_steps_saga_id ON saga_steps(saga_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_sagas_created_at_id ON sagas(created_at, id);`,
+		`CREATE INDEX IF NOT EXISTS idx_sagas_updated_at_id ON sagas(updated_at, id);`,
+		`
+		CREATE TABLE IF NOT EXISTS saga_events (
+			id UUID PRIMARY KEY,
+			sequence BIGSERIAL NOT NULL,
+			saga_id UUID NOT NULL REFERENCES sagas(id) ON DELETE CASCADE,
+			step_id UUID,
+			step_name VARCHAR(255),
+			type VARCHAR(50) NOT NULL,
+			from_status VARCHAR(50),
+			to_status VARCHAR(50),
+			attempt INTEGER NOT NULL DEFAULT 0,
+			actor VARCHAR(100) NOT NULL,
+			error TEXT,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		`,
+		`CREATE INDEX IF NOT EXISTS idx_saga_events_saga_id_sequence ON saga_events(saga_id, sequence);`,
+		`ALTER TABLE sagas ADD COLUMN IF NOT EXISTS executor VARCHAR(20) NOT NULL DEFAULT 'IN_PROCESS';`,
+		`ALTER TABLE sagas ADD COLUMN IF NOT EXISTS lease_owner VARCHAR(255);`,
+		`ALTER TABLE sagas ADD COLUMN IF NOT EXISTS lease_expires_at TIMESTAMP WITH TIME ZONE;`,
+		`ALTER TABLE saga_steps ADD COLUMN IF NOT EXISTS deadline_at TIMESTAMP WITH TIME ZONE;`,
+		`CREATE INDEX IF NOT EXISTS idx_saga_steps_deadline_at ON saga_steps(deadline_at) WHERE status = 'PENDING';`,
+		`CREATE INDEX IF NOT EXISTS idx_saga_steps_next_attempt_at ON saga_steps(next_attempt_at) WHERE status = 'RETRYING';`,
+		`DROP INDEX IF EXISTS idx_sagas_claim;`,
+		`CREATE INDEX IF NOT EXISTS idx_sagas_claim_due ON sagas(updated_at) WHERE executor = 'MESSAGING' AND status IN ('PENDING', 'PROCESSING', 'COMPENSATING');`,
 	}
-	return &saga, nil
 }
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/testutil"
+)
+
+func newTestRepository(t *testing.T) *SagaRepository {
+	testutil.SkipWithoutPostgres(t)
+	db := testutil.NewTestPostgres(t)
+	t.Cleanup(func() { db.Cleanup(t) })
+
+	repo := NewSagaRepository(db.DB)
+	for _, migration := range repo.Migrations() {
+		require.NoError(t, db.Exec(migration).Error)
+	}
+	return repo
+}
+
+// createSaga stores a message-driven saga with the given status whose first
+// step is changed by update
+func createSaga(t *testing.T, repo *SagaRepository, status entity.SagaStatus, update func(step *entity.SagaStep)) *entity.Saga {
+	t.Helper()
+
+	saga := entity.NewSaga(entity.SagaTypeOrderPayment, uuid.New(), []entity.SagaStep{
+		{Name: entity.StepCreateOrder},
+		{Name: entity.StepProcessPayment},
+	})
+	saga.Executor = entity.SagaExecutorMessaging
+	saga.SetStatus(status)
+	update(&saga.Steps[0])
+	require.NoError(t, repo.Create(context.Background(), saga))
+	return saga
+}
+
+func sagaIDs(sagas []*entity.Saga) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(sagas))
+	for _, saga := range sagas {
+		ids = append(ids, saga.ID)
+	}
+	return ids
+}
+
+func TestSagaRepository_AcquireLease(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+	saga := createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {})
+
+	acquired, err := repo.AcquireLease(ctx, saga.ID, "instance-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// The lease is held until it is released or expires
+	acquired, err = repo.AcquireLease(ctx, saga.ID, "instance-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	renewed, err := repo.RenewLease(ctx, saga.ID, "instance-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, renewed)
+
+	require.NoError(t, repo.ReleaseLease(ctx, saga.ID, "instance-2"))
+	acquired, err = repo.AcquireLease(ctx, saga.ID, "instance-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	require.NoError(t, repo.ReleaseLease(ctx, saga.ID, "instance-1"))
+	acquired, err = repo.AcquireLease(ctx, saga.ID, "instance-2", -time.Second)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// An expired lease is taken over
+	acquired, err = repo.AcquireLease(ctx, saga.ID, "instance-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	renewed, err = repo.RenewLease(ctx, saga.ID, "instance-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, renewed)
+
+	stored, err := repo.GetByID(ctx, saga.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "instance-1", stored.LeaseOwner)
+	assert.True(t, stored.IsLeased())
+}
+
+func TestSagaRepository_ClaimDueSagas(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	pastDeadline := createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {
+		step.DeadlineAt = &past
+	})
+	retryDue := createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {
+		step.Status = entity.StepStatusRetrying
+		step.NextAttemptAt = &past
+	})
+	compensationDue := createSaga(t, repo, entity.SagaStatusCompensating, func(step *entity.SagaStep) {
+		step.Status = entity.StepStatusCompleted
+		step.NextAttemptAt = &past
+	})
+
+	// Sagas that are not due, or not run by the orchestrator
+	createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {
+		step.DeadlineAt = &future
+	})
+	createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {
+		step.Status = entity.StepStatusRetrying
+		step.NextAttemptAt = &future
+	})
+	createSaga(t, repo, entity.SagaStatusCompensating, func(step *entity.SagaStep) {
+		step.Status = entity.StepStatusCompleted
+		step.NextAttemptAt = &future
+	})
+	createSaga(t, repo, entity.SagaStatusFailed, func(step *entity.SagaStep) {
+		step.DeadlineAt = &past
+	})
+	inProcess := entity.NewSaga(entity.SagaTypeOrderPayment, uuid.New(), []entity.SagaStep{{Name: entity.StepCreateOrder}})
+	inProcess.SetStatus(entity.SagaStatusProcessing)
+	inProcess.Steps[0].DeadlineAt = &past
+	require.NoError(t, repo.Create(ctx, inProcess))
+
+	// A leased saga is left to its holder
+	leased := createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {
+		step.DeadlineAt = &past
+	})
+	acquired, err := repo.AcquireLease(ctx, leased.ID, "instance-2", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// A saga locked by a concurrent claim is skipped rather than waited for
+	locked := createSaga(t, repo, entity.SagaStatusProcessing, func(step *entity.SagaStep) {
+		step.DeadlineAt = &past
+	})
+	tx := repo.db.Begin()
+	require.NoError(t, tx.Exec(`SELECT id FROM sagas WHERE id = ? FOR UPDATE`, locked.ID).Error)
+
+	claimed, err := repo.ClaimDueSagas(ctx, "instance-1", time.Minute, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{pastDeadline.ID, retryDue.ID, compensationDue.ID}, sagaIDs(claimed))
+	for _, saga := range claimed {
+		assert.Equal(t, "instance-1", saga.LeaseOwner)
+		assert.Len(t, saga.Steps, 2)
+	}
+	require.NoError(t, tx.Rollback().Error)
+
+	// Claimed sagas are leased, so another instance only gets the unlocked one
+	claimed, err = repo.ClaimDueSagas(ctx, "instance-2", time.Minute, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{locked.ID}, sagaIDs(claimed))
+
+	// Released sagas are claimed again, up to the limit
+	for _, id := range []uuid.UUID{pastDeadline.ID, retryDue.ID, compensationDue.ID} {
+		require.NoError(t, repo.ReleaseLease(ctx, id, "instance-1"))
+	}
+	claimed, err = repo.ClaimDueSagas(ctx, "instance-3", time.Minute, 2)
+	require.NoError(t, err)
+	assert.Len(t, claimed, 2)
+}
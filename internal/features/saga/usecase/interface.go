@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	pb "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/grpc/proto"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 )
 
 // SagaStatus represents the status of a saga transaction
@@ -67,7 +68,7 @@ type SagaTransactionResponse struct {
 type StartOrderSagaRequest struct {
 	OrderID       uuid.UUID
 	UserID        uuid.UUID
-	Amount        float64
+	Amount        money.Money
 	PaymentMethod string
 	Metadata      map[string]string
 }
@@ -83,7 +84,7 @@ type ListSagaTransactionsRequest struct {
 // Usecase defines the interface for saga orchestration
 type Usecase interface {
 	// StartOrderSaga starts a new order saga transaction
-	StartOrderSaga(ctx context.Context, orderID, userID uuid.UUID, amount float64, paymentMethod string, metadata map[string]string) (*pb.SagaTransaction, error)
+	StartOrderSaga(ctx context.Context, orderID, userID uuid.UUID, amount money.Money, paymentMethod string, metadata map[string]string) (*pb.SagaTransaction, error)
 
 	// GetSagaStatus retrieves the status of a saga transaction
 	GetSagaStatus(ctx context.Context, sagaID uuid.UUID) (*pb.SagaTransaction, error)
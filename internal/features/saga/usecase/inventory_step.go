@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"errors"
+
+	inventory "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
+	orderEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+)
+
+// ReservationItems returns the stock reserved by the UpdateInventory step for the order items
+func ReservationItems(items []orderEntity.OrderItem) []inventory.ReservationItem {
+	result := make([]inventory.ReservationItem, len(items))
+	for i, item := range items {
+		result[i] = inventory.ReservationItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	return result
+}
+
+// InventoryError marks the reservation errors that a retry cannot fix as
+// permanent, so that the saga is compensated right away
+func InventoryError(err error) error {
+	for _, permanent := range []error{
+		inventory.ErrInsufficientStock,
+		inventory.ErrProductNotFound,
+		inventory.ErrReservationReleased,
+		inventory.ErrNoItems,
+		inventory.ErrInvalidQuantity,
+	} {
+		if errors.Is(err, permanent) {
+			return definition.Permanent(err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	orderEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/statemachine"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+)
+
+// ConfirmOrder moves the order accepted by the CreateOrder step to CONFIRMED
+func ConfirmOrder(ctx context.Context, states *statemachine.OrderStateMachine, order *orderEntity.Order) error {
+	err := states.Apply(ctx, order, orderEntity.OrderStatusConfirmed, orderEntity.ActorSaga, "accepted by the order-payment saga")
+	if errors.Is(err, orderEntity.ErrInvalidTransition) {
+		return definition.Permanent(err)
+	}
+	return err
+}
+
+// CancelFailedOrder moves the order of a failed order-payment saga to
+// CANCELLED, giving the saga error as the reason
+func CancelFailedOrder(ctx context.Context, states *statemachine.OrderStateMachine, orderID uuid.UUID, saga *entity.Saga) error {
+	reason := "order-payment saga failed"
+	if saga.Error != "" {
+		reason += ": " + saga.Error
+	}
+	_, err := states.Transition(ctx, orderID, orderEntity.OrderStatusCancelled, orderEntity.ActorSaga, reason)
+	return err
+}
+
+// ProcessOrder moves the order of a completed order-payment saga to
+// PROCESSING. An order cancelled in the meantime is left alone.
+func ProcessOrder(ctx context.Context, states *statemachine.OrderStateMachine, orderID uuid.UUID) error {
+	_, err := states.Transition(ctx, orderID, orderEntity.OrderStatusProcessing, orderEntity.ActorSaga, "payment captured and stock reserved")
+	if errors.Is(err, orderEntity.ErrInvalidTransition) || errors.Is(err, orderRepo.ErrStatusChanged) {
+		return nil
+	}
+	return err
+}
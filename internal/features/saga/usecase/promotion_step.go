@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"errors"
+
+	orderEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+)
+
+// DiscountLines returns the coupon uses reserved by the CreateOrder step for the order discounts
+func DiscountLines(discounts []orderEntity.OrderDiscount) []promotion.DiscountLine {
+	result := make([]promotion.DiscountLine, len(discounts))
+	for i, discount := range discounts {
+		result[i] = promotion.DiscountLine{
+			PromotionID: discount.PromotionID,
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      discount.Amount,
+		}
+	}
+	return result
+}
+
+// PromotionError marks the redemption errors that a retry cannot fix as
+// permanent, so that the saga is compensated right away
+func PromotionError(err error) error {
+	for _, permanent := range []error{
+		promotion.ErrUsageLimitReached,
+		promotion.ErrRedemptionReleased,
+	} {
+		if errors.Is(err, permanent) {
+			return definition.Permanent(err)
+		}
+	}
+	return err
+}
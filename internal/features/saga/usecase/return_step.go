@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	inventory "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
+	returnEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/returns/domain/entity"
+)
+
+// ReturnItems returns the stock given back by the RestockReturn step for the return items
+func ReturnItems(items []returnEntity.ReturnItem) []inventory.ReservationItem {
+	result := make([]inventory.ReservationItem, len(items))
+	for i, item := range items {
+		result[i] = inventory.ReservationItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	return result
+}
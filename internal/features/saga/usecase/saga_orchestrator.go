@@ -9,8 +9,11 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
-	"github.com/diki-haryadi/ecommerce-saga/internal/shared/messaging"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
+	"github.com/diki-haryadi/ecommerce-saga/pkg/metrics"
 )
 
 var (
@@ -20,82 +23,243 @@ var (
 	ErrNotFound         = errors.New("saga not found")
 	ErrAlreadyExist     = errors.New("saga already exists")
 	ErrInvalidStatus    = errors.New("invalid status")
+	ErrSagaLeased       = errors.New("saga is being processed by another orchestrator")
 )
 
-type SagaRepository interface {
-	Create(ctx context.Context, saga *entity.SagaTransaction) error
-	GetByID(ctx context.Context, id uuid.UUID) (*entity.SagaTransaction, error)
-	GetByOrderID(ctx context.Context, orderID uuid.UUID) (*entity.SagaTransaction, error)
-	Update(ctx context.Context, saga *entity.SagaTransaction) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetPendingSagas(ctx context.Context) ([]*entity.SagaTransaction, error)
-}
+const (
+	// claimBatchSize is the number of sagas an orchestrator claims per polling round
+	claimBatchSize = 100
+
+	// leasePollInterval is how often a step result asks again for a leased saga
+	leasePollInterval = 100 * time.Millisecond
+)
 
+// SagaOrchestrator drives message-based sagas. Step and compensation commands
+// are written to the outbox with the saga state and published by outbox.Relay.
+// Several orchestrators may poll the same database; each saga is leased to one
+// of them while it is processed.
 type SagaOrchestrator struct {
-	sagaRepo      SagaRepository
-	messageBroker messaging.MessageBroker
-	stepTimeout   time.Duration
-	maxRetries    int
+	sagaRepo    repository.SagaRepository
+	registry    *definition.Registry
+	stepTimeout time.Duration
+	maxRetries  int
+	leaseOwner  string
+	leaseTTL    time.Duration
 }
 
 func NewSagaOrchestrator(
-	sagaRepo SagaRepository,
-	messageBroker messaging.MessageBroker,
+	sagaRepo repository.SagaRepository,
+	registry *definition.Registry,
 	stepTimeout time.Duration,
 	maxRetries int,
 ) *SagaOrchestrator {
 	return &SagaOrchestrator{
-		sagaRepo:      sagaRepo,
-		messageBroker: messageBroker,
-		stepTimeout:   stepTimeout,
-		maxRetries:    maxRetries,
+		sagaRepo:    sagaRepo,
+		registry:    registry,
+		stepTimeout: stepTimeout,
+		maxRetries:  maxRetries,
+		leaseOwner:  newLeaseOwner(),
+		leaseTTL:    defaultLeaseTTL,
 	}
 }
 
 func (o *SagaOrchestrator) StartOrderPaymentSaga(ctx context.Context, orderID uuid.UUID) error {
 	// Check if saga already exists for this order
-	if _, err := o.sagaRepo.GetByOrderID(ctx, orderID); err == nil {
+	if _, err := o.sagaRepo.GetByOrderIDAndType(ctx, orderID, entity.SagaTypeOrderPayment); err == nil {
 		return ErrSagaAlreadyExist
 	}
 
-	// Create new saga transaction
-	saga := entity.NewSagaTransaction(orderID)
+	def, err := o.registry.Get(entity.SagaTypeOrderPayment)
+	if err != nil {
+		return err
+	}
+	if def.FirstStep() == nil {
+		return ErrInvalidStep
+	}
+
+	// Create new saga
+	saga := entity.NewSaga(def.Type, orderID, def.NewSteps(nil))
+	saga.Executor = entity.SagaExecutorMessaging
 	saga.Timeout = o.stepTimeout
 	saga.MaxRetries = o.maxRetries
+	saga.SetStatus(entity.SagaStatusProcessing)
 
-	// Save saga to postgres
-	if err := o.sagaRepo.Create(ctx, saga); err != nil {
+	// Save saga to postgres together with the first step command
+	step := saga.GetNextStep()
+	msg, err := o.stepMessage(saga, step)
+	if err != nil {
+		return err
+	}
+	saga.StartStep(step.ID, o.stepDeadline(saga, step.Name))
+	if err := o.sagaRepo.Create(ctx, saga, msg); err != nil {
 		return fmt.Errorf("failed to create saga: %w", err)
 	}
 
-	// Start first step
-	return o.processStep(ctx, saga)
+	return nil
 }
 
-func (o *SagaOrchestrator) ProcessStepResult(ctx context.Context, sagaID uuid.UUID, step entity.SagaStep, status entity.StepStatus, err error) error {
+// StartOrderReturnSaga starts the saga restocking and refunding an approved
+// return of the order. An order may have several return sagas, one per return.
+func (o *SagaOrchestrator) StartOrderReturnSaga(ctx context.Context, orderID, returnID uuid.UUID) error {
+	def, err := o.registry.Get(entity.SagaTypeOrderReturn)
+	if err != nil {
+		return err
+	}
+	if def.FirstStep() == nil {
+		return ErrInvalidStep
+	}
+
+	payload, err := json.Marshal(definition.ReturnPayload{ReturnID: returnID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal return payload: %w", err)
+	}
+
+	saga := entity.NewSaga(def.Type, orderID, def.NewSteps(payload))
+	saga.Executor = entity.SagaExecutorMessaging
+	saga.Timeout = o.stepTimeout
+	saga.MaxRetries = o.maxRetries
+	saga.SetStatus(entity.SagaStatusProcessing)
+
+	step := saga.GetNextStep()
+	msg, err := o.stepMessage(saga, step)
+	if err != nil {
+		return err
+	}
+	saga.StartStep(step.ID, o.stepDeadline(saga, step.Name))
+	if err := o.sagaRepo.Create(ctx, saga, msg); err != nil {
+		return fmt.Errorf("failed to create saga: %w", err)
+	}
+
+	return nil
+}
+
+// CompensateOrderSaga undoes the order-payment saga of an order that is being
+// cancelled, refunding the payment and releasing the stock. It returns once the
+// compensation is stored: message-driven sagas send their compensation commands
+// through the outbox, in-process sagas are compensated by SagaRecovery. It
+// reports false if the saga is being processed and cannot be stopped right now.
+// An order without saga, or whose saga is already undone, has nothing to compensate.
+func (o *SagaOrchestrator) CompensateOrderSaga(ctx context.Context, orderID uuid.UUID, reason string) (bool, error) {
+	saga, err := o.sagaRepo.GetByOrderIDAndType(ctx, orderID, entity.SagaTypeOrderPayment)
+	if errors.Is(err, repository.ErrSagaNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !isCompensable(saga) {
+		return true, nil
+	}
+
+	// Only the instance holding the lease may change the saga
+	acquired, err := o.sagaRepo.AcquireLease(ctx, saga.ID, o.leaseOwner, o.leaseTTL)
+	if err != nil {
+		return false, err
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer o.releaseLease(ctx, saga.ID)
+
+	// The saga may have moved on before the lease was taken
+	saga, err = o.sagaRepo.GetByID(ctx, saga.ID)
+	if err != nil {
+		return false, err
+	}
+	if !isCompensable(saga) {
+		return true, nil
+	}
+
+	saga.Abort(errors.New(reason))
+	if saga.Executor == entity.SagaExecutorInProcess {
+		if len(saga.CompensationSteps()) > 0 {
+			saga.SetStatus(entity.SagaStatusCompensating)
+		}
+		return true, o.sagaRepo.Update(ctx, saga)
+	}
+	return true, o.compensate(ctx, saga, errors.New(reason))
+}
+
+// IsOrderSagaRunning checks if the order-payment saga of an order is still
+// running or undoing its steps
+func (o *SagaOrchestrator) IsOrderSagaRunning(ctx context.Context, orderID uuid.UUID) (bool, error) {
+	saga, err := o.sagaRepo.GetByOrderIDAndType(ctx, orderID, entity.SagaTypeOrderPayment)
+	if errors.Is(err, repository.ErrSagaNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	switch saga.Status {
+	case entity.SagaStatusPending, entity.SagaStatusProcessing, entity.SagaStatusCompensating:
+		return true, nil
+	}
+	return false, nil
+}
+
+// isCompensable checks if the saga is running or completed, and so has steps to undo
+func isCompensable(saga *entity.Saga) bool {
+	switch saga.Status {
+	case entity.SagaStatusPending, entity.SagaStatusProcessing, entity.SagaStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// ProcessStepResult moves a saga on with the outcome a worker reported for one
+// of its steps. The saga is leased while it is changed, so that a result
+// arriving during a sweep neither overwrites the sweeper's changes nor is
+// overwritten by them. While another orchestrator holds the saga it waits for
+// the lease, which is held for a sweep or expires with its holder. It fails
+// with ErrSagaLeased when the lease is still held after its TTL; the result is
+// then lost, the step times out at its deadline and is sent again, and the
+// worker reports the outcome it recorded.
+func (o *SagaOrchestrator) ProcessStepResult(ctx context.Context, sagaID uuid.UUID, step entity.SagaStep, status entity.StepStatus, stepErr error) error {
+	if err := o.waitForLease(ctx, sagaID); err != nil {
+		return err
+	}
+	defer o.releaseLease(ctx, sagaID)
+
 	saga, err := o.sagaRepo.GetByID(ctx, sagaID)
 	if err != nil {
 		return fmt.Errorf("saga not found: %w", err)
 	}
 
-	// Add step result
-	saga.AddStepResult(step, status, err)
-
 	// Handle step result
 	switch status {
-	case entity.StepStatusSuccess:
-		return o.handleSuccessfulStep(ctx, saga)
-	case entity.StepStatusFailed:
-		return o.handleFailedStep(ctx, saga)
+	case entity.StepStatusSuccess, entity.StepStatusFailed:
+		// Commands sent again report their outcome again; only the result of
+		// the current attempt may move the saga forward
+		if !saga.IsCurrentAttempt(step) {
+			return nil
+		}
+		if status == entity.StepStatusSuccess {
+			return o.handleSuccessfulStep(ctx, saga, step)
+		}
+		if o.shouldRetry(saga, step, stepErr) {
+			return o.scheduleRetry(ctx, saga, step, stepErr)
+		}
+		return o.handleFailedStep(ctx, saga, step, stepErr)
+	case entity.StepStatusCompensated:
+		return o.handleCompensatedStep(ctx, saga, step)
+	case entity.StepStatusCompensationFailed:
+		return o.handleFailedCompensation(ctx, saga, step, stepErr)
 	default:
+		saga.UpdateStepStatus(step.ID, status, errorMessage(stepErr))
 		return o.sagaRepo.Update(ctx, saga)
 	}
 }
 
+// ProcessPendingSagas is the timeout and retry sweeper. It claims a batch of
+// message-driven sagas with a step past its deadline or due for retry, or with
+// a failed compensation due to start again, times out or re-dispatches that
+// step or compensation, and releases the sagas again. Concurrent
+// orchestrators claim disjoint batches, so no step is dispatched twice.
 func (o *SagaOrchestrator) ProcessPendingSagas(ctx context.Context) error {
-	sagas, err := o.sagaRepo.GetPendingSagas(ctx)
+	sagas, err := o.sagaRepo.ClaimDueSagas(ctx, o.leaseOwner, o.leaseTTL, claimBatchSize)
 	if err != nil {
-		return fmt.Errorf("failed to get pending sagas: %w", err)
+		return fmt.Errorf("failed to claim due sagas: %w", err)
 	}
 
 	for _, saga := range sagas {
@@ -103,118 +267,310 @@ func (o *SagaOrchestrator) ProcessPendingSagas(ctx context.Context) error {
 			// Log error but continue processing other sagas
 			fmt.Printf("Error processing saga %s: %v\n", saga.ID, err)
 		}
+		o.releaseLease(ctx, saga.ID)
 	}
 
 	return nil
 }
 
-func (o *SagaOrchestrator) processSaga(ctx context.Context, saga *entity.SagaTransaction) error {
-	// Check for timeout
-	if saga.IsTimeout() {
-		saga.UpdateStatus(entity.SagaStatusFailed)
-		if err := o.sagaRepo.Update(ctx, saga); err != nil {
-			return err
+// waitForLease leases a saga, polling for at most the lease TTL while another
+// orchestrator holds it
+func (o *SagaOrchestrator) waitForLease(ctx context.Context, sagaID uuid.UUID) error {
+	deadline := time.Now().Add(o.leaseTTL)
+	for {
+		acquired, err := o.sagaRepo.AcquireLease(ctx, sagaID, o.leaseOwner, o.leaseTTL)
+		if err != nil {
+			return fmt.Errorf("failed to lease saga: %w", err)
+		}
+		if acquired {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return ErrSagaLeased
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(leasePollInterval):
 		}
-		return o.startCompensation(ctx, saga)
 	}
+}
 
-	// Process current step
-	return o.processStep(ctx, saga)
+// releaseLease gives up the lease held on a saga
+func (o *SagaOrchestrator) releaseLease(ctx context.Context, sagaID uuid.UUID) {
+	if err := o.sagaRepo.ReleaseLease(context.WithoutCancel(ctx), sagaID, o.leaseOwner); err != nil {
+		// The lease expires on its own
+		fmt.Printf("Error releasing saga %s: %v\n", sagaID, err)
+	}
 }
 
-func (o *SagaOrchestrator) handleSuccessfulStep(ctx context.Context, saga *entity.SagaTransaction) error {
-	nextStep := o.getNextStep(saga.CurrentStep)
-	if nextStep == "" {
-		// No more steps, saga completed
-		saga.UpdateStatus(entity.SagaStatusCompleted)
+func (o *SagaOrchestrator) processSaga(ctx context.Context, saga *entity.Saga) error {
+	if saga.IsCompensating() {
+		return o.retryCompensations(ctx, saga)
+	}
+
+	step := saga.GetNextStep()
+	if step == nil {
+		return nil
+	}
+
+	// A dispatched step that has not reported back by its deadline timed out
+	if step.IsPastDeadline() {
+		return o.handleTimedOutStep(ctx, saga, step)
+	}
+
+	// Commands of running steps are already in the outbox; only a retried
+	// step is dispatched again, once its backoff delay has passed
+	if step.Status != entity.StepStatusRetrying || !step.IsRetryDue() {
+		return nil
+	}
+
+	saga.UpdateStepStatus(step.ID, entity.StepStatusPending, step.ErrorMessage)
+	return o.processStep(ctx, saga, step)
+}
+
+func (o *SagaOrchestrator) handleSuccessfulStep(ctx context.Context, saga *entity.Saga, step entity.SagaStep) error {
+	saga.UpdateStepStatus(step.ID, entity.StepStatusCompleted, "")
+
+	nextStep := saga.GetNextStep()
+	if nextStep == nil {
+		// No more steps, saga completed. A failed completion handler leaves the
+		// step result unsaved, so it runs again when the step times out and the
+		// worker reports its recorded outcome again.
+		def, err := o.registry.Get(saga.Type)
+		if err != nil {
+			return err
+		}
+		if err := def.Complete(ctx, saga); err != nil {
+			return fmt.Errorf("failed to complete saga: %w", err)
+		}
+		saga.SetStatus(entity.SagaStatusCompleted)
 		return o.sagaRepo.Update(ctx, saga)
 	}
 
-	// Update saga with next step
-	saga.SetCurrentStep(entity.SagaStep{Name: nextStep})
-	if err := o.sagaRepo.Update(ctx, saga); err != nil {
+	// Process next step
+	return o.processStep(ctx, saga, nextStep)
+}
+
+// shouldRetry reports whether the failed step has attempts left
+func (o *SagaOrchestrator) shouldRetry(saga *entity.Saga, step entity.SagaStep, stepErr error) bool {
+	if !definition.IsRetryable(stepErr) || step.Retries >= saga.MaxRetries {
+		return false
+	}
+
+	stepDef, err := o.stepDefinition(saga, step.Name)
+	if err != nil {
+		return false
+	}
+	return stepDef.Retry.CanRetry(step.Retries)
+}
+
+// scheduleRetry stores the next attempt time of the step. The step is
+// published again by ProcessPendingSagas once the backoff delay has passed.
+func (o *SagaOrchestrator) scheduleRetry(ctx context.Context, saga *entity.Saga, step entity.SagaStep, stepErr error) error {
+	stepDef, err := o.stepDefinition(saga, step.Name)
+	if err != nil {
 		return err
 	}
 
-	// Process next step
-	return o.processStep(ctx, saga)
+	delay := stepDef.Retry.Backoff(step.Retries + 1)
+	saga.ScheduleStepRetry(step.ID, entity.StepStatusRetrying, time.Now().Add(delay), errorMessage(stepErr))
+	return o.sagaRepo.Update(ctx, saga)
+}
+
+// handleTimedOutStep marks a step TIMED_OUT, then retries it or, once its
+// retries run out, compensates the saga. A result the worker reports later is
+// not for the current attempt and is ignored.
+func (o *SagaOrchestrator) handleTimedOutStep(ctx context.Context, saga *entity.Saga, step *entity.SagaStep) error {
+	saga.UpdateStepStatus(step.ID, entity.StepStatusTimedOut, ErrStepTimeout.Error())
+	metrics.SagaStepTimeouts.WithLabelValues(string(step.Name)).Inc()
+
+	if o.shouldRetry(saga, *step, ErrStepTimeout) {
+		return o.scheduleRetry(ctx, saga, *step, ErrStepTimeout)
+	}
+	return o.compensate(ctx, saga, ErrStepTimeout)
+}
+
+func (o *SagaOrchestrator) handleFailedStep(ctx context.Context, saga *entity.Saga, step entity.SagaStep, stepErr error) error {
+	saga.UpdateStepStatus(step.ID, entity.StepStatusFailed, errorMessage(stepErr))
+	return o.compensate(ctx, saga, stepErr)
 }
 
-func (o *SagaOrchestrator) handleFailedStep(ctx context.Context, saga *entity.SagaTransaction) error {
-	// Mark saga as failed
-	saga.UpdateStatus(entity.SagaStatusFailed)
-	if err := o.sagaRepo.Update(ctx, saga); err != nil {
+// compensate undoes the completed steps of the saga. A saga without completed
+// steps has nothing to undo and is marked failed right away.
+func (o *SagaOrchestrator) compensate(ctx context.Context, saga *entity.Saga, reason error) error {
+	msgs, err := o.compensationMessages(saga)
+	if err != nil {
 		return err
 	}
 
-	// Start compensation
-	return o.startCompensation(ctx, saga)
+	saga.Fail(reason)
+	if len(msgs) > 0 {
+		saga.SetStatus(entity.SagaStatusCompensating)
+	}
+	return o.sagaRepo.Update(ctx, saga, msgs...)
 }
 
-func (o *SagaOrchestrator) processStep(ctx context.Context, saga *entity.SagaTransaction) error {
-	// Prepare step message
-	msg := map[string]interface{}{
-		"saga_id":  saga.ID,
-		"order_id": saga.OrderID,
-		"step":     saga.CurrentStep,
+func (o *SagaOrchestrator) handleCompensatedStep(ctx context.Context, saga *entity.Saga, step entity.SagaStep) error {
+	current := saga.GetStepByID(step.ID)
+	if current == nil || current.Status == entity.StepStatusCompensated {
+		return nil
 	}
 
-	// Get topic for current step
-	topic := o.getTopicForStep(saga.CurrentStep.Name)
+	saga.UpdateStepStatus(step.ID, entity.StepStatusCompensated, "")
+	finishCompensation(saga)
+	return o.sagaRepo.Update(ctx, saga)
+}
+
+// handleFailedCompensation starts the compensation of a step again once its
+// backoff delay has passed. A compensation that failed for good is not
+// retried; the saga then ends FAILED rather than COMPENSATED.
+func (o *SagaOrchestrator) handleFailedCompensation(ctx context.Context, saga *entity.Saga, step entity.SagaStep, stepErr error) error {
+	// Only the result of the running compensation of a step counts
+	current := saga.GetStepByID(step.ID)
+	if !saga.IsCompensating() || current == nil || current.Retries != step.Retries ||
+		(current.Status != entity.StepStatusCompleted && current.Status != entity.StepStatusTimedOut) {
+		return nil
+	}
 
-	// Publish step message
-	msgBytes, err := json.Marshal(msg)
+	if !definition.IsRetryable(stepErr) {
+		saga.UpdateStepStatus(step.ID, entity.StepStatusCompensationFailed, errorMessage(stepErr))
+		finishCompensation(saga)
+		return o.sagaRepo.Update(ctx, saga)
+	}
+
+	stepDef, err := o.stepDefinition(saga, step.Name)
 	if err != nil {
-		return fmt.Errorf("failed to marshal step message: %w", err)
+		return err
+	}
+	delay := stepDef.Retry.Backoff(current.Retries + 1)
+	saga.ScheduleCompensationRetry(step.ID, time.Now().Add(delay), errorMessage(stepErr))
+	return o.sagaRepo.Update(ctx, saga)
+}
+
+// retryCompensations starts the failed compensations of a saga again whose
+// backoff delay has passed
+func (o *SagaOrchestrator) retryCompensations(ctx context.Context, saga *entity.Saga) error {
+	steps := saga.DueCompensations()
+	if len(steps) == 0 {
+		return nil
 	}
 
-	return o.messageBroker.Publish(ctx, topic, msgBytes)
+	msgs := make([]*outbox.Message, 0, len(steps))
+	for _, step := range steps {
+		msg, err := o.compensationMessage(saga, step)
+		if err != nil {
+			return err
+		}
+		saga.StartCompensation(step.ID)
+		msgs = append(msgs, msg)
+	}
+	return o.sagaRepo.Update(ctx, saga, msgs...)
 }
 
-func (o *SagaOrchestrator) startCompensation(ctx context.Context, saga *entity.SagaTransaction) error {
-	// Process compensation steps in reverse order
-	for i := len(saga.CompensationSteps) - 1; i >= 0; i-- {
-		step := saga.CompensationSteps[i]
-		msg := map[string]interface{}{
-			"saga_id":  saga.ID,
-			"order_id": saga.OrderID,
-			"step":     step,
+// finishCompensation ends the compensation of a saga once no step is left to
+// undo. A saga with a step that could not be undone stays FAILED.
+func finishCompensation(saga *entity.Saga) {
+	if !saga.IsCompensating() || len(saga.CompensationSteps()) > 0 {
+		return
+	}
+	for _, step := range saga.Steps {
+		if step.Status == entity.StepStatusCompensationFailed {
+			saga.SetStatus(entity.SagaStatusFailed)
+			return
 		}
+	}
+	saga.SetStatus(entity.SagaStatusCompensated)
+}
+
+// processStep saves the saga together with the command for the given step
+func (o *SagaOrchestrator) processStep(ctx context.Context, saga *entity.Saga, step *entity.SagaStep) error {
+	msg, err := o.stepMessage(saga, step)
+	if err != nil {
+		return err
+	}
+	saga.StartStep(step.ID, o.stepDeadline(saga, step.Name))
+	return o.sagaRepo.Update(ctx, saga, msg)
+}
+
+// stepMessage builds the outbox message executing a step of the saga
+func (o *SagaOrchestrator) stepMessage(saga *entity.Saga, step *entity.SagaStep) (*outbox.Message, error) {
+	msg := outbox.NewMessage(saga.ID, definition.StepTopic(step.Name), nil)
+	cmd := definition.StepCommand{
+		MessageID: msg.ID,
+		SagaID:    saga.ID,
+		OrderID:   saga.OrderID,
+		Step:      *step,
+	}
+
+	payload, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal step message: %w", err)
+	}
+	msg.Payload = payload
 
-		// Get compensation topic
-		topic := o.getCompensationTopicForStep(step.Name)
+	return msg, nil
+}
 
-		// Publish compensation message
-		msgBytes, err := json.Marshal(msg)
+// compensationMessages builds the outbox messages undoing the completed steps in
+// reverse order and records the start of each compensation
+func (o *SagaOrchestrator) compensationMessages(saga *entity.Saga) ([]*outbox.Message, error) {
+	steps := saga.CompensationSteps()
+	msgs := make([]*outbox.Message, 0, len(steps))
+	for _, step := range steps {
+		msg, err := o.compensationMessage(saga, step)
 		if err != nil {
-			return fmt.Errorf("failed to marshal compensation message: %w", err)
+			return nil, err
 		}
 
-		if err := o.messageBroker.Publish(ctx, topic, msgBytes); err != nil {
-			return err
-		}
+		saga.StartCompensation(step.ID)
+		msgs = append(msgs, msg)
 	}
 
-	return nil
+	return msgs, nil
 }
 
-func (o *SagaOrchestrator) getNextStep(currentStep entity.SagaStep) entity.StepType {
-	switch currentStep.Name {
-	case entity.StepCreateOrder:
-		return entity.StepProcessPayment
-	case entity.StepProcessPayment:
-		return entity.StepUpdateInventory
-	case entity.StepUpdateInventory:
-		return "" // No more steps
-	default:
-		return ""
+// compensationMessage builds the outbox message undoing a step of the saga
+func (o *SagaOrchestrator) compensationMessage(saga *entity.Saga, step *entity.SagaStep) (*outbox.Message, error) {
+	msg := outbox.NewMessage(saga.ID, definition.CompensationTopic(step.Name), nil)
+	cmd := definition.StepCommand{
+		MessageID: msg.ID,
+		SagaID:    saga.ID,
+		OrderID:   saga.OrderID,
+		Step:      *step,
+	}
+
+	payload, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal compensation message: %w", err)
 	}
+	msg.Payload = payload
+
+	return msg, nil
 }
 
-func (o *SagaOrchestrator) getTopicForStep(step entity.StepType) string {
-	return fmt.Sprintf("saga.%s", step)
+func (o *SagaOrchestrator) stepDefinition(saga *entity.Saga, name entity.StepType) (*definition.StepDefinition, error) {
+	def, err := o.registry.Get(saga.Type)
+	if err != nil {
+		return nil, err
+	}
+	return def.Step(name)
 }
 
-func (o *SagaOrchestrator) getCompensationTopicForStep(step entity.StepType) string {
-	return fmt.Sprintf("saga.compensation.%s", step)
+// stepDeadline returns how long an attempt of a step may take: the timeout of
+// its definition, or the saga timeout
+func (o *SagaOrchestrator) stepDeadline(saga *entity.Saga, name entity.StepType) time.Duration {
+	stepDef, err := o.stepDefinition(saga, name)
+	if err != nil {
+		return saga.Timeout
+	}
+	return stepTimeout(saga, stepDef)
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
 }
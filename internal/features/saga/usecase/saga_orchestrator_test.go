@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/outbox"
+)
+
+// leasedSagaRepository holds the lease of a saga for a number of attempts
+type leasedSagaRepository struct {
+	repository.SagaRepository
+	saga     *entity.Saga
+	held     int
+	attempts int
+	released bool
+	updated  bool
+}
+
+func (r *leasedSagaRepository) AcquireLease(ctx context.Context, sagaID uuid.UUID, owner string, ttl time.Duration) (bool, error) {
+	r.attempts++
+	return r.attempts > r.held, nil
+}
+
+func (r *leasedSagaRepository) ReleaseLease(ctx context.Context, sagaID uuid.UUID, owner string) error {
+	r.released = true
+	return nil
+}
+
+func (r *leasedSagaRepository) Update(ctx context.Context, saga *entity.Saga, messages ...*outbox.Message) error {
+	r.updated = true
+	return nil
+}
+
+func (r *leasedSagaRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Saga, error) {
+	return r.saga, nil
+}
+
+func TestSagaOrchestrator_ProcessStepResult_WaitsForLease(t *testing.T) {
+	newSaga := func() *entity.Saga {
+		return entity.NewSaga(entity.SagaTypeOrderPayment, uuid.New(), []entity.SagaStep{
+			{Name: entity.StepCreateOrder},
+		})
+	}
+
+	t.Run("applies the result once the lease is free", func(t *testing.T) {
+		saga := newSaga()
+		repo := &leasedSagaRepository{saga: saga, held: 2}
+		o := NewSagaOrchestrator(repo, definition.NewRegistry(), time.Minute, 3)
+
+		err := o.ProcessStepResult(context.Background(), saga.ID, saga.Steps[0], entity.StepStatusSuccess, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 3, repo.attempts)
+		assert.True(t, repo.updated)
+		assert.True(t, repo.released)
+	})
+
+	t.Run("gives up after the lease TTL", func(t *testing.T) {
+		saga := newSaga()
+		repo := &leasedSagaRepository{saga: saga, held: 100}
+		o := NewSagaOrchestrator(repo, definition.NewRegistry(), time.Minute, 3)
+		o.leaseTTL = leasePollInterval
+
+		err := o.ProcessStepResult(context.Background(), saga.ID, saga.Steps[0], entity.StepStatusSuccess, nil)
+		assert.ErrorIs(t, err, ErrSagaLeased)
+		assert.False(t, repo.updated)
+		assert.False(t, repo.released)
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		saga := newSaga()
+		repo := &leasedSagaRepository{saga: saga, held: 100}
+		o := NewSagaOrchestrator(repo, definition.NewRegistry(), time.Minute, 3)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := o.ProcessStepResult(ctx, saga.ID, saga.Steps[0], entity.StepStatusSuccess, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, repo.updated)
+		assert.Equal(t, 1, repo.attempts)
+	})
+}
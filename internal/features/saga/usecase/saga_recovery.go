@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+)
+
+// SagaRecovery resumes in-process sagas whose executor stopped, e.g. because
+// the process was restarted mid-saga. It runs once at startup and then on
+// every interval.
+type SagaRecovery struct {
+	sagaUsecase *SagaUsecase
+	interval    time.Duration
+}
+
+// NewSagaRecovery creates a new saga recovery
+func NewSagaRecovery(sagaUsecase *SagaUsecase, interval time.Duration) *SagaRecovery {
+	return &SagaRecovery{
+		sagaUsecase: sagaUsecase,
+		interval:    interval,
+	}
+}
+
+// Run recovers sagas until the context is cancelled
+func (r *SagaRecovery) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		resumed, err := r.sagaUsecase.RecoverSagas(ctx)
+		if err != nil {
+			log.Printf("Error recovering sagas: %v", err)
+		} else if resumed > 0 {
+			log.Printf("Resumed %d interrupted sagas", resumed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecoverSagas resumes the in-process sagas left PENDING, PROCESSING or
+// COMPENSATING without a live lease and returns how many were resumed. Running
+// sagas renew their lease, and a saga is resumed only by the instance that
+// takes its expired lease, so two instances never run the same saga.
+func (u *SagaUsecase) RecoverSagas(ctx context.Context) (int, error) {
+	pending, err := u.sagaRepo.GetPendingSagas(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending sagas: %w", err)
+	}
+	compensating, err := u.sagaRepo.GetCompensatingSagas(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get compensating sagas: %w", err)
+	}
+
+	resumed := 0
+	for _, sagaEntity := range append(pending, compensating...) {
+		// Message-driven sagas are driven by the orchestrator loop
+		if sagaEntity.Executor != entity.SagaExecutorInProcess || sagaEntity.IsLeased() {
+			continue
+		}
+
+		acquired, err := u.sagaRepo.AcquireLease(ctx, sagaEntity.ID, u.leaseOwner, u.leaseTTL)
+		if err != nil {
+			return resumed, fmt.Errorf("failed to acquire lease of saga %s: %w", sagaEntity.ID, err)
+		}
+		if !acquired {
+			continue
+		}
+
+		// Resume from the last step stored as finished
+		run := u.executeSaga
+		if sagaEntity.IsCompensating() {
+			run = u.compensateSaga
+		}
+		go u.runLeased(repository.WithActor(context.Background(), repository.ActorRecovery), sagaEntity, run)
+		resumed++
+	}
+
+	return resumed, nil
+}
+
+// runLeased runs fn on a saga whose lease this instance holds. The lease is
+// renewed while fn runs and released when it returns; fn is cancelled if the
+// lease is lost.
+func (u *SagaUsecase) runLeased(ctx context.Context, sagaEntity *entity.Saga, fn func(context.Context, *entity.Saga)) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go u.keepLease(ctx, cancel, sagaEntity.ID)
+	fn(ctx, sagaEntity)
+
+	if ctx.Err() != nil {
+		return
+	}
+	cancel()
+	if err := u.sagaRepo.ReleaseLease(context.WithoutCancel(ctx), sagaEntity.ID, u.leaseOwner); err != nil {
+		log.Printf("Failed to release lease of saga %s: %v", sagaEntity.ID, err)
+	}
+}
+
+// keepLease renews the lease of a saga until ctx is done and cancels it when the lease is lost
+func (u *SagaUsecase) keepLease(ctx context.Context, cancel context.CancelFunc, sagaID uuid.UUID) {
+	ticker := time.NewTicker(u.leaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		renewed, err := u.sagaRepo.RenewLease(ctx, sagaID, u.leaseOwner, u.leaseTTL)
+		if err != nil {
+			// Try again on the next tick; the lease is still valid for a while
+			continue
+		}
+		if !renewed {
+			log.Printf("Lost lease of saga %s, stopping", sagaID)
+			cancel()
+			return
+		}
+	}
+}
+
+// newLeaseOwner returns an identifier unique to this process
+func newLeaseOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8])
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
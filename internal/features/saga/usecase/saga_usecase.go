@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	inventory "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/domain/usecase"
 	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/statemachine"
 	paymentRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/repository"
+	promotion "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/domain/usecase"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,8 +20,19 @@ import (
 	paymentClient "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/delivery/grpc/client"
 	paymentEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/entity"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/definition"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+	"github.com/diki-haryadi/ecommerce-saga/pkg/metrics"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+
+	// defaultLeaseTTL is how long a saga stays with an instance that stops renewing its lease
+	defaultLeaseTTL = 30 * time.Second
 )
 
 var (
@@ -28,142 +43,229 @@ var (
 
 // OrderPaymentPayload represents the payload for order-payment saga
 type OrderPaymentPayload struct {
-	OrderID  uuid.UUID `json:"order_id"`
-	Amount   float64   `json:"amount"`
-	Currency string    `json:"currency"`
+	OrderID uuid.UUID   `json:"order_id"`
+	Amount  money.Money `json:"amount"`
 }
 
 type SagaUsecase struct {
 	sagaRepo      repository.SagaRepository
 	orderRepo     orderRepo.OrderRepository
+	orders        *statemachine.OrderStateMachine
 	paymentRepo   paymentRepo.PaymentRepository
+	inventory     inventory.Usecase
+	promotions    promotion.Usecase
 	orderClient   *orderClient.OrderClient
 	paymentClient *paymentClient.PaymentClient
 	cartClient    *cartClient.CartClient
+	registry      *definition.Registry
+	leaseOwner    string
+	leaseTTL      time.Duration
 }
 
 func NewSagaUsecase(
 	sagaRepo repository.SagaRepository,
 	orderRepo orderRepo.OrderRepository,
 	paymentRepo paymentRepo.PaymentRepository,
+	inventory inventory.Usecase,
+	promotions promotion.Usecase,
 	orderClient *orderClient.OrderClient,
 	paymentClient *paymentClient.PaymentClient,
 	cartClient *cartClient.CartClient,
 ) *SagaUsecase {
-	return &SagaUsecase{
+	u := &SagaUsecase{
 		sagaRepo:      sagaRepo,
 		orderRepo:     orderRepo,
+		orders:        statemachine.NewOrderStateMachine(orderRepo),
 		paymentRepo:   paymentRepo,
+		inventory:     inventory,
+		promotions:    promotions,
 		orderClient:   orderClient,
 		paymentClient: paymentClient,
 		cartClient:    cartClient,
+		registry:      definition.NewRegistry(),
+		leaseOwner:    newLeaseOwner(),
+		leaseTTL:      defaultLeaseTTL,
 	}
+
+	// Bind the in-process step handlers
+	u.registry.Bind(entity.StepCreateOrder, u.executeCreateOrder, u.compensateCreateOrder)
+	u.registry.Bind(entity.StepProcessPayment, u.executeProcessPayment, u.compensateProcessPayment)
+	u.registry.Bind(entity.StepUpdateInventory, u.executeUpdateInventory, u.compensateUpdateInventory)
+	u.registry.OnComplete(entity.SagaTypeOrderPayment, u.completeOrderPayment)
+
+	return u
+}
+
+// Registry returns the saga definitions executed by this usecase
+func (u *SagaUsecase) Registry() *definition.Registry {
+	return u.registry
+}
+
+// SetLeaseTTL sets how long a saga stays with this instance without a lease renewal
+func (u *SagaUsecase) SetLeaseTTL(ttl time.Duration) {
+	u.leaseTTL = ttl
 }
 
 // StartOrderSaga starts a new order saga transaction
-func (u *SagaUsecase) StartOrderSaga(ctx context.Context, orderID, userID uuid.UUID, amount float64, paymentMethod string, metadata map[string]string) (*saga.SagaResponse, error) {
+func (u *SagaUsecase) StartOrderSaga(ctx context.Context, orderID, userID uuid.UUID, amount money.Money, paymentMethod string, metadata map[string]string) (*saga.SagaResponse, error) {
 	// Create payload
 	payload := OrderPaymentPayload{
-		OrderID:  orderID,
-		Amount:   amount,
-		Currency: "USD",
+		OrderID: orderID,
+		Amount:  amount,
 	}
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create saga steps
-	steps := []entity.SagaStep{
-		{
-			Name:    entity.StepCreateOrder,
-			Payload: payloadBytes,
-		},
-		{
-			Name:    entity.StepProcessPayment,
-			Payload: payloadBytes,
-		},
-		{
-			Name:    entity.StepUpdateInventory,
-			Payload: payloadBytes,
-		},
+	// Create saga steps from the definition
+	def, err := u.registry.Get(entity.SagaTypeOrderPayment)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create saga
-	sagaEntity := entity.NewSaga(entity.SagaTypeOrderPayment, steps)
-	if err := u.sagaRepo.Create(ctx, sagaEntity); err != nil {
+	sagaEntity := entity.NewSaga(def.Type, orderID, def.NewSteps(payloadBytes))
+	sagaEntity.SetStatus(entity.SagaStatusProcessing)
+	sagaEntity.Lease(u.leaseOwner, u.leaseTTL)
+	if err := u.sagaRepo.Create(repository.WithActor(ctx, repository.ActorAPI), sagaEntity); err != nil {
 		return nil, err
 	}
 
 	// Start saga execution
-	go u.executeSaga(context.Background(), sagaEntity)
+	go u.runLeased(repository.WithActor(context.Background(), repository.ActorExecutor), sagaEntity, u.executeSaga)
 
 	// Convert to response
-	return &saga.SagaResponse{
-		ID:        sagaEntity.ID,
-		Type:      saga.Type(string(sagaEntity.Type)),
-		Status:    saga.Status(string(sagaEntity.Status)),
-		Steps:     convertStepsToResponse(sagaEntity.Steps),
-		Metadata:  metadata,
-		CreatedAt: sagaEntity.CreatedAt,
-		UpdatedAt: sagaEntity.UpdatedAt,
-	}, nil
+	return toSagaResponse(sagaEntity, metadata), nil
 }
 
 // GetSagaStatus retrieves the status of a saga transaction
 func (u *SagaUsecase) GetSagaStatus(ctx context.Context, sagaID uuid.UUID) (*saga.SagaResponse, error) {
 	sagaEntity, err := u.sagaRepo.GetByID(ctx, sagaID)
 	if err != nil {
+		if errors.Is(err, repository.ErrSagaNotFound) {
+			return nil, saga.ErrNotFound
+		}
 		return nil, err
 	}
 
-	return &saga.SagaResponse{
-		ID:        sagaEntity.ID,
-		Type:      saga.Type(string(sagaEntity.Type)),
-		Status:    saga.Status(string(sagaEntity.Status)),
-		Steps:     convertStepsToResponse(sagaEntity.Steps),
-		Metadata:  make(map[string]string),
-		CreatedAt: sagaEntity.CreatedAt,
-		UpdatedAt: sagaEntity.UpdatedAt,
-	}, nil
+	return toSagaResponse(sagaEntity, nil), nil
 }
 
-// executeSaga executes a saga
+// executeSaga executes a saga from its first unfinished step. It stops without
+// saving anything once ctx is cancelled, e.g. because the lease was lost.
 func (u *SagaUsecase) executeSaga(ctx context.Context, saga *entity.Saga) {
+	def, err := u.registry.Get(saga.Type)
+	if err != nil {
+		return
+	}
+
 	for {
+		if ctx.Err() != nil {
+			return
+		}
+
 		step := saga.GetNextStep()
 		if step == nil {
 			break
 		}
 
-		var err error
-		switch step.Name {
-		case entity.StepCreateOrder:
-			err = u.executeCreateOrder(ctx, step)
-		case entity.StepProcessPayment:
-			err = u.executeProcessPayment(ctx, step)
-		case entity.StepUpdateInventory:
-			err = u.executeUpdateInventory(ctx, step)
+		// A resumed saga may be waiting for the backoff delay of a retry
+		if !step.IsRetryDue() {
+			if err := sleep(ctx, time.Until(*step.NextAttemptAt)); err != nil {
+				return
+			}
 		}
 
+		stepDef, err := def.Step(step.Name)
 		if err != nil {
-			u.handleStepFailure(ctx, saga, step, err)
+			u.handleStepFailure(ctx, saga, step, definition.Permanent(err))
 			return
 		}
 
-		step.Status = entity.StepStatusCompleted
-		if err := u.sagaRepo.UpdateStepStatus(ctx, saga.ID, step.ID, entity.StepStatusCompleted, ""); err != nil {
+		timeout := stepTimeout(saga, stepDef)
+		saga.StartStep(step.ID, timeout)
+		u.sagaRepo.Update(ctx, saga)
+
+		if err := u.runStep(ctx, saga, step, stepDef.Action, timeout); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				saga.UpdateStepStatus(step.ID, entity.StepStatusTimedOut, ErrStepTimeout.Error())
+				metrics.SagaStepTimeouts.WithLabelValues(string(step.Name)).Inc()
+				err = ErrStepTimeout
+			}
+			if !definition.IsRetryable(err) || !stepDef.Retry.CanRetry(step.Retries) {
+				u.handleStepFailure(ctx, saga, step, err)
+				return
+			}
+			if err := u.scheduleRetry(ctx, saga, step, stepDef.Retry, err); err != nil {
+				u.handleStepFailure(ctx, saga, step, err)
+				return
+			}
+			continue
+		}
+
+		saga.UpdateStepStatus(step.ID, entity.StepStatusCompleted, "")
+		if err := u.sagaRepo.Update(ctx, saga); err != nil {
 			// Log error but continue
 			continue
 		}
 	}
+
+	// The saga stays PROCESSING and is completed when it is recovered
+	if err := def.Complete(ctx, saga); err != nil {
+		return
+	}
+	saga.SetStatus(entity.SagaStatusCompleted)
+	u.sagaRepo.Update(ctx, saga)
+}
+
+// scheduleRetry records the failed attempt and waits for the backoff delay
+// before the step is run again. The step stays PENDING because this goroutine,
+// not the orchestrator loop, performs the next attempt.
+func (u *SagaUsecase) scheduleRetry(ctx context.Context, saga *entity.Saga, step *entity.SagaStep, policy definition.RetryPolicy, stepErr error) error {
+	delay := policy.Backoff(step.Retries + 1)
+	saga.ScheduleStepRetry(step.ID, entity.StepStatusPending, time.Now().Add(delay), stepErr.Error())
+	if err := u.sagaRepo.Update(ctx, saga); err != nil {
+		return err
+	}
+
+	return sleep(ctx, delay)
+}
+
+// stepTimeout returns the deadline of a step: the timeout of its definition, or the saga timeout
+func stepTimeout(saga *entity.Saga, stepDef *definition.StepDefinition) time.Duration {
+	if stepDef.Timeout > 0 {
+		return stepDef.Timeout
+	}
+	return saga.Timeout
+}
+
+// runStep runs a step handler bounded by the step timeout
+func (u *SagaUsecase) runStep(ctx context.Context, saga *entity.Saga, step *entity.SagaStep, handler definition.StepHandler, timeout time.Duration) error {
+	if handler == nil {
+		return definition.ErrMissingAction
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return handler(ctx, &definition.StepCommand{
+		SagaID:  saga.ID,
+		OrderID: saga.OrderID,
+		Step:    *step,
+	})
 }
 
 // executeCreateOrder executes the CreateOrder step
-func (u *SagaUsecase) executeCreateOrder(ctx context.Context, step *entity.SagaStep) error {
+func (u *SagaUsecase) executeCreateOrder(ctx context.Context, cmd *definition.StepCommand) error {
 	var payload OrderPaymentPayload
-	if err := json.Unmarshal(step.Payload, &payload); err != nil {
-		return err
+	if err := json.Unmarshal(cmd.Step.Payload, &payload); err != nil {
+		return definition.Permanent(err)
 	}
 
 	order, err := u.orderRepo.GetByID(ctx, payload.OrderID)
@@ -171,27 +273,41 @@ func (u *SagaUsecase) executeCreateOrder(ctx context.Context, step *entity.SagaS
 		return err
 	}
 	if order == nil {
-		return errors.New("order not found")
+		return definition.Permanent(errors.New("order not found"))
 	}
 
 	if order.Status != orderEntity.OrderStatusPending {
-		return errors.New("invalid order status")
+		return definition.Permanent(errors.New("invalid order status"))
 	}
 
-	return nil
+	// Count the order's coupons against their usage limits
+	err = u.promotions.ReserveRedemptions(ctx, order.ID, order.UserID, DiscountLines(order.Discounts))
+	if err != nil {
+		return PromotionError(err)
+	}
+
+	return ConfirmOrder(ctx, u.orders, order)
 }
 
 // executeProcessPayment executes the ProcessPayment step
-func (u *SagaUsecase) executeProcessPayment(ctx context.Context, step *entity.SagaStep) error {
+func (u *SagaUsecase) executeProcessPayment(ctx context.Context, cmd *definition.StepCommand) error {
 	var payload OrderPaymentPayload
-	if err := json.Unmarshal(step.Payload, &payload); err != nil {
+	if err := json.Unmarshal(cmd.Step.Payload, &payload); err != nil {
+		return definition.Permanent(err)
+	}
+
+	// The order is charged its total in the currency it was placed in
+	order, err := u.orderRepo.GetByID(ctx, payload.OrderID)
+	if err != nil {
 		return err
 	}
+	if order == nil {
+		return definition.Permanent(errors.New("order not found"))
+	}
 
 	payment := paymentEntity.NewPayment(
-		payload.OrderID,
-		payload.Amount,
-		payload.Currency,
+		order.ID,
+		order.TotalAmount,
 		paymentEntity.PaymentProviderStripe,
 	)
 
@@ -208,57 +324,111 @@ func (u *SagaUsecase) executeProcessPayment(ctx context.Context, step *entity.Sa
 	return u.paymentRepo.Update(ctx, payment)
 }
 
-// executeUpdateInventory executes the UpdateInventory step
-func (u *SagaUsecase) executeUpdateInventory(ctx context.Context, step *entity.SagaStep) error {
+// executeUpdateInventory executes the UpdateInventory step by reserving the stock of the order items
+func (u *SagaUsecase) executeUpdateInventory(ctx context.Context, cmd *definition.StepCommand) error {
 	var payload OrderPaymentPayload
-	if err := json.Unmarshal(step.Payload, &payload); err != nil {
+	if err := json.Unmarshal(cmd.Step.Payload, &payload); err != nil {
+		return definition.Permanent(err)
+	}
+
+	order, err := u.orderRepo.GetByID(ctx, payload.OrderID)
+	if err != nil {
 		return err
 	}
 
-	// Implementation of updating inventory
-	return nil
+	_, err = u.inventory.ReserveStock(ctx, order.ID, order.UserID, ReservationItems(order.Items))
+	return InventoryError(err)
 }
 
-// handleStepFailure handles a step failure
+// handleStepFailure handles a step failure. The saga is stored as COMPENSATING
+// before the compensation runs, so that it is resumed if the process stops.
 func (u *SagaUsecase) handleStepFailure(ctx context.Context, saga *entity.Saga, step *entity.SagaStep, err error) {
-	// Update step status
-	u.sagaRepo.UpdateStepStatus(ctx, saga.ID, step.ID, entity.StepStatusFailed, err.Error())
+	// Update step status; a timed out step keeps its status so that it is compensated
+	if step.Status != entity.StepStatusTimedOut {
+		saga.UpdateStepStatus(step.ID, entity.StepStatusFailed, err.Error())
+	}
+	saga.Fail(err)
+	if len(saga.CompensationSteps()) > 0 {
+		saga.SetStatus(entity.SagaStatusCompensating)
+	}
+	if err := u.sagaRepo.Update(ctx, saga); err != nil {
+		return
+	}
 
 	// Start compensation
-	go u.compensateSaga(context.Background(), saga)
+	u.compensateSaga(ctx, saga)
 }
 
-// compensateSaga compensates a failed saga
+// compensateSaga compensates a failed saga. Steps whose compensation fails stay
+// COMPLETED and are compensated again when the saga is recovered.
 func (u *SagaUsecase) compensateSaga(ctx context.Context, saga *entity.Saga) {
-	// Reverse through completed steps
-	for i := len(saga.Steps) - 1; i >= 0; i-- {
-		step := &saga.Steps[i]
-		if step.Status != entity.StepStatusCompleted {
-			continue
-		}
+	def, err := u.registry.Get(saga.Type)
+	if err != nil {
+		return
+	}
+
+	steps := saga.CompensationSteps()
+	if len(steps) == 0 && !saga.IsCompensating() {
+		return
+	}
+	if !saga.IsCompensating() {
+		saga.SetStatus(entity.SagaStatusCompensating)
+		u.sagaRepo.Update(ctx, saga)
+	}
 
-		var err error
-		switch step.Name {
-		case entity.StepProcessPayment:
-			err = u.compensateProcessPayment(ctx, step)
-		case entity.StepUpdateInventory:
-			err = u.compensateUpdateInventory(ctx, step)
+	// Reverse through completed steps
+	for _, step := range steps {
+		if ctx.Err() != nil {
+			return
 		}
 
+		stepDef, err := def.Step(step.Name)
 		if err != nil {
-			// Log error but continue compensation
 			continue
 		}
 
-		step.Status = entity.StepStatusCompensated
-		u.sagaRepo.UpdateStepStatus(ctx, saga.ID, step.ID, entity.StepStatusCompensated, "")
+		if stepDef.HasCompensation() {
+			saga.StartCompensation(step.ID)
+			if err := u.runStep(ctx, saga, step, stepDef.Compensation, stepTimeout(saga, stepDef)); err != nil {
+				// Log error but continue compensation
+				continue
+			}
+		}
+
+		saga.UpdateStepStatus(step.ID, entity.StepStatusCompensated, "")
+		u.sagaRepo.Update(ctx, saga)
+	}
+
+	if ctx.Err() == nil && len(saga.CompensationSteps()) == 0 {
+		saga.SetStatus(entity.SagaStatusCompensated)
+		u.sagaRepo.Update(ctx, saga)
 	}
 }
 
-// compensateProcessPayment compensates the ProcessPayment step
-func (u *SagaUsecase) compensateProcessPayment(ctx context.Context, step *entity.SagaStep) error {
+// compensateCreateOrder compensates the CreateOrder step by giving the order's
+// coupon uses back and cancelling the order
+func (u *SagaUsecase) compensateCreateOrder(ctx context.Context, cmd *definition.StepCommand) error {
 	var payload OrderPaymentPayload
-	if err := json.Unmarshal(step.Payload, &payload); err != nil {
+	if err := json.Unmarshal(cmd.Step.Payload, &payload); err != nil {
+		return err
+	}
+
+	if err := u.promotions.ReleaseRedemptions(ctx, payload.OrderID); err != nil {
+		return err
+	}
+
+	sagaEntity, err := u.sagaRepo.GetByID(ctx, cmd.SagaID)
+	if err != nil {
+		return err
+	}
+	return CancelFailedOrder(ctx, u.orders, payload.OrderID, sagaEntity)
+}
+
+// compensateProcessPayment compensates the ProcessPayment step. A payment that
+// went through is refunded by the payment service; one still open is marked failed.
+func (u *SagaUsecase) compensateProcessPayment(ctx context.Context, cmd *definition.StepCommand) error {
+	var payload OrderPaymentPayload
+	if err := json.Unmarshal(cmd.Step.Payload, &payload); err != nil {
 		return err
 	}
 
@@ -267,6 +437,17 @@ func (u *SagaUsecase) compensateProcessPayment(ctx context.Context, step *entity
 		return err
 	}
 
+	switch {
+	case payment == nil:
+		// The step was stopped before the payment was made
+		return nil
+	case payment.Status == paymentEntity.PaymentStatusSuccess && u.paymentClient != nil:
+		_, err := u.paymentClient.RefundPayment(ctx, payment.ID.String(), payment.Amount, "Payment compensated due to saga failure")
+		return err
+	case payment.Status == paymentEntity.PaymentStatusRefunded:
+		return nil
+	}
+
 	payment.Status = paymentEntity.PaymentStatusFailed
 	payment.ErrorMessage = "Payment compensated due to saga failure"
 	payment.UpdatedAt = time.Now()
@@ -274,42 +455,153 @@ func (u *SagaUsecase) compensateProcessPayment(ctx context.Context, step *entity
 	return u.paymentRepo.Update(ctx, payment)
 }
 
-// compensateUpdateInventory compensates the UpdateInventory step
-func (u *SagaUsecase) compensateUpdateInventory(ctx context.Context, step *entity.SagaStep) error {
+// compensateUpdateInventory compensates the UpdateInventory step by giving the
+// stock reserved, or sold to an order cancelled after payment, back
+func (u *SagaUsecase) compensateUpdateInventory(ctx context.Context, cmd *definition.StepCommand) error {
 	var payload OrderPaymentPayload
-	if err := json.Unmarshal(step.Payload, &payload); err != nil {
+	if err := json.Unmarshal(cmd.Step.Payload, &payload); err != nil {
 		return err
 	}
 
-	// Implementation of updating inventory
-	return nil
+	return u.inventory.CancelStock(ctx, payload.OrderID)
+}
+
+// completeOrderPayment commits the stock and the coupon uses reserved for the
+// order of a completed saga and moves the order on to PROCESSING
+func (u *SagaUsecase) completeOrderPayment(ctx context.Context, saga *entity.Saga) error {
+	if err := u.inventory.CommitStock(ctx, saga.OrderID); err != nil {
+		return err
+	}
+	if err := u.promotions.CommitRedemptions(ctx, saga.OrderID); err != nil {
+		return err
+	}
+	return ProcessOrder(ctx, u.orders, saga.OrderID)
 }
 
 // CompensateTransaction initiates compensation for a saga transaction
 func (u *SagaUsecase) CompensateTransaction(ctx context.Context, sagaID, stepID uuid.UUID, reason string) (*saga.SagaResponse, error) {
 	sagaEntity, err := u.sagaRepo.GetByID(ctx, sagaID)
 	if err != nil {
+		if errors.Is(err, repository.ErrSagaNotFound) {
+			return nil, saga.ErrNotFound
+		}
 		return nil, err
 	}
 
+	// Only the instance holding the lease may change the saga
+	acquired, err := u.sagaRepo.AcquireLease(ctx, sagaEntity.ID, u.leaseOwner, u.leaseTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, saga.ErrSagaBusy
+	}
+
 	// Start compensation process
-	go u.compensateSaga(context.Background(), sagaEntity)
+	go u.runLeased(repository.WithActor(context.Background(), repository.ActorAPI), sagaEntity, u.compensateSaga)
+
+	return toSagaResponse(sagaEntity, nil), nil
+}
+
+// ListSagaTransactions retrieves a page of saga transactions
+func (u *SagaUsecase) ListSagaTransactions(ctx context.Context, filter saga.ListFilter) (*saga.ListResult, error) {
+	sortBy, err := repository.ParseSortField(filter.SortBy)
+	if err != nil {
+		return nil, saga.ErrInvalidFilter
+	}
 
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	repoFilter := repository.ListFilter{
+		Status:      entity.SagaStatus(strings.ToUpper(filter.Status)),
+		Type:        entity.SagaType(filter.Type),
+		OrderID:     filter.OrderID,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		SortBy:      sortBy,
+		SortDesc:    filter.SortDesc,
+		Limit:       limit + 1,
+	}
+	if filter.Cursor != "" {
+		cursor, err := repository.DecodeCursor(filter.Cursor)
+		if err != nil || cursor.SortBy != sortBy {
+			return nil, saga.ErrInvalidFilter
+		}
+		repoFilter.After = cursor
+	}
+
+	sagas, total, err := u.sagaRepo.List(ctx, repoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	// The extra row tells whether there is a next page
+	result := &saga.ListResult{Total: total}
+	if len(sagas) > limit {
+		sagas = sagas[:limit]
+		result.NextCursor = repository.CursorFor(sagas[limit-1], sortBy).Encode()
+	}
+
+	result.Sagas = make([]*saga.SagaResponse, len(sagas))
+	for i, sagaEntity := range sagas {
+		result.Sagas[i] = toSagaResponse(sagaEntity, nil)
+	}
+	return result, nil
+}
+
+// GetSagaTimeline retrieves the ordered history of a saga transaction
+func (u *SagaUsecase) GetSagaTimeline(ctx context.Context, sagaID uuid.UUID) ([]*saga.SagaEvent, error) {
+	if _, err := u.sagaRepo.GetByID(ctx, sagaID); err != nil {
+		if errors.Is(err, repository.ErrSagaNotFound) {
+			return nil, saga.ErrNotFound
+		}
+		return nil, err
+	}
+
+	events, err := u.sagaRepo.GetEvents(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]*saga.SagaEvent, len(events))
+	for i, event := range events {
+		timeline[i] = &saga.SagaEvent{
+			Sequence:   event.Sequence,
+			Type:       string(event.Type),
+			StepID:     event.StepID,
+			StepName:   string(event.StepName),
+			FromStatus: event.FromStatus,
+			ToStatus:   event.ToStatus,
+			Attempt:    event.Attempt,
+			Actor:      event.Actor,
+			Error:      event.Error,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	return timeline, nil
+}
+
+func toSagaResponse(sagaEntity *entity.Saga, metadata map[string]string) *saga.SagaResponse {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
 	return &saga.SagaResponse{
 		ID:        sagaEntity.ID,
 		Type:      saga.Type(string(sagaEntity.Type)),
+		OrderID:   sagaEntity.OrderID,
 		Status:    saga.Status(string(sagaEntity.Status)),
+		Error:     sagaEntity.Error,
 		Steps:     convertStepsToResponse(sagaEntity.Steps),
-		Metadata:  make(map[string]string),
+		Metadata:  metadata,
 		CreatedAt: sagaEntity.CreatedAt,
 		UpdatedAt: sagaEntity.UpdatedAt,
-	}, nil
-}
-
-// ListSagaTransactions retrieves a list of saga transactions
-func (u *SagaUsecase) ListSagaTransactions(ctx context.Context, page, limit int32, status string, sagaType saga.Type) ([]*saga.SagaResponse, int64, error) {
-	// TODO: Implement pagination and filtering
-	return nil, 0, nil
+	}
 }
 
 func convertStepsToResponse(steps []entity.SagaStep) []saga.SagaStep {
@@ -324,7 +616,8 @@ func convertStepsToResponse(steps []entity.SagaStep) []saga.SagaStep {
 			CompensationAction: "compensate_" + string(step.Name),
 			Payload:            make(map[string]interface{}),
 			ErrorMessage:       step.ErrorMessage,
-			ExecutedAt:         step.CreatedAt,
+			Retries:            step.Retries,
+			ExecutedAt:         step.UpdatedAt,
 		}
 	}
 	return result
@@ -332,12 +625,14 @@ func convertStepsToResponse(steps []entity.SagaStep) []saga.SagaStep {
 
 // StartOrderPaymentSaga starts a new order-payment saga transaction
 func (u *SagaUsecase) StartOrderPaymentSaga(ctx context.Context, orderID uuid.UUID) error {
-	// Default values for demonstration
-	amount := 0.0           // This should be fetched from the order
-	userID := uuid.New()    // This should be fetched from the order
+	order, err := u.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
 	paymentMethod := "card" // This should be fetched from the order
 	metadata := make(map[string]string)
 
-	_, err := u.StartOrderSaga(ctx, orderID, userID, amount, paymentMethod, metadata)
+	_, err = u.StartOrderSaga(ctx, order.ID, order.UserID, order.TotalAmount, paymentMethod, metadata)
 	return err
 }
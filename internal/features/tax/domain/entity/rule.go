@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// Rounding tells when tax amounts are rounded to minor units
+type Rounding string
+
+const (
+	// RoundingPerLine rounds the tax of every order line
+	RoundingPerLine Rounding = "LINE"
+	// RoundingPerOrder rounds the tax of all lines taxed by a rule once
+	RoundingPerOrder Rounding = "ORDER"
+)
+
+// Rule is the tax rate of a country. A rule with a Region only applies there
+// and one with a Category only to products of that tax category; the most
+// specific rule that applies to a line wins.
+type Rule struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Country   string    `json:"country" gorm:"type:varchar(2);not null"`
+	Region    string    `json:"region" gorm:"type:varchar(50);not null;default:''"`
+	Category  string    `json:"category" gorm:"type:varchar(50);not null;default:''"`
+	Name      string    `json:"name" gorm:"type:varchar(100);not null"`
+	Rate      float64   `json:"rate" gorm:"type:numeric(7,4);not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the tax rule table name
+func (Rule) TableName() string {
+	return "tax_rules"
+}
+
+// NewRule creates a new tax rule of rate percent
+func NewRule(country, region, category, name string, rate float64) *Rule {
+	return &Rule{
+		ID:        uuid.New(),
+		Country:   NormalizeCountry(country),
+		Region:    NormalizeRegion(region),
+		Category:  strings.TrimSpace(category),
+		Name:      name,
+		Rate:      rate,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// NormalizeCountry returns the ISO 3166 country code as rules store it
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+// NormalizeRegion returns the region code as rules store it
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+// Applies tells whether the rule taxes a product category in a region of its country
+func (r *Rule) Applies(region, category string) bool {
+	return (r.Region == "" || r.Region == region) && (r.Category == "" || r.Category == category)
+}
+
+// specificity ranks the rules that apply to a line; a region outranks a category
+func (r *Rule) specificity() int {
+	rank := 0
+	if r.Region != "" {
+		rank += 2
+	}
+	if r.Category != "" {
+		rank++
+	}
+	return rank
+}
+
+// Tax returns the tax of an amount at the rate of the rule: on top of the
+// amount, or contained in it when prices include tax
+func (r *Rule) Tax(amount money.Money, inclusive bool) money.Money {
+	rate, _ := new(big.Rat).SetString(strconv.FormatFloat(r.Rate, 'f', -1, 64))
+	base := big.NewRat(100, 1)
+	if inclusive {
+		base.Add(base, rate)
+	}
+	return amount.MulRat(rate.Quo(rate, base), money.RoundHalfUp)
+}
+
+// Match returns the most specific rule that applies to a product category in
+// a region, nil if none does
+func Match(rules []*Rule, region, category string) *Rule {
+	var match *Rule
+	for _, rule := range rules {
+		if !rule.Applies(region, category) {
+			continue
+		}
+		if match == nil || rule.specificity() > match.specificity() {
+			match = rule
+		}
+	}
+	return match
+}
+
+// Line is an amount to tax: what is paid for the products of an order line
+type Line struct {
+	Category string
+	Amount   money.Money
+}
+
+// TaxLine is the tax charged by a rule on the lines it applies to
+type TaxLine struct {
+	Rule    *Rule
+	Taxable money.Money
+	Amount  money.Money
+}
+
+// Calculate returns the tax of the lines in a region at the rules of its
+// country, one tax line per rule that applies, in the order of the lines
+func Calculate(rules []*Rule, region string, lines []Line, inclusive bool, rounding Rounding) ([]TaxLine, error) {
+	region = NormalizeRegion(region)
+
+	taxes := make([]TaxLine, 0)
+	index := make(map[uuid.UUID]int)
+	for _, line := range lines {
+		rule := Match(rules, region, line.Category)
+		if rule == nil {
+			continue
+		}
+
+		i, ok := index[rule.ID]
+		if !ok {
+			i = len(taxes)
+			index[rule.ID] = i
+			taxes = append(taxes, TaxLine{Rule: rule})
+		}
+
+		var err error
+		if taxes[i].Taxable, err = taxes[i].Taxable.Add(line.Amount); err != nil {
+			return nil, err
+		}
+		if rounding != RoundingPerOrder {
+			if taxes[i].Amount, err = taxes[i].Amount.Add(rule.Tax(line.Amount, inclusive)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// All lines of a tax line share its rate, so taxing their sum rounds once
+	if rounding == RoundingPerOrder {
+		for i := range taxes {
+			taxes[i].Amount = taxes[i].Rule.Tax(taxes[i].Taxable, inclusive)
+		}
+	}
+	return taxes, nil
+}
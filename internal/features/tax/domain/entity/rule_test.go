@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+func TestMatch(t *testing.T) {
+	country := NewRule("us", "", "", "US", 5)
+	state := NewRule("US", "ny", "", "New York", 8.875)
+	clothing := NewRule("US", "NY", "clothing", "New York clothing", 4)
+	books := NewRule("US", "", "books", "Books", 0)
+	rules := []*Rule{country, state, clothing, books}
+
+	assert.Same(t, state, Match(rules, "NY", "standard"))
+	assert.Same(t, clothing, Match(rules, "NY", "clothing"))
+	assert.Same(t, state, Match(rules, "NY", "books"))
+	assert.Same(t, books, Match(rules, "CA", "books"))
+	assert.Same(t, country, Match(rules, "CA", "standard"))
+	assert.Nil(t, Match([]*Rule{state}, "CA", "standard"))
+}
+
+func TestCalculate(t *testing.T) {
+	standard := NewRule("GB", "", "", "VAT", 20)
+	reduced := NewRule("GB", "", "children", "VAT reduced", 5)
+	rules := []*Rule{standard, reduced}
+	lines := []Line{
+		{Category: "standard", Amount: money.New(333, money.GBP)},
+		{Category: "children", Amount: money.New(1000, money.GBP)},
+		{Category: "standard", Amount: money.New(333, money.GBP)},
+	}
+
+	// 20% of 3.33 rounds to 0.67 on each line
+	taxes, err := Calculate(rules, "", lines, false, RoundingPerLine)
+	require.NoError(t, err)
+	require.Len(t, taxes, 2)
+	assert.Same(t, standard, taxes[0].Rule)
+	assert.Equal(t, money.New(666, money.GBP), taxes[0].Taxable)
+	assert.Equal(t, money.New(134, money.GBP), taxes[0].Amount)
+	assert.Equal(t, money.New(50, money.GBP), taxes[1].Amount)
+
+	// 20% of 6.66 rounds once to 1.33
+	taxes, err = Calculate(rules, "", lines, false, RoundingPerOrder)
+	require.NoError(t, err)
+	assert.Equal(t, money.New(133, money.GBP), taxes[0].Amount)
+
+	// Prices including 20% VAT contain a sixth of tax
+	taxes, err = Calculate(rules, "", lines[:1], true, RoundingPerLine)
+	require.NoError(t, err)
+	assert.Equal(t, money.New(56, money.GBP), taxes[0].Amount)
+
+	// Lines without a rule are not taxed
+	taxes, err = Calculate([]*Rule{reduced}, "", lines[:1], false, RoundingPerLine)
+	require.NoError(t, err)
+	assert.Empty(t, taxes)
+}
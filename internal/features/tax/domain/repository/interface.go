@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/entity"
+)
+
+// RuleRepository defines the interface for tax rule persistence
+type RuleRepository interface {
+	// Create saves a new tax rule
+	Create(ctx context.Context, rule *entity.Rule) error
+
+	// ListByCountry retrieves the tax rules of a country
+	ListByCountry(ctx context.Context, country string) ([]*entity.Rule, error)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// TaxCalculator calculates the taxes of an order
+type TaxCalculator interface {
+	Calculate(ctx context.Context, location Location, lines []Line) (*Result, error)
+}
+
+// Location is where the goods of an order are delivered, which decides the
+// rules that tax them. Country is an ISO 3166 code; Region is optional.
+type Location struct {
+	Country string
+	Region  string
+}
+
+// Line is an order line to tax. Amount is what is paid for the line, after
+// discounts.
+type Line struct {
+	ProductID uuid.UUID
+	Category  string
+	Amount    money.Money
+}
+
+// TaxLine is the tax charged at one rate on the lines it applies to
+type TaxLine struct {
+	Name     string      `json:"name"`
+	Country  string      `json:"country"`
+	Region   string      `json:"region,omitempty"`
+	Category string      `json:"category,omitempty"`
+	Rate     float64     `json:"rate"`
+	Taxable  money.Money `json:"taxable"`
+	Amount   money.Money `json:"amount"`
+}
+
+// Result holds the taxes of an order. Inclusive tells whether they are
+// contained in the prices or charged on top of them.
+type Result struct {
+	Lines     []TaxLine   `json:"lines"`
+	Total     money.Money `json:"total"`
+	Inclusive bool        `json:"inclusive"`
+}
+
+// Common errors
+var (
+	ErrInvalidCountry = NewError("country is required")
+)
+
+// Error represents a tax error
+type Error struct {
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// NewError creates a new tax error
+func NewError(message string) *Error {
+	return &Error{message: message}
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/repository"
+)
+
+// RuleRepository implements the repository.RuleRepository interface
+type RuleRepository struct {
+	db *gorm.DB
+}
+
+// NewRuleRepository creates a new PostgreSQL tax rule repository
+func NewRuleRepository(db *gorm.DB) repository.RuleRepository {
+	return &RuleRepository{
+		db: db,
+	}
+}
+
+// Create saves a new tax rule
+func (r *RuleRepository) Create(ctx context.Context, rule *entity.Rule) error {
+	return r.db.WithContext(ctx).Create(rule).Error
+}
+
+// ListByCountry retrieves the tax rules of a country
+func (r *RuleRepository) ListByCountry(ctx context.Context, country string) ([]*entity.Rule, error) {
+	var rules []*entity.Rule
+	if err := r.db.WithContext(ctx).Where("country = ?", country).Order("created_at").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/entity"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/repository"
+	"github.com/diki-haryadi/ecommerce-saga/internal/features/tax/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// RuleCalculator calculates taxes at the rules of the destination country
+type RuleCalculator struct {
+	ruleRepo  repository.RuleRepository
+	inclusive bool
+	rounding  entity.Rounding
+}
+
+// NewRuleCalculator creates a new rule-based tax calculator. Prices include
+// tax when inclusive is set; tax is rounded per line unless rounding is
+// entity.RoundingPerOrder.
+func NewRuleCalculator(ruleRepo repository.RuleRepository, inclusive bool, rounding entity.Rounding) *RuleCalculator {
+	return &RuleCalculator{
+		ruleRepo:  ruleRepo,
+		inclusive: inclusive,
+		rounding:  rounding,
+	}
+}
+
+// Calculate returns the taxes of the lines delivered to a location
+func (c *RuleCalculator) Calculate(ctx context.Context, location usecase.Location, lines []usecase.Line) (*usecase.Result, error) {
+	country := entity.NormalizeCountry(location.Country)
+	if country == "" {
+		return nil, usecase.ErrInvalidCountry
+	}
+
+	rules, err := c.ruleRepo.ListByCountry(ctx, country)
+	if err != nil {
+		return nil, err
+	}
+
+	taxLines := make([]entity.Line, len(lines))
+	for i, line := range lines {
+		taxLines[i] = entity.Line{
+			Category: line.Category,
+			Amount:   line.Amount,
+		}
+	}
+	taxes, err := entity.Calculate(rules, location.Region, taxLines, c.inclusive, c.rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &usecase.Result{
+		Lines:     make([]usecase.TaxLine, len(taxes)),
+		Inclusive: c.inclusive,
+	}
+	for i, tax := range taxes {
+		result.Lines[i] = usecase.TaxLine{
+			Name:     tax.Rule.Name,
+			Country:  tax.Rule.Country,
+			Region:   tax.Rule.Region,
+			Category: tax.Rule.Category,
+			Rate:     tax.Rule.Rate,
+			Taxable:  tax.Taxable,
+			Amount:   tax.Amount,
+		}
+		if result.Total, err = result.Total.Add(tax.Amount); err != nil {
+			return nil, err
+		}
+	}
+	if len(lines) > 0 && result.Total.IsZero() {
+		result.Total = money.Zero(lines[0].Amount.Currency)
+	}
+	return result, nil
+}
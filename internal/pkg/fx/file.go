@@ -0,0 +1,72 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// fileRates is the content of a rates file: the price of one unit of Base in
+// each quote currency, e.g. {"base": "USD", "rates": {"EUR": "0.92"}}
+type fileRates struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+// FileProvider serves the rates of a JSON file. Rates between two quote
+// currencies are crossed through the base currency.
+type FileProvider struct {
+	rates map[money.Currency]*big.Rat
+}
+
+// NewFileProvider loads the rates of the file at path
+func NewFileProvider(path string) (*FileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rates file: %w", err)
+	}
+
+	var content fileRates
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("failed to parse rates file: %w", err)
+	}
+
+	base, err := money.ParseCurrency(content.Base)
+	if err != nil {
+		return nil, err
+	}
+	provider := &FileProvider{
+		rates: map[money.Currency]*big.Rat{base: big.NewRat(1, 1)},
+	}
+	for code, value := range content.Rates {
+		currency, err := money.ParseCurrency(code)
+		if err != nil {
+			return nil, err
+		}
+		rate, err := ParseRate(base, currency, value)
+		if err != nil {
+			return nil, err
+		}
+		if provider.rates[currency], err = rate.Rat(); err != nil {
+			return nil, err
+		}
+	}
+	return provider, nil
+}
+
+// Rate returns the rate from one currency to another
+func (p *FileProvider) Rate(ctx context.Context, from, to money.Currency) (Rate, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+	}
+	return NewRate(from, to, new(big.Rat).Quo(toRate, fromRate))
+}
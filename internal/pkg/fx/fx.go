@@ -0,0 +1,139 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// rateDecimals is the number of decimals rates are kept with, as stored in
+// the database
+const rateDecimals = 10
+
+var (
+	ErrRateNotFound = errors.New("fx: exchange rate not found")
+	ErrInvalidRate  = errors.New("fx: invalid exchange rate")
+)
+
+// Provider returns exchange rates between currencies
+type Provider interface {
+	Rate(ctx context.Context, from, to money.Currency) (Rate, error)
+}
+
+// Rate is the price of one unit of From in units of To, as a decimal string
+// so that it is stored exactly
+type Rate struct {
+	From  money.Currency `json:"from" gorm:"type:varchar(3)"`
+	To    money.Currency `json:"to" gorm:"type:varchar(3)"`
+	Value string         `json:"value" gorm:"type:numeric(20,10)"`
+}
+
+// NewRate returns the rate from one currency to another, rounded to the
+// decimals rates are stored with
+func NewRate(from, to money.Currency, value *big.Rat) (Rate, error) {
+	if value.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("%w: %s to %s", ErrInvalidRate, from, to)
+	}
+	return Rate{From: from, To: to, Value: formatRat(value)}, nil
+}
+
+// ParseRate returns the rate from one currency to another of a decimal string
+func ParseRate(from, to money.Currency, value string) (Rate, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, value)
+	}
+	return NewRate(from, to, r)
+}
+
+// Identity returns the rate of a currency to itself
+func Identity(currency money.Currency) Rate {
+	return Rate{From: currency, To: currency, Value: "1"}
+}
+
+// IsZero reports whether no rate is set
+func (r Rate) IsZero() bool {
+	return r.From == "" && r.To == ""
+}
+
+// Rat returns the exact value of the rate
+func (r Rate) Rat() (*big.Rat, error) {
+	value, ok := new(big.Rat).SetString(r.Value)
+	if !ok || value.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRate, r.Value)
+	}
+	return value, nil
+}
+
+// Inverse returns the rate from To to From
+func (r Rate) Inverse() (Rate, error) {
+	value, err := r.Rat()
+	if err != nil {
+		return Rate{}, err
+	}
+	return NewRate(r.To, r.From, value.Inv(value))
+}
+
+// Convert converts an amount in From to To, rounding it to minor units with
+// the given mode
+func (r Rate) Convert(amount money.Money, mode money.RoundingMode) (money.Money, error) {
+	if amount.Currency != r.From {
+		return money.Money{}, fmt.Errorf("%w: %s and %s", money.ErrCurrencyMismatch, amount.Currency, r.From)
+	}
+	value, err := r.Rat()
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	// The currencies may count a different number of minor units per unit
+	shift := r.To.Exponent() - r.From.Exponent()
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+	if shift < 0 {
+		scale.Inv(scale)
+	}
+	value.Mul(value, scale)
+
+	converted := amount.MulRat(value, mode)
+	return money.New(converted.Amount, r.To), nil
+}
+
+// Lookup returns the rate from one currency to another. A currency converts to
+// itself at one without asking provider, which may be nil when only one
+// currency is in use.
+func Lookup(ctx context.Context, provider Provider, from, to money.Currency) (Rate, error) {
+	if from == to {
+		return Identity(from), nil
+	}
+	if provider == nil {
+		return Rate{}, fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+	}
+	return provider.Rate(ctx, from, to)
+}
+
+// Convert converts an amount to a currency at the rate of provider
+func Convert(ctx context.Context, provider Provider, amount money.Money, to money.Currency, mode money.RoundingMode) (money.Money, error) {
+	rate, err := Lookup(ctx, provider, amount.Currency, to)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return rate.Convert(amount, mode)
+}
+
+// formatRat formats r with the decimals rates are stored with, without
+// trailing zeros
+func formatRat(r *big.Rat) string {
+	s := r.FloatString(rateDecimals)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
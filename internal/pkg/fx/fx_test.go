@@ -0,0 +1,87 @@
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+func TestRate_Convert(t *testing.T) {
+	tests := []struct {
+		name   string
+		rate   string
+		from   money.Currency
+		to     money.Currency
+		amount int64
+		want   int64
+	}{
+		{"same exponent", "0.92", money.USD, money.EUR, 1999, 1839},
+		{"to no minor units", "151.37", money.USD, money.JPY, 1999, 3026},
+		{"from no minor units", "0.0066", money.JPY, money.USD, 3026, 1997},
+		{"to large amounts", "15700", money.USD, money.IDR, 1000, 15700000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.from, tt.to, tt.rate)
+			require.NoError(t, err)
+
+			converted, err := rate.Convert(money.New(tt.amount, tt.from), money.RoundHalfUp)
+			require.NoError(t, err)
+			assert.Equal(t, money.New(tt.want, tt.to), converted)
+		})
+	}
+
+	rate, err := ParseRate(money.USD, money.EUR, "0.92")
+	require.NoError(t, err)
+	_, err = rate.Convert(money.New(100, money.GBP), money.RoundHalfUp)
+	assert.ErrorIs(t, err, money.ErrCurrencyMismatch)
+
+	_, err = ParseRate(money.USD, money.EUR, "-1")
+	assert.ErrorIs(t, err, ErrInvalidRate)
+}
+
+func TestLookup(t *testing.T) {
+	rate, err := Lookup(context.Background(), nil, money.USD, money.USD)
+	require.NoError(t, err)
+	assert.Equal(t, Identity(money.USD), rate)
+
+	_, err = Lookup(context.Background(), nil, money.USD, money.EUR)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"base": "USD", "rates": {"EUR": "0.8", "GBP": "0.64"}}`), 0o600))
+
+	provider, err := NewFileProvider(path)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		from money.Currency
+		to   money.Currency
+		want string
+	}{
+		{"direct", money.USD, money.EUR, "0.8"},
+		{"inverse", money.EUR, money.USD, "1.25"},
+		{"cross", money.EUR, money.GBP, "0.8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := provider.Rate(context.Background(), tt.from, tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, Rate{From: tt.from, To: tt.to, Value: tt.want}, rate)
+		})
+	}
+
+	_, err = provider.Rate(context.Background(), money.USD, money.JPY)
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
@@ -0,0 +1,75 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
+)
+
+// rateRecord is a row of the fx_rates table: the price of one unit of the
+// base currency in the quote currency
+type rateRecord struct {
+	BaseCurrency  string    `gorm:"type:varchar(3);primaryKey"`
+	QuoteCurrency string    `gorm:"type:varchar(3);primaryKey"`
+	Rate          string    `gorm:"type:numeric(20,10);not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+}
+
+func (rateRecord) TableName() string {
+	return "fx_rates"
+}
+
+// DBProvider serves the rates of the fx_rates table. A pair stored the other
+// way round is inverted.
+type DBProvider struct {
+	db *gorm.DB
+}
+
+// NewDBProvider creates a new database rate provider
+func NewDBProvider(db *gorm.DB) *DBProvider {
+	return &DBProvider{
+		db: db,
+	}
+}
+
+// Rate returns the rate from one currency to another
+func (p *DBProvider) Rate(ctx context.Context, from, to money.Currency) (Rate, error) {
+	var records []rateRecord
+	err := p.db.WithContext(ctx).
+		Where("(base_currency = ? AND quote_currency = ?) OR (base_currency = ? AND quote_currency = ?)", from, to, to, from).
+		Find(&records).Error
+	if err != nil {
+		return Rate{}, err
+	}
+
+	for _, record := range records {
+		if money.Currency(record.BaseCurrency) == from {
+			return ParseRate(from, to, record.Rate)
+		}
+	}
+	for _, record := range records {
+		rate, err := ParseRate(to, from, record.Rate)
+		if err != nil {
+			return Rate{}, err
+		}
+		return rate.Inverse()
+	}
+	return Rate{}, fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+}
+
+// SetRate stores the rate from one currency to another
+func (p *DBProvider) SetRate(ctx context.Context, rate Rate) error {
+	if _, err := rate.Rat(); err != nil {
+		return err
+	}
+	return p.db.WithContext(ctx).Save(&rateRecord{
+		BaseCurrency:  string(rate.From),
+		QuoteCurrency: string(rate.To),
+		Rate:          rate.Value,
+		UpdatedAt:     time.Now(),
+	}).Error
+}
@@ -0,0 +1,283 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	GBP Currency = "GBP"
+	SGD Currency = "SGD"
+	IDR Currency = "IDR"
+	JPY Currency = "JPY"
+)
+
+// exponents holds the number of minor unit digits of the supported currencies
+var exponents = map[Currency]int{
+	USD: 2,
+	EUR: 2,
+	GBP: 2,
+	SGD: 2,
+	IDR: 2,
+	JPY: 0,
+}
+
+var (
+	ErrUnknownCurrency  = errors.New("money: unknown currency")
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+	ErrInvalidWeights   = errors.New("money: weights must be positive")
+)
+
+// ParseCurrency returns the currency of an ISO 4217 code
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if !currency.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+	return currency, nil
+}
+
+// Valid reports whether the currency is supported
+func (c Currency) Valid() bool {
+	_, ok := exponents[c]
+	return ok
+}
+
+// Exponent returns the number of minor unit digits of the currency, e.g. 2
+// for USD cents and 0 for JPY
+func (c Currency) Exponent() int {
+	return exponents[c]
+}
+
+// RoundingMode tells how an amount that falls between two minor units is rounded
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest minor unit, halves away from zero
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest minor unit, halves to the even one
+	RoundHalfEven
+	// RoundDown rounds toward zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+)
+
+// Money is an amount in the minor units of its currency, e.g. cents. The zero
+// value has no currency and acts as zero of any currency in arithmetic, so
+// that sums can start from it; any other mix of currencies is refused.
+type Money struct {
+	Amount   int64    `json:"amount" bson:"amount" gorm:"not null"`
+	Currency Currency `json:"currency" bson:"currency" gorm:"type:varchar(3);not null"`
+}
+
+// New returns an amount of minor units of the currency
+func New(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Zero returns no money of the currency
+func Zero(currency Currency) Money {
+	return Money{Currency: currency}
+}
+
+// FromMajor converts an amount in major units, e.g. dollars, rounding it to
+// minor units with the given mode. It is meant for configuration values and
+// rates; amounts that are exchanged should stay in minor units.
+func FromMajor(amount float64, currency Currency, mode RoundingMode) Money {
+	r := ratOf(amount)
+	r.Mul(r, new(big.Rat).SetInt(pow10(currency.Exponent())))
+	return Money{Amount: roundRat(r, mode), Currency: currency}
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.common(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: currency}, nil
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := m.common(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: currency}, nil
+}
+
+// Mul returns m times a quantity
+func (m Money) Mul(quantity int64) Money {
+	return Money{Amount: m.Amount * quantity, Currency: m.Currency}
+}
+
+// Percent returns percent of m, rounded to minor units with the given mode
+func (m Money) Percent(percent float64, mode RoundingMode) Money {
+	rate := ratOf(percent)
+	return m.MulRat(rate.Quo(rate, big.NewRat(100, 1)), mode)
+}
+
+// MulRat returns m times an exact rate, rounded to minor units with the given mode
+func (m Money) MulRat(rate *big.Rat, mode RoundingMode) Money {
+	r := new(big.Rat).SetInt64(m.Amount)
+	r.Mul(r, rate)
+	return Money{Amount: roundRat(r, mode), Currency: m.Currency}
+}
+
+// Allocate splits m in proportion to the weights. The minor units left over
+// by rounding go to the first shares, so that the shares always add up to m.
+func (m Money) Allocate(weights []int64) ([]Money, error) {
+	var total int64
+	for _, weight := range weights {
+		if weight < 0 {
+			return nil, ErrInvalidWeights
+		}
+		total += weight
+	}
+	if total == 0 {
+		return nil, ErrInvalidWeights
+	}
+
+	shares := make([]Money, len(weights))
+	remainder := m.Amount
+	for i, weight := range weights {
+		r := new(big.Rat).SetFrac64(m.Amount*weight, total)
+		shares[i] = Money{Amount: roundRat(r, RoundDown), Currency: m.Currency}
+		remainder -= shares[i].Amount
+	}
+
+	unit := int64(1)
+	if remainder < 0 {
+		unit = -1
+	}
+	for i := 0; remainder != 0; i = (i + 1) % len(shares) {
+		if weights[i] == 0 {
+			continue
+		}
+		shares[i].Amount += unit
+		remainder -= unit
+	}
+	return shares, nil
+}
+
+// Cmp compares m and o and returns -1, 0 or +1
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.common(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// IsZero reports whether m is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsPositive reports whether m is greater than zero
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// IsNegative reports whether m is less than zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Decimal formats m in major units, e.g. "12.34"
+func (m Money) Decimal() string {
+	exponent := m.Currency.Exponent()
+	if exponent == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+	return new(big.Rat).SetFrac(big.NewInt(m.Amount), pow10(exponent)).FloatString(exponent)
+}
+
+// String formats m with its currency, e.g. "12.34 USD"
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// Sum adds up the amounts
+func Sum(amounts ...Money) (Money, error) {
+	var total Money
+	for _, amount := range amounts {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// common returns the currency shared by m and o
+func (m Money) common(o Money) (Currency, error) {
+	switch {
+	case m.Currency == o.Currency:
+		return m.Currency, nil
+	case m == Money{}:
+		return o.Currency, nil
+	case o == Money{}:
+		return m.Currency, nil
+	default:
+		return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+}
+
+// ratOf returns the exact decimal value of the shortest representation of f
+func ratOf(f float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	return r
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+// roundRat rounds r to an integer with the given mode
+func roundRat(r *big.Rat, mode RoundingMode) int64 {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient.Int64()
+	}
+
+	var away bool
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundHalfUp, RoundHalfEven:
+		twice := new(big.Int).Lsh(new(big.Int).Abs(remainder), 1)
+		switch twice.Cmp(r.Denom()) {
+		case 1:
+			away = true
+		case 0:
+			away = mode == RoundHalfUp || quotient.Bit(0) == 1
+		}
+	}
+
+	if away {
+		quotient.Add(quotient, big.NewInt(int64(r.Num().Sign())))
+	}
+	return quotient.Int64()
+}
@@ -0,0 +1,66 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromMajor(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency Currency
+		mode     RoundingMode
+		want     int64
+	}{
+		{"exact", 12.34, USD, RoundHalfUp, 1234},
+		{"binary fraction stays exact", 0.285, USD, RoundHalfUp, 29},
+		{"half even", 0.285, USD, RoundHalfEven, 28},
+		{"down", 0.289, USD, RoundDown, 28},
+		{"up", 0.281, USD, RoundUp, 29},
+		{"negative half up", -0.285, USD, RoundHalfUp, -29},
+		{"no minor units", 1500.5, JPY, RoundHalfEven, 1500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, New(tt.want, tt.currency), FromMajor(tt.amount, tt.currency, tt.mode))
+		})
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	total, err := New(1000, USD).Add(New(250, USD))
+	require.NoError(t, err)
+	assert.Equal(t, New(1250, USD), total)
+
+	_, err = New(1000, USD).Add(New(250, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = New(1000, USD).Cmp(New(250, EUR))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	total, err = Sum(New(100, EUR), New(200, EUR))
+	require.NoError(t, err)
+	assert.Equal(t, New(300, EUR), total)
+
+	assert.Equal(t, New(167, USD), New(1111, USD).Percent(15, RoundHalfUp))
+	assert.Equal(t, New(166, USD), New(1111, USD).Percent(15, RoundDown))
+	assert.Equal(t, "11.11 USD", New(1111, USD).String())
+	assert.Equal(t, "-0.05", New(-5, USD).Decimal())
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	shares, err := New(1000, USD).Allocate([]int64{1, 1, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []Money{New(334, USD), New(333, USD), New(333, USD)}, shares)
+
+	shares, err = New(-100, USD).Allocate([]int64{0, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, []Money{New(0, USD), New(-34, USD), New(-66, USD)}, shares)
+
+	_, err = New(100, USD).Allocate([]int64{0, 0})
+	assert.ErrorIs(t, err, ErrInvalidWeights)
+}
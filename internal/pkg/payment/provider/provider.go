@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"time"
 )
 
@@ -16,10 +17,11 @@ type Config struct {
 	WebhookEndpoint string
 }
 
-// PaymentProvider defines the interface for payment providers
+// PaymentProvider defines the interface for payment providers. Amounts are
+// charged and refunded in their own currency, in minor units.
 type PaymentProvider interface {
-	ProcessPayment(ctx context.Context, details *usecase.PaymentDetails, amount float64) (string, error)
-	RefundPayment(ctx context.Context, transactionID string, amount float64) error
+	ProcessPayment(ctx context.Context, details *usecase.PaymentDetails, amount money.Money) (string, error)
+	RefundPayment(ctx context.Context, transactionID string, amount money.Money) error
 }
 
 // provider implements PaymentProvider interface
@@ -50,12 +52,12 @@ func newStripeProvider(config Config) PaymentProvider {
 	}
 }
 
-func (p *stripeProvider) ProcessPayment(ctx context.Context, details *usecase.PaymentDetails, amount float64) (string, error) {
+func (p *stripeProvider) ProcessPayment(ctx context.Context, details *usecase.PaymentDetails, amount money.Money) (string, error) {
 	// TODO: Implement actual Stripe payment processing
 	return "stripe_mock_transaction_id", nil
 }
 
-func (p *stripeProvider) RefundPayment(ctx context.Context, transactionID string, amount float64) error {
+func (p *stripeProvider) RefundPayment(ctx context.Context, transactionID string, amount money.Money) error {
 	// TODO: Implement actual Stripe refund
 	return nil
 }
@@ -70,12 +72,12 @@ func newMidtransProvider(config Config) PaymentProvider {
 	}
 }
 
-func (p *midtransProvider) ProcessPayment(ctx context.Context, details *usecase.PaymentDetails, amount float64) (string, error) {
+func (p *midtransProvider) ProcessPayment(ctx context.Context, details *usecase.PaymentDetails, amount money.Money) (string, error) {
 	// TODO: Implement actual Midtrans payment processing
 	return "midtrans_mock_transaction_id", nil
 }
 
-func (p *midtransProvider) RefundPayment(ctx context.Context, transactionID string, amount float64) error {
+func (p *midtransProvider) RefundPayment(ctx context.Context, transactionID string, amount money.Money) error {
 	// TODO: Implement actual Midtrans refund
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -20,12 +21,22 @@ type TestDB struct {
 	Name string
 }
 
+// SkipWithoutPostgres skips a test that needs PostgreSQL when TEST_DATABASE_URL is not set
+func SkipWithoutPostgres(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TEST_DATABASE_URL") == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+}
+
 // NewTestPostgres creates a new test PostgreSQL database
 func NewTestPostgres(t *testing.T) *TestDB {
 	t.Helper()
 
-	// Get test database configuration
-	dbName := "test_" + time.Now().Format("20060102150405")
+	// Get test database configuration. Packages are tested in parallel, so the
+	// name is unique to the nanosecond.
+	dbName := "test_" + strconv.FormatInt(time.Now().UnixNano(), 10)
 	dsn := os.Getenv("TEST_DATABASE_URL")
 	if dsn == "" {
 		dsn = "host=localhost user=postgres password=postgres port=5432 sslmode=disable"
@@ -8,11 +8,14 @@ import (
 )
 
 type Config struct {
-	App      AppConfig      `mapstructure:"app"`
-	Auth     AuthConfig     `mapstructure:"auth"`
-	Database DatabaseConfig `mapstructure:"database"`
-	GRPC     GRPCConfig     `mapstructure:"grpc"`
-	Services ServicesConfig `mapstructure:"services"`
+	App         AppConfig      `mapstructure:"app"`
+	Auth        AuthConfig     `mapstructure:"auth"`
+	Database    DatabaseConfig `mapstructure:"database"`
+	GRPC        GRPCConfig     `mapstructure:"grpc"`
+	Services    ServicesConfig `mapstructure:"services"`
+	Saga        SagaConfig     `mapstructure:"saga"`
+	Redis       RedisConfig    `mapstructure:"redis"`
+	OrderConfig `mapstructure:",squash"`
 }
 
 type AppConfig struct {
@@ -56,6 +59,42 @@ type ServiceConfig struct {
 	Port int    `mapstructure:"port"`
 }
 
+type RedisConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Password string `mapstructure:"password"`
+	DB       int    `mapstructure:"db"`
+}
+
+// OrderConfig holds the top-level settings orders are priced, taxed and
+// deduplicated with
+type OrderConfig struct {
+	BaseCurrency        string        `mapstructure:"base_currency"`
+	FXRatesFile         string        `mapstructure:"fx_rates_file"`
+	MinOrderValue       float64       `mapstructure:"min_order_value"`
+	TaxPricesIncludeTax bool          `mapstructure:"tax_prices_include_tax"`
+	TaxRounding         string        `mapstructure:"tax_rounding"`
+	IdempotencyStore    string        `mapstructure:"idempotency_store"`
+	IdempotencyTTL      time.Duration `mapstructure:"idempotency_ttl"`
+}
+
+type SagaConfig struct {
+	Timeout  time.Duration      `mapstructure:"timeout"`
+	Retry    SagaRetryConfig    `mapstructure:"retry"`
+	Recovery SagaRecoveryConfig `mapstructure:"recovery"`
+}
+
+type SagaRecoveryConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	LeaseTTL time.Duration `mapstructure:"lease_ttl"`
+}
+
+type SagaRetryConfig struct {
+	MaxAttempts     int           `mapstructure:"max_attempts"`
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+	MaxInterval     time.Duration `mapstructure:"max_interval"`
+}
+
 // LoadConfig loads configuration from file and environment variables
 func LoadConfig(path string) (*Config, error) {
 	viper.AddConfigPath(path)
@@ -0,0 +1,150 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Interceptor provides Idempotency-Key handling for gRPC services
+type Interceptor struct {
+	store Store
+	ttl   time.Duration
+	// List of methods whose responses are replayed
+	methods map[string]bool
+}
+
+// NewInterceptor creates a new idempotency interceptor for the given full method names
+func NewInterceptor(store Store, ttl time.Duration, methods ...string) *Interceptor {
+	enabled := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		enabled[method] = true
+	}
+
+	return &Interceptor{
+		store:   store,
+		ttl:     ttl,
+		methods: enabled,
+	}
+}
+
+// WithKey returns a client context sending key as the idempotency key
+func WithKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, HeaderKey, key)
+}
+
+// Unary returns a unary server interceptor replaying the stored response of a
+// call retried with the same idempotency-key metadata
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !i.methods[info.FullMethod] || !ok {
+			return handler(ctx, req)
+		}
+		clientKey := incomingKey(ctx)
+		if clientKey == "" {
+			return handler(ctx, req)
+		}
+
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "failed to read request")
+		}
+		caller, _ := ctx.Value("user_id").(string)
+		key := scope(info.FullMethod, caller, clientKey)
+		requestPrint := fingerprint([]byte(info.FullMethod), body)
+
+		record, err := claim(ctx, i.store, key, requestPrint, i.ttl)
+		switch {
+		case errors.Is(err, ErrKeyReused):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ErrInProgress):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case err != nil:
+			return nil, status.Error(codes.Internal, "failed to check idempotency key")
+		case record != nil:
+			return replay(record)
+		}
+
+		resp, err := handler(ctx, req)
+		code, contentType, respBody, storable := outcome(resp, err)
+		if !storable {
+			if err := i.store.Release(ctx, key); err != nil {
+				log.Printf("Error releasing idempotency key: %v", err)
+			}
+			return resp, err
+		}
+
+		// The call has taken effect; a failure to store its response must not
+		// fail it, the key just stays claimed until it expires
+		if err := complete(ctx, i.store, key, requestPrint, i.ttl, int(code), contentType, respBody); err != nil {
+			log.Printf("Error storing idempotent response: %v", err)
+		}
+		return resp, err
+	}
+}
+
+// outcome encodes the result of a call, reporting whether it may be replayed.
+// Errors a retry could resolve are not stored.
+func outcome(resp interface{}, err error) (codes.Code, string, []byte, bool) {
+	if err != nil {
+		st := status.Convert(err)
+		switch st.Code() {
+		case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+			codes.PermissionDenied, codes.FailedPrecondition, codes.OutOfRange:
+			return st.Code(), "", []byte(st.Message()), true
+		default:
+			return st.Code(), "", nil, false
+		}
+	}
+
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return codes.OK, "", nil, false
+	}
+	body, marshalErr := proto.Marshal(msg)
+	if marshalErr != nil {
+		return codes.OK, "", nil, false
+	}
+	return codes.OK, string(msg.ProtoReflect().Descriptor().FullName()), body, true
+}
+
+// replay decodes the stored result of a call
+func replay(record *Record) (interface{}, error) {
+	code := codes.Code(record.StatusCode)
+	if code != codes.OK {
+		return nil, status.Error(code, string(record.Body))
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(record.ContentType))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+	msg := messageType.New().Interface()
+	if err := proto.Unmarshal(record.Body, msg); err != nil {
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+	return msg, nil
+}
+
+// incomingKey returns the idempotency key sent in the call metadata
+func incomingKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(HeaderKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
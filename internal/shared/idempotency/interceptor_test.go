@@ -0,0 +1,85 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestInterceptor(t *testing.T) {
+	const method = "/order.OrderService/CreateOrder"
+	calls := 0
+	var failure error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if failure != nil {
+			return nil, failure
+		}
+		return wrapperspb.Int32(int32(calls)), nil
+	}
+	unary := NewInterceptor(&memoryStore{records: map[string]*Record{}}, time.Hour, method).Unary()
+
+	call := func(fullMethod, user, key, body string) (interface{}, error) {
+		// The auth interceptor runs first and puts the caller on the context
+		ctx := context.WithValue(context.Background(), "user_id", user)
+		if key != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(HeaderKey, key))
+		}
+		return unary(ctx, wrapperspb.String(body), &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+	}
+	assertResponse := func(t *testing.T, want int32, resp interface{}, err error) {
+		t.Helper()
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(wrapperspb.Int32(want), resp.(proto.Message)))
+	}
+
+	resp, err := call(method, "user-1", "key-1", "order")
+	assertResponse(t, 1, resp, err)
+
+	// A retry replays the first response without running the handler again
+	resp, err = call(method, "user-1", "key-1", "order")
+	assertResponse(t, 1, resp, err)
+	assert.Equal(t, 1, calls)
+
+	// Reusing the key for another request is rejected
+	_, err = call(method, "user-1", "key-1", "other order")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+
+	// Keys are scoped to the caller
+	resp, err = call(method, "user-2", "key-1", "order")
+	assertResponse(t, 2, resp, err)
+
+	// Calls without a key, and methods that are not listed, are not replayed
+	resp, err = call(method, "user-1", "", "order")
+	assertResponse(t, 3, resp, err)
+	resp, err = call("/order.OrderService/GetOrder", "user-1", "key-1", "order")
+	assertResponse(t, 4, resp, err)
+
+	// Errors a retry could resolve release the key
+	failure = status.Error(codes.Unavailable, "payment provider unavailable")
+	_, err = call(method, "user-1", "key-2", "order")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	failure = nil
+	resp, err = call(method, "user-1", "key-2", "order")
+	assertResponse(t, 6, resp, err)
+
+	// Final errors are replayed
+	failure = status.Error(codes.FailedPrecondition, "cart is empty")
+	_, err = call(method, "user-1", "key-3", "order")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	failure = nil
+	_, err = call(method, "user-1", "key-3", "order")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "cart is empty", status.Convert(err).Message())
+	assert.Equal(t, 7, calls)
+}
@@ -0,0 +1,65 @@
+package idempotency
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReplayedHeader marks a response replayed from an earlier request
+const ReplayedHeader = "Idempotent-Replayed"
+
+// Middleware replays the stored response of a request retried with the same
+// Idempotency-Key header. Requests without the header run as usual. Server
+// errors are not stored, so the request may be retried with the same key.
+func Middleware(store Store, ttl time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		clientKey := c.Get(HeaderKey)
+		if clientKey == "" {
+			return c.Next()
+		}
+
+		ctx := c.UserContext()
+		caller, _ := c.Locals("user_id").(string)
+		key := scope(c.Method()+" "+c.Path(), caller, clientKey)
+		requestPrint := fingerprint([]byte(c.Method()), []byte(c.Path()), c.Body())
+
+		record, err := claim(ctx, store, key, requestPrint, ttl)
+		switch {
+		case errors.Is(err, ErrKeyReused):
+			return response.Error(c, fiber.StatusUnprocessableEntity, err.Error())
+		case errors.Is(err, ErrInProgress):
+			return response.Error(c, fiber.StatusConflict, err.Error())
+		case err != nil:
+			return response.InternalServerError(c, "Failed to check idempotency key")
+		case record != nil:
+			c.Set(ReplayedHeader, "true")
+			c.Set(fiber.HeaderContentType, record.ContentType)
+			return c.Status(record.StatusCode).Send(record.Body)
+		}
+
+		if err := c.Next(); err != nil {
+			_ = store.Release(ctx, key)
+			return err
+		}
+
+		res := c.Response()
+		if res.StatusCode() >= fiber.StatusInternalServerError {
+			if err := store.Release(ctx, key); err != nil {
+				log.Printf("Error releasing idempotency key: %v", err)
+			}
+			return nil
+		}
+
+		// The request has taken effect; a failure to store its response must
+		// not turn it into an error, the key just stays claimed until it expires
+		body := append([]byte(nil), res.Body()...)
+		if err := complete(ctx, store, key, requestPrint, ttl, res.StatusCode(), string(res.Header.ContentType()), body); err != nil {
+			log.Printf("Error storing idempotent response: %v", err)
+		}
+		return nil
+	}
+}
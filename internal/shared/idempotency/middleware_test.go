@@ -0,0 +1,96 @@
+package idempotency
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+func (s *memoryStore) Begin(_ context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if record, ok := s.records[key]; ok {
+		return record, nil
+	}
+	s.records[key] = newRecord(key, fingerprint, ttl)
+	return nil, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[record.Key] = record
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func TestMiddleware(t *testing.T) {
+	calls := 0
+	status := fiber.StatusCreated
+	app := fiber.New()
+	app.Post("/orders", Middleware(&memoryStore{records: map[string]*Record{}}, time.Hour), func(c *fiber.Ctx) error {
+		calls++
+		return c.Status(status).JSON(fiber.Map{"call": calls})
+	})
+
+	send := func(key, body string) (int, string, string) {
+		req := httptest.NewRequest(fiber.MethodPost, "/orders", strings.NewReader(body))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		if key != "" {
+			req.Header.Set(HeaderKey, key)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data), resp.Header.Get(ReplayedHeader)
+	}
+
+	code, body, replayed := send("key-1", `{"item":1}`)
+	assert.Equal(t, fiber.StatusCreated, code)
+	assert.JSONEq(t, `{"call":1}`, body)
+	assert.Empty(t, replayed)
+
+	// A retry replays the first response without running the handler again
+	code, body, replayed = send("key-1", `{"item":1}`)
+	assert.Equal(t, fiber.StatusCreated, code)
+	assert.JSONEq(t, `{"call":1}`, body)
+	assert.Equal(t, "true", replayed)
+	assert.Equal(t, 1, calls)
+
+	code, _, _ = send("key-1", `{"item":2}`)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, code)
+	assert.Equal(t, 1, calls)
+
+	// Server errors are not stored, so the key may be retried
+	status = fiber.StatusInternalServerError
+	code, _, _ = send("key-2", `{"item":1}`)
+	assert.Equal(t, fiber.StatusInternalServerError, code)
+	status = fiber.StatusCreated
+	code, body, _ = send("key-2", `{"item":1}`)
+	assert.Equal(t, fiber.StatusCreated, code)
+	assert.JSONEq(t, `{"call":3}`, body)
+
+	code, _, _ = send("", `{"item":1}`)
+	assert.Equal(t, fiber.StatusCreated, code)
+	assert.Equal(t, 4, calls)
+}
@@ -0,0 +1,102 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PostgresStore keeps idempotency records in PostgreSQL
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresStore creates a new PostgreSQL idempotency store
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{
+		db: db,
+	}
+}
+
+// Begin claims key, taking it over when its record has expired
+func (s *PostgresStore) Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	record := newRecord(key, fingerprint, ttl)
+	result := s.db.WithContext(ctx).Exec(`
+		INSERT INTO idempotency_keys (key, fingerprint, status, status_code, content_type, body, created_at, expires_at)
+		VALUES (?, ?, ?, 0, '', NULL, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			status = EXCLUDED.status,
+			status_code = 0,
+			content_type = '',
+			body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`,
+		record.Key, record.Fingerprint, record.Status, record.CreatedAt, record.ExpiresAt)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var existing Record
+	if err := s.db.WithContext(ctx).Where("key = ?", key).First(&existing).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Released between the insert and the lookup; the client may retry
+			return nil, ErrInProgress
+		}
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	return &existing, nil
+}
+
+// Complete stores the response of a claimed key
+func (s *PostgresStore) Complete(ctx context.Context, record *Record) error {
+	err := s.db.WithContext(ctx).Model(&Record{}).
+		Where("key = ? AND status = ?", record.Key, StatusInProgress).
+		Updates(map[string]interface{}{
+			"status":       StatusCompleted,
+			"status_code":  record.StatusCode,
+			"content_type": record.ContentType,
+			"body":         record.Body,
+			"expires_at":   record.ExpiresAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release drops a claimed key
+func (s *PostgresStore) Release(ctx context.Context, key string) error {
+	err := s.db.WithContext(ctx).
+		Where("key = ? AND status = ?", key, StatusInProgress).
+		Delete(&Record{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Migrations returns the database migrations for the idempotency keys table
+func (s *PostgresStore) Migrations() []string {
+	return []string{
+		`
+		CREATE TABLE IF NOT EXISTS idempotency_keys (
+			key VARCHAR(255) PRIMARY KEY,
+			fingerprint VARCHAR(64) NOT NULL,
+			status VARCHAR(50) NOT NULL,
+			status_code INTEGER NOT NULL DEFAULT 0,
+			content_type VARCHAR(255),
+			body BYTEA,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+		);
+		`,
+	}
+}
@@ -0,0 +1,107 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// HeaderKey is the HTTP header and gRPC metadata key carrying the idempotency key
+const HeaderKey = "Idempotency-Key"
+
+var (
+	// ErrKeyReused is returned when a key is sent again with a different request
+	ErrKeyReused = errors.New("idempotency key was already used for a different request")
+	// ErrInProgress is returned when the request of a key is still being processed
+	ErrInProgress = errors.New("a request with this idempotency key is still being processed")
+)
+
+// Status represents the state of an idempotency key
+type Status string
+
+const (
+	StatusInProgress Status = "IN_PROGRESS"
+	StatusCompleted  Status = "COMPLETED"
+)
+
+// Record is the request fingerprint and stored response of an idempotency key
+type Record struct {
+	Key         string    `json:"key" gorm:"type:varchar(255);primary_key"`
+	Fingerprint string    `json:"fingerprint" gorm:"type:varchar(64);not null"`
+	Status      Status    `json:"status" gorm:"type:varchar(50);not null"`
+	StatusCode  int       `json:"status_code" gorm:"not null;default:0"`
+	ContentType string    `json:"content_type" gorm:"type:varchar(255)"`
+	Body        []byte    `json:"body" gorm:"type:bytea"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at" gorm:"not null"`
+}
+
+// TableName returns the idempotency keys table name
+func (Record) TableName() string {
+	return "idempotency_keys"
+}
+
+// Store keeps idempotency records
+type Store interface {
+	// Begin claims key for a request. It returns nil when the key was claimed,
+	// or the unexpired record already stored under it.
+	Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error)
+	// Complete stores the response of a claimed key
+	Complete(ctx context.Context, record *Record) error
+	// Release drops a claimed key so that the request may be retried with it
+	Release(ctx context.Context, key string) error
+}
+
+// newRecord creates an in-progress record for a claimed key
+func newRecord(key, fingerprint string, ttl time.Duration) *Record {
+	now := time.Now()
+	return &Record{
+		Key:         key,
+		Fingerprint: fingerprint,
+		Status:      StatusInProgress,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+// claim begins key and returns the completed record to replay, if any
+func claim(ctx context.Context, store Store, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	record, err := store.Begin(ctx, key, fingerprint, ttl)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	if record.Fingerprint != fingerprint {
+		return nil, ErrKeyReused
+	}
+	if record.Status != StatusCompleted {
+		return nil, ErrInProgress
+	}
+	return record, nil
+}
+
+// complete stores the response of a claimed key
+func complete(ctx context.Context, store Store, key, fingerprint string, ttl time.Duration, statusCode int, contentType string, body []byte) error {
+	record := newRecord(key, fingerprint, ttl)
+	record.Status = StatusCompleted
+	record.StatusCode = statusCode
+	record.ContentType = contentType
+	record.Body = body
+	return store.Complete(ctx, record)
+}
+
+// fingerprint hashes the parts identifying a request
+func fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// scope namespaces a client key by the operation and caller it was sent to
+func scope(operation, caller, key string) string {
+	return fingerprint([]byte(operation), []byte(caller), []byte(key))
+}
@@ -0,0 +1,60 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/infrastructure/cache/redis"
+)
+
+const redisKeyPrefix = "idempotency:"
+
+// RedisStore keeps idempotency records in Redis, which expires them by itself
+type RedisStore struct {
+	cache *redis.RedisCache
+}
+
+// NewRedisStore creates a new Redis idempotency store
+func NewRedisStore(cache *redis.RedisCache) *RedisStore {
+	return &RedisStore{
+		cache: cache,
+	}
+}
+
+// Begin claims key with SETNX
+func (s *RedisStore) Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, error) {
+	claimed, err := s.cache.SetNX(ctx, redisKeyPrefix+key, newRecord(key, fingerprint, ttl), ttl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	var existing Record
+	if err := s.cache.Get(ctx, redisKeyPrefix+key, &existing); err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	if existing.Key == "" {
+		// Released or expired between the claim and the lookup; the client may retry
+		return nil, ErrInProgress
+	}
+	return &existing, nil
+}
+
+// Complete stores the response of a claimed key
+func (s *RedisStore) Complete(ctx context.Context, record *Record) error {
+	if err := s.cache.Set(ctx, redisKeyPrefix+record.Key, record, time.Until(record.ExpiresAt)); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release drops a claimed key
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	if err := s.cache.Delete(ctx, redisKeyPrefix+key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
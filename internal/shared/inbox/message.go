@@ -0,0 +1,53 @@
+package inbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status is the recorded outcome of a handled message
+type Status string
+
+const (
+	StatusProcessed Status = "PROCESSED"
+	StatusFailed    Status = "FAILED"
+)
+
+// Key identifies a handled message. Messages are deduplicated per saga and
+// Step, the topic of the step or compensation command, so that a command the
+// orchestrator sends again under a new MessageID is not handled twice either.
+type Key struct {
+	MessageID uuid.UUID
+	SagaID    uuid.UUID
+	Step      string
+}
+
+// Message records that a message has been handled and with which outcome
+type Message struct {
+	MessageID   uuid.UUID `json:"message_id" gorm:"type:uuid;not null"`
+	SagaID      uuid.UUID `json:"saga_id" gorm:"type:uuid;primaryKey"`
+	Step        string    `json:"step" gorm:"type:varchar(255);primaryKey"`
+	Status      Status    `json:"status" gorm:"type:varchar(50);not null"`
+	Error       string    `json:"error,omitempty" gorm:"type:text"`
+	ProcessedAt time.Time `json:"processed_at"`
+}
+
+// TableName returns the inbox table name
+func (Message) TableName() string {
+	return "inbox_messages"
+}
+
+func newMessage(key Key, status Status, handlerErr error) *Message {
+	msg := &Message{
+		MessageID:   key.MessageID,
+		SagaID:      key.SagaID,
+		Step:        key.Step,
+		Status:      status,
+		ProcessedAt: time.Now(),
+	}
+	if handlerErr != nil {
+		msg.Error = handlerErr.Error()
+	}
+	return msg
+}
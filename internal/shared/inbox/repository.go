@@ -0,0 +1,136 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrHandlerFailed is returned for a duplicate of a message whose handler failed for good
+var ErrHandlerFailed = errors.New("message handler failed")
+
+type txKey struct{}
+
+// Tx returns the inbox transaction carried by ctx, or db when there is none.
+// Handlers run through Repository.Handle must write their side effects with it.
+func Tx(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
+
+// Transaction runs fn in a transaction carried by the context passed to it,
+// or in the one ctx already carries. Writes made through Tx take part in it.
+func Transaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	return Tx(ctx, db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Repository deduplicates message handling in PostgreSQL
+type Repository struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a new inbox repository
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Handle runs fn at most once per key. The inbox row is written in the same
+// transaction as the side effects of fn, which are available through Tx. A
+// duplicate returns the recorded outcome without running fn again, and reports
+// whether it was one. A failure of fn is only recorded when final reports it
+// final; otherwise nothing is recorded and a duplicate runs fn again. A nil
+// final records no failure.
+func (r *Repository) Handle(ctx context.Context, key Key, fn func(ctx context.Context) error, final func(err error) bool) (bool, error) {
+	var handlerErr error
+	duplicate := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// A concurrent duplicate blocks on the primary key until this transaction ends
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(newMessage(key, StatusProcessed, nil))
+		if result.Error != nil {
+			return fmt.Errorf("failed to record inbox message: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			duplicate = true
+			return nil
+		}
+
+		handlerErr = fn(context.WithValue(ctx, txKey{}, tx))
+		return handlerErr
+	})
+	if duplicate {
+		return true, r.recordedOutcome(ctx, key)
+	}
+	if handlerErr == nil || final == nil || !final(handlerErr) {
+		return false, err
+	}
+
+	// The side effects were rolled back; remember the failure so a command sent
+	// again reports it instead of running the handler again
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(newMessage(key, StatusFailed, handlerErr)).Error; err != nil {
+		return false, fmt.Errorf("failed to record inbox failure: %w", err)
+	}
+	return false, handlerErr
+}
+
+// recordedOutcome returns the outcome stored for an already handled message
+func (r *Repository) recordedOutcome(ctx context.Context, key Key) error {
+	var msg Message
+	err := r.db.WithContext(ctx).
+		Where("saga_id = ? AND step = ?", key.SagaID, key.Step).
+		First(&msg).Error
+	if err != nil {
+		return fmt.Errorf("failed to get inbox message: %w", err)
+	}
+	if msg.Status == StatusFailed {
+		return fmt.Errorf("%w: %s", ErrHandlerFailed, msg.Error)
+	}
+	return nil
+}
+
+// Migrations returns the database migrations for the inbox table
+func (r *Repository) Migrations() []string {
+	return []string{
+		`
+		CREATE TABLE IF NOT EXISTS inbox_messages (
+			message_id UUID NOT NULL,
+			saga_id UUID NOT NULL,
+			step VARCHAR(255) NOT NULL,
+			status VARCHAR(50) NOT NULL,
+			error TEXT,
+			processed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (saga_id, step)
+		);
+		`,
+		// Tables keyed per message are rekeyed as in the migrations directory
+		`
+		DO $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM information_schema.key_column_usage
+				WHERE table_name = 'inbox_messages' AND constraint_name = 'inbox_messages_pkey' AND column_name = 'message_id'
+			) THEN
+				DELETE FROM inbox_messages WHERE status = 'FAILED';
+				DELETE FROM inbox_messages a USING inbox_messages b
+				WHERE a.saga_id = b.saga_id AND a.step = b.step
+					AND (a.processed_at, a.message_id) < (b.processed_at, b.message_id);
+				UPDATE inbox_messages SET step = 'saga.' || step;
+				ALTER TABLE inbox_messages DROP CONSTRAINT inbox_messages_pkey;
+				ALTER TABLE inbox_messages ADD PRIMARY KEY (saga_id, step);
+			END IF;
+		END $$;
+		`,
+	}
+}
@@ -0,0 +1,102 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/testutil"
+)
+
+type record struct {
+	ID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Label string
+}
+
+func newTestRepository(t *testing.T) *Repository {
+	testutil.SkipWithoutPostgres(t)
+	db := testutil.NewTestPostgres(t)
+	t.Cleanup(func() { db.Cleanup(t) })
+
+	repo := NewRepository(db.DB)
+	for _, migration := range repo.Migrations() {
+		require.NoError(t, db.Exec(migration).Error)
+	}
+	require.NoError(t, db.AutoMigrate(&record{}))
+	return repo
+}
+
+func TestRepository_Handle(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+	permanent := errors.New("card declined")
+	final := func(err error) bool { return errors.Is(err, permanent) }
+
+	write := func(label string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			return Tx(ctx, repo.db).Create(&record{ID: uuid.New(), Label: label}).Error
+		}
+	}
+	records := func(label string) int64 {
+		var count int64
+		require.NoError(t, repo.db.Model(&record{}).Where("label = ?", label).Count(&count).Error)
+		return count
+	}
+
+	t.Run("deduplicates per saga and step", func(t *testing.T) {
+		key := Key{MessageID: uuid.New(), SagaID: uuid.New(), Step: "saga.process_payment"}
+		duplicate, err := repo.Handle(ctx, key, write("processed"), final)
+		require.NoError(t, err)
+		assert.False(t, duplicate)
+
+		// The orchestrator sends the command again under a new message ID
+		key.MessageID = uuid.New()
+		duplicate, err = repo.Handle(ctx, key, write("processed"), final)
+		require.NoError(t, err)
+		assert.True(t, duplicate)
+		assert.EqualValues(t, 1, records("processed"))
+
+		// Its compensation is a different command
+		key.Step = "saga.compensation.process_payment"
+		duplicate, err = repo.Handle(ctx, key, write("processed"), final)
+		require.NoError(t, err)
+		assert.False(t, duplicate)
+		assert.EqualValues(t, 2, records("processed"))
+	})
+
+	t.Run("records final failures", func(t *testing.T) {
+		key := Key{MessageID: uuid.New(), SagaID: uuid.New(), Step: "saga.process_payment"}
+		duplicate, err := repo.Handle(ctx, key, func(ctx context.Context) error {
+			require.NoError(t, write("failed")(ctx))
+			return permanent
+		}, final)
+		assert.ErrorIs(t, err, permanent)
+		assert.False(t, duplicate)
+		assert.Zero(t, records("failed"))
+
+		duplicate, err = repo.Handle(ctx, key, write("failed"), final)
+		assert.ErrorIs(t, err, ErrHandlerFailed)
+		assert.True(t, duplicate)
+		assert.Zero(t, records("failed"))
+	})
+
+	t.Run("runs again after other failures", func(t *testing.T) {
+		transient := errors.New("connection refused")
+		for _, final := range []func(error) bool{final, nil} {
+			key := Key{MessageID: uuid.New(), SagaID: uuid.New(), Step: "saga.compensation.process_payment"}
+			_, err := repo.Handle(ctx, key, func(ctx context.Context) error {
+				return transient
+			}, final)
+			assert.ErrorIs(t, err, transient)
+
+			duplicate, err := repo.Handle(ctx, key, write("retried"), final)
+			require.NoError(t, err)
+			assert.False(t, duplicate)
+		}
+		assert.EqualValues(t, 2, records("retried"))
+	})
+}
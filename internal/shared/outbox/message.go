@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message is a broker message stored in the outbox table. It is written in the
+// same transaction as the state change it announces and published later by the Relay.
+type Message struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	Sequence    int64      `json:"sequence" gorm:"autoIncrement;not null;<-:false"`
+	AggregateID uuid.UUID  `json:"aggregate_id" gorm:"type:uuid;not null"`
+	Topic       string     `json:"topic" gorm:"type:varchar(255);not null"`
+	Payload     []byte     `json:"payload" gorm:"type:jsonb;not null"`
+	Attempts    int        `json:"attempts" gorm:"not null;default:0"`
+	LastError   string     `json:"last_error,omitempty" gorm:"type:text"`
+	CreatedAt   time.Time  `json:"created_at"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+}
+
+// TableName returns the outbox table name
+func (Message) TableName() string {
+	return "outbox_messages"
+}
+
+// NewMessage creates an unsent outbox message for an aggregate, e.g. a saga
+func NewMessage(aggregateID uuid.UUID, topic string, payload []byte) *Message {
+	return &Message{
+		ID:          uuid.New(),
+		AggregateID: aggregateID,
+		Topic:       topic,
+		Payload:     payload,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// IsSent checks if the message has been published
+func (m *Message) IsSent() bool {
+	return m.SentAt != nil
+}
@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/diki-haryadi/ecommerce-saga/internal/shared/messaging"
+)
+
+// Relay publishes outbox messages through the message broker and marks them as
+// sent. Delivery is at-least-once: a message published right before a crash is
+// published again, so consumers must be idempotent. Messages of one aggregate
+// are published in the order they were written; when one fails, the later
+// messages of that aggregate wait for the next batch.
+type Relay struct {
+	repo      *Repository
+	broker    messaging.MessageBroker
+	interval  time.Duration
+	batchSize int
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(repo *Repository, broker messaging.MessageBroker, interval time.Duration, batchSize int) *Relay {
+	return &Relay{
+		repo:      repo,
+		broker:    broker,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run publishes pending messages until the context is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		// Drain the outbox before waiting for the next tick
+		for {
+			sent, err := r.PublishPending(ctx)
+			if err != nil {
+				log.Printf("Error relaying outbox messages: %v", err)
+				break
+			}
+			if sent < r.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishPending publishes one batch of unsent messages and returns how many were sent
+func (r *Relay) PublishPending(ctx context.Context) (int, error) {
+	sent := 0
+	err := r.repo.Process(ctx, r.batchSize, func(ctx context.Context, batch *Batch) error {
+		blocked := make(map[uuid.UUID]bool)
+		for _, msg := range batch.Messages {
+			if blocked[msg.AggregateID] {
+				continue
+			}
+
+			if err := r.broker.Publish(ctx, msg.Topic, msg.Payload); err != nil {
+				blocked[msg.AggregateID] = true
+				if err := batch.MarkFailed(msg.ID, err); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := batch.MarkSent(msg.ID); err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	return sent, err
+}
@@ -0,0 +1,111 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Repository persists outbox messages in PostgreSQL
+type Repository struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a new outbox repository
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Add stores messages using the given transaction, so they are committed
+// together with the state change that produced them
+func Add(tx *gorm.DB, messages ...*Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	if err := tx.Create(messages).Error; err != nil {
+		return fmt.Errorf("failed to add outbox messages: %w", err)
+	}
+	return nil
+}
+
+// Process locks up to limit unsent messages in publishing order and passes them
+// to fn. Rows are locked without SKIP LOCKED, so concurrent relays wait for each
+// other instead of publishing messages of the same aggregate out of order.
+// The outcome recorded by fn through Batch is committed when fn returns.
+func (r *Repository) Process(ctx context.Context, limit int, fn func(ctx context.Context, batch *Batch) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var messages []*Message
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("sent_at IS NULL").
+			Order("sequence ASC").
+			Limit(limit).
+			Find(&messages).Error
+		if err != nil {
+			return fmt.Errorf("failed to fetch outbox messages: %w", err)
+		}
+
+		return fn(ctx, &Batch{tx: tx, Messages: messages})
+	})
+}
+
+// DeleteSentBefore removes published messages older than the given time
+func (r *Repository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("sent_at IS NOT NULL AND sent_at < ?", before).
+		Delete(&Message{})
+	return result.RowsAffected, result.Error
+}
+
+// Migrations returns the database migrations for the outbox table
+func (r *Repository) Migrations() []string {
+	return []string{
+		`
+		CREATE TABLE IF NOT EXISTS outbox_messages (
+			id UUID PRIMARY KEY,
+			sequence BIGSERIAL NOT NULL,
+			aggregate_id UUID NOT NULL,
+			topic VARCHAR(255) NOT NULL,
+			payload JSONB NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			sent_at TIMESTAMP WITH TIME ZONE
+		);
+		`,
+		`CREATE INDEX IF NOT EXISTS idx_outbox_messages_unsent ON outbox_messages(sequence) WHERE sent_at IS NULL;`,
+		`CREATE INDEX IF NOT EXISTS idx_outbox_messages_aggregate_id ON outbox_messages(aggregate_id);`,
+	}
+}
+
+// Batch is a set of locked outbox messages being published
+type Batch struct {
+	tx       *gorm.DB
+	Messages []*Message
+}
+
+// MarkSent records that a message has been published
+func (b *Batch) MarkSent(id uuid.UUID) error {
+	return b.tx.Model(&Message{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"sent_at":  time.Now(),
+			"attempts": gorm.Expr("attempts + 1"),
+		}).Error
+}
+
+// MarkFailed records a failed publish attempt, keeping the message unsent
+func (b *Batch) MarkFailed(id uuid.UUID, publishErr error) error {
+	return b.tx.Model(&Message{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": publishErr.Error(),
+		}).Error
+}
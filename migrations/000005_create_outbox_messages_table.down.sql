@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_outbox_messages_aggregate_id;
+DROP INDEX IF EXISTS idx_outbox_messages_unsent;
+DROP TABLE IF EXISTS outbox_messages;
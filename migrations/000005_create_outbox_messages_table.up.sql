@@ -0,0 +1,15 @@
+-- Outbox messages written in the same transaction as the saga state
+CREATE TABLE outbox_messages (
+    id UUID PRIMARY KEY,
+    sequence BIGSERIAL NOT NULL,
+    aggregate_id UUID NOT NULL,
+    topic VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_outbox_messages_unsent ON outbox_messages(sequence) WHERE sent_at IS NULL;
+CREATE INDEX idx_outbox_messages_aggregate_id ON outbox_messages(aggregate_id);
@@ -0,0 +1,10 @@
+-- Inbox of handled step commands, written in the same transaction as the handler's side effects
+CREATE TABLE inbox_messages (
+    message_id UUID NOT NULL,
+    saga_id UUID NOT NULL,
+    step VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    error TEXT,
+    processed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, saga_id, step)
+);
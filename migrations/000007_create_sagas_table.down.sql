@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS saga_transactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES orders(id),
+    status VARCHAR(50) NOT NULL,
+    current_step VARCHAR(50) NOT NULL,
+    steps JSONB NOT NULL,
+    compensation_steps JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_saga_transactions_order_id ON saga_transactions(order_id);
+
+DROP INDEX IF EXISTS idx_saga_steps_saga_id;
+DROP INDEX IF EXISTS idx_sagas_order_id;
+DROP INDEX IF EXISTS idx_sagas_status;
+DROP TABLE IF EXISTS saga_steps;
+DROP TABLE IF EXISTS sagas;
@@ -0,0 +1,35 @@
+-- Sagas replace the saga_transactions table, which matched none of the saga models
+CREATE TABLE sagas (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type VARCHAR(50) NOT NULL,
+    order_id UUID NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    error TEXT,
+    timeout BIGINT NOT NULL,
+    max_retries INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Saga steps table
+CREATE TABLE saga_steps (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    saga_id UUID NOT NULL REFERENCES sagas(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    "order" INT NOT NULL,
+    payload JSONB,
+    error_message TEXT,
+    retries INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_sagas_status ON sagas(status);
+CREATE INDEX idx_sagas_order_id ON sagas(order_id);
+CREATE INDEX idx_saga_steps_saga_id ON saga_steps(saga_id);
+
+DROP TRIGGER IF EXISTS update_saga_transactions_updated_at ON saga_transactions;
+DROP INDEX IF EXISTS idx_saga_transactions_order_id;
+DROP TABLE IF EXISTS saga_transactions;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_sagas_updated_at_id;
+DROP INDEX IF EXISTS idx_sagas_created_at_id;
@@ -0,0 +1,3 @@
+-- Keyset pagination indexes for listing sagas
+CREATE INDEX idx_sagas_created_at_id ON sagas(created_at, id);
+CREATE INDEX idx_sagas_updated_at_id ON sagas(updated_at, id);
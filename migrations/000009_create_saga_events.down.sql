@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_saga_events_saga_id_sequence;
+DROP TABLE IF EXISTS saga_events;
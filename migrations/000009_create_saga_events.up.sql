@@ -0,0 +1,17 @@
+-- Append-only log of every saga and step transition
+CREATE TABLE saga_events (
+    id UUID PRIMARY KEY,
+    sequence BIGSERIAL NOT NULL,
+    saga_id UUID NOT NULL REFERENCES sagas(id) ON DELETE CASCADE,
+    step_id UUID,
+    step_name VARCHAR(255),
+    type VARCHAR(50) NOT NULL,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50),
+    attempt INT NOT NULL DEFAULT 0,
+    actor VARCHAR(100) NOT NULL,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_saga_events_saga_id_sequence ON saga_events(saga_id, sequence);
@@ -0,0 +1,3 @@
+ALTER TABLE sagas DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE sagas DROP COLUMN IF EXISTS lease_owner;
+ALTER TABLE sagas DROP COLUMN IF EXISTS executor;
@@ -0,0 +1,4 @@
+-- Which component drives the saga, and the lease of the instance running it
+ALTER TABLE sagas ADD COLUMN executor VARCHAR(20) NOT NULL DEFAULT 'IN_PROCESS';
+ALTER TABLE sagas ADD COLUMN lease_owner VARCHAR(255);
+ALTER TABLE sagas ADD COLUMN lease_expires_at TIMESTAMP WITH TIME ZONE;
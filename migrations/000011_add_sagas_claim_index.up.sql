@@ -0,0 +1,3 @@
+-- Sagas claimed by the orchestrator polling loop, oldest update first
+CREATE INDEX idx_sagas_claim ON sagas(updated_at)
+    WHERE executor = 'MESSAGING' AND status IN ('PENDING', 'PROCESSING');
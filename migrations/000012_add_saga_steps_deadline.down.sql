@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_saga_steps_next_attempt_at;
+DROP INDEX IF EXISTS idx_saga_steps_deadline_at;
+ALTER TABLE saga_steps DROP COLUMN IF EXISTS deadline_at;
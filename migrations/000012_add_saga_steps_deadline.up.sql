@@ -0,0 +1,6 @@
+-- Deadline of the running attempt of a step, set when the step is dispatched
+ALTER TABLE saga_steps ADD COLUMN deadline_at TIMESTAMP WITH TIME ZONE;
+
+-- Steps looked for by the timeout and retry sweeper
+CREATE INDEX idx_saga_steps_deadline_at ON saga_steps(deadline_at) WHERE status = 'PENDING';
+CREATE INDEX idx_saga_steps_next_attempt_at ON saga_steps(next_attempt_at) WHERE status = 'RETRYING';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_inventory_reservations_product_id;
+DROP TABLE IF EXISTS inventory_reservations;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_stock_non_negative;
@@ -0,0 +1,16 @@
+-- products.stock is the available stock; it can never go negative
+ALTER TABLE products ADD CONSTRAINT products_stock_non_negative CHECK (stock >= 0);
+
+-- Stock held for an order, taken from products.stock when reserved
+CREATE TABLE inventory_reservations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    product_id UUID NOT NULL REFERENCES products(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (order_id, product_id)
+);
+
+CREATE INDEX idx_inventory_reservations_product_id ON inventory_reservations(product_id) WHERE status = 'RESERVED';
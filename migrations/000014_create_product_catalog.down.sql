@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_product_images_product_id;
+DROP TABLE IF EXISTS product_images;
+
+DROP INDEX IF EXISTS idx_products_status_created_at;
+DROP INDEX IF EXISTS idx_products_category_id;
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_sku_key,
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS category_id,
+    DROP COLUMN IF EXISTS sku;
+
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,38 @@
+-- Product categories, optionally nested under a parent
+CREATE TABLE categories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    parent_id UUID REFERENCES categories(id),
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Catalog fields of products; existing products get their ID as SKU
+ALTER TABLE products
+    ADD COLUMN sku VARCHAR(100),
+    ADD COLUMN category_id UUID REFERENCES categories(id),
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE products SET sku = id::text WHERE sku IS NULL;
+ALTER TABLE products ALTER COLUMN sku SET NOT NULL;
+ALTER TABLE products ADD CONSTRAINT products_sku_key UNIQUE (sku);
+
+CREATE INDEX idx_products_category_id ON products(category_id);
+CREATE INDEX idx_products_status_created_at ON products(status, created_at DESC);
+
+-- Image metadata; the images themselves live in object storage
+CREATE TABLE product_images (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    alt_text VARCHAR(255),
+    position INT NOT NULL DEFAULT 0,
+    width INT,
+    height INT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_product_images_product_id ON product_images(product_id, position);
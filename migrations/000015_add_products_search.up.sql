@@ -0,0 +1,12 @@
+-- Full text search document of products, kept up to date by Postgres on every write
+ALTER TABLE products
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(sku, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_products_search_vector ON products USING GIN (search_vector);
+
+-- Keyset pagination of searches sorted by price
+CREATE INDEX idx_products_price_id ON products(price, id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_inventory_holds_expires_at;
+DROP INDEX IF EXISTS idx_inventory_holds_product_id;
+DROP INDEX IF EXISTS idx_inventory_holds_user_id_product_id;
+DROP TABLE IF EXISTS inventory_holds;
@@ -0,0 +1,17 @@
+-- Stock set aside for cart items, taken from products.stock until the hold
+-- expires, the item is removed or the order reserves it
+CREATE TABLE inventory_holds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    cart_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL REFERENCES products(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (cart_id, product_id)
+);
+
+CREATE INDEX idx_inventory_holds_user_id_product_id ON inventory_holds(user_id, product_id);
+CREATE INDEX idx_inventory_holds_product_id ON inventory_holds(product_id);
+CREATE INDEX idx_inventory_holds_expires_at ON inventory_holds(expires_at);
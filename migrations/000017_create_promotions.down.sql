@@ -0,0 +1,14 @@
+ALTER TABLE IF EXISTS carts DROP COLUMN IF EXISTS coupon_code;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS discount_total,
+    DROP COLUMN IF EXISTS subtotal;
+
+DROP INDEX IF EXISTS idx_order_discounts_order_id;
+DROP TABLE IF EXISTS order_discounts;
+
+DROP INDEX IF EXISTS idx_promotion_redemptions_order_id;
+DROP INDEX IF EXISTS idx_promotion_redemptions_promotion_id_status;
+DROP TABLE IF EXISTS promotion_redemptions;
+
+DROP TABLE IF EXISTS promotions;
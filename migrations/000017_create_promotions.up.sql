@@ -0,0 +1,57 @@
+-- Coupons redeemed by code. Zero limits mean unlimited.
+CREATE TABLE promotions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    type VARCHAR(20) NOT NULL CHECK (type IN ('PERCENTAGE', 'FIXED', 'BUY_X_GET_Y')),
+    value DECIMAL(10,2) NOT NULL DEFAULT 0,
+    product_id UUID REFERENCES products(id),
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    min_order_value DECIMAL(10,2) NOT NULL DEFAULT 0,
+    usage_limit INT NOT NULL DEFAULT 0 CHECK (usage_limit >= 0),
+    per_user_limit INT NOT NULL DEFAULT 0 CHECK (per_user_limit >= 0),
+    starts_at TIMESTAMP WITH TIME ZONE,
+    ends_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Coupon uses of an order: RESERVED by the order saga, REDEEMED when it
+-- completes and RELEASED when it is compensated
+CREATE TABLE promotion_redemptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    promotion_id UUID NOT NULL REFERENCES promotions(id),
+    user_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    amount DECIMAL(10,2) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('RESERVED', 'REDEEMED', 'RELEASED')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (promotion_id, order_id)
+);
+
+CREATE INDEX idx_promotion_redemptions_promotion_id_status ON promotion_redemptions(promotion_id, status);
+CREATE INDEX idx_promotion_redemptions_order_id ON promotion_redemptions(order_id);
+
+CREATE TABLE order_discounts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    promotion_id UUID NOT NULL REFERENCES promotions(id),
+    code VARCHAR(50) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    amount DECIMAL(10,2) NOT NULL
+);
+
+CREATE INDEX idx_order_discounts_order_id ON order_discounts(order_id);
+
+-- total_amount is now the subtotal less the discounts
+ALTER TABLE orders
+    ADD COLUMN subtotal DECIMAL(10,2),
+    ADD COLUMN discount_total DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+UPDATE orders SET subtotal = total_amount;
+
+ALTER TABLE orders ALTER COLUMN subtotal SET NOT NULL;
+
+ALTER TABLE IF EXISTS carts ADD COLUMN IF NOT EXISTS coupon_code VARCHAR(50);
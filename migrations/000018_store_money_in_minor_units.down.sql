@@ -0,0 +1,58 @@
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'cart_items' AND column_name = 'price_amount') THEN
+        ALTER TABLE cart_items DROP COLUMN price_currency;
+        ALTER TABLE cart_items ALTER COLUMN price_amount TYPE DECIMAL(10,2) USING price_amount / 100.0;
+        ALTER TABLE cart_items RENAME COLUMN price_amount TO price;
+    END IF;
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'carts' AND column_name = 'total_amount') THEN
+        ALTER TABLE carts DROP COLUMN total_currency;
+        ALTER TABLE carts ALTER COLUMN total_amount TYPE DECIMAL(10,2) USING total_amount / 100.0;
+        ALTER TABLE carts RENAME COLUMN total_amount TO total;
+    END IF;
+END $$;
+
+ALTER TABLE promotion_redemptions
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN amount TYPE DECIMAL(10,2) USING amount / 100.0;
+
+UPDATE promotions SET value = amount_amount / 100.0 WHERE type = 'FIXED';
+
+ALTER TABLE promotions
+    DROP COLUMN IF EXISTS amount_currency,
+    DROP COLUMN IF EXISTS amount_amount,
+    DROP COLUMN IF EXISTS min_order_value_currency,
+    ALTER COLUMN min_order_value_amount TYPE DECIMAL(10,2) USING min_order_value_amount / 100.0;
+ALTER TABLE promotions RENAME COLUMN min_order_value_amount TO min_order_value;
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN amount TYPE DECIMAL(10,2) USING amount / 100.0;
+
+ALTER TABLE order_discounts
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN amount TYPE DECIMAL(10,2) USING amount / 100.0;
+
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS price_currency,
+    ALTER COLUMN price_amount TYPE DECIMAL(10,2) USING price_amount / 100.0;
+ALTER TABLE order_items RENAME COLUMN price_amount TO price;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS discount_total_currency,
+    DROP COLUMN IF EXISTS subtotal_currency,
+    DROP COLUMN IF EXISTS total_currency,
+    ALTER COLUMN discount_total_amount TYPE DECIMAL(10,2) USING discount_total_amount / 100.0,
+    ALTER COLUMN subtotal_amount TYPE DECIMAL(10,2) USING subtotal_amount / 100.0,
+    ALTER COLUMN total_amount TYPE DECIMAL(10,2) USING total_amount / 100.0;
+ALTER TABLE orders RENAME COLUMN discount_total_amount TO discount_total;
+ALTER TABLE orders RENAME COLUMN subtotal_amount TO subtotal;
+
+DROP INDEX IF EXISTS idx_products_price_id;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS price_currency,
+    ALTER COLUMN price_amount TYPE DECIMAL(10,2) USING price_amount / 100.0;
+ALTER TABLE products RENAME COLUMN price_amount TO price;
+
+CREATE INDEX idx_products_price_id ON products(price, id);
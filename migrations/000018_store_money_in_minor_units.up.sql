@@ -0,0 +1,70 @@
+-- Amounts are stored as whole minor units (cents) next to their ISO 4217
+-- currency. Existing amounts were in US dollars.
+
+-- Products
+DROP INDEX IF EXISTS idx_products_price_id;
+
+ALTER TABLE products RENAME COLUMN price TO price_amount;
+ALTER TABLE products
+    ALTER COLUMN price_amount TYPE BIGINT USING ROUND(price_amount * 100),
+    ADD COLUMN price_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+-- Keyset pagination of searches sorted by price
+CREATE INDEX idx_products_price_id ON products(price_amount, id);
+
+-- Orders
+ALTER TABLE orders RENAME COLUMN subtotal TO subtotal_amount;
+ALTER TABLE orders RENAME COLUMN discount_total TO discount_total_amount;
+ALTER TABLE orders
+    ALTER COLUMN total_amount TYPE BIGINT USING ROUND(total_amount * 100),
+    ALTER COLUMN subtotal_amount TYPE BIGINT USING ROUND(subtotal_amount * 100),
+    ALTER COLUMN discount_total_amount TYPE BIGINT USING ROUND(discount_total_amount * 100),
+    ADD COLUMN total_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN subtotal_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN discount_total_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE order_items RENAME COLUMN price TO price_amount;
+ALTER TABLE order_items
+    ALTER COLUMN price_amount TYPE BIGINT USING ROUND(price_amount * 100),
+    ADD COLUMN price_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE order_discounts
+    ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100),
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+-- Payments
+ALTER TABLE payments
+    ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100),
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+-- Promotions: value is only the percentage of PERCENTAGE promotions now, the
+-- amount of FIXED promotions moves to amount_amount
+ALTER TABLE promotions RENAME COLUMN min_order_value TO min_order_value_amount;
+ALTER TABLE promotions
+    ALTER COLUMN min_order_value_amount TYPE BIGINT USING ROUND(min_order_value_amount * 100),
+    ADD COLUMN min_order_value_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN amount_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN amount_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+UPDATE promotions SET amount_amount = ROUND(value * 100), value = 0 WHERE type = 'FIXED';
+
+ALTER TABLE promotion_redemptions
+    ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100),
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+-- Carts kept in Postgres rather than MongoDB
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'carts' AND column_name = 'total') THEN
+        ALTER TABLE carts RENAME COLUMN total TO total_amount;
+        ALTER TABLE carts
+            ALTER COLUMN total_amount TYPE BIGINT USING ROUND(total_amount * 100),
+            ADD COLUMN total_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+    END IF;
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'cart_items' AND column_name = 'price') THEN
+        ALTER TABLE cart_items RENAME COLUMN price TO price_amount;
+        ALTER TABLE cart_items
+            ALTER COLUMN price_amount TYPE BIGINT USING ROUND(price_amount * 100),
+            ADD COLUMN price_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+    END IF;
+END $$;
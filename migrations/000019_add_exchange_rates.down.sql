@@ -0,0 +1,13 @@
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'carts') THEN
+        ALTER TABLE carts DROP COLUMN IF EXISTS currency;
+    END IF;
+END $$;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS fx_value,
+    DROP COLUMN IF EXISTS fx_to,
+    DROP COLUMN IF EXISTS fx_from;
+
+DROP TABLE IF EXISTS fx_rates;
@@ -0,0 +1,24 @@
+-- Exchange rates: the price of one unit of base_currency in quote_currency
+CREATE TABLE IF NOT EXISTS fx_rates (
+    base_currency VARCHAR(3) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(20,10) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (base_currency, quote_currency)
+);
+
+-- The rate the catalog prices of an order were converted at
+ALTER TABLE orders
+    ADD COLUMN fx_from VARCHAR(3),
+    ADD COLUMN fx_to VARCHAR(3),
+    ADD COLUMN fx_value NUMERIC(20,10);
+
+UPDATE orders SET fx_from = total_currency, fx_to = total_currency, fx_value = 1;
+
+-- The currency the shopper chose for carts kept in Postgres rather than MongoDB
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'carts') THEN
+        ALTER TABLE carts ADD COLUMN IF NOT EXISTS currency VARCHAR(3);
+    END IF;
+END $$;
@@ -0,0 +1,19 @@
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'cart_items') THEN
+        ALTER TABLE cart_items DROP COLUMN IF EXISTS tax_category;
+    END IF;
+END $$;
+
+DROP TABLE IF EXISTS order_taxes;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS tax_inclusive,
+    DROP COLUMN IF EXISTS tax_total_currency,
+    DROP COLUMN IF EXISTS tax_total_amount;
+
+ALTER TABLE order_items DROP COLUMN IF EXISTS tax_category;
+
+ALTER TABLE products DROP COLUMN IF EXISTS tax_category;
+
+DROP TABLE IF EXISTS tax_rules;
@@ -0,0 +1,47 @@
+-- Tax rates per country, optionally narrowed to a region and a product tax category
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    country VARCHAR(2) NOT NULL,
+    region VARCHAR(50) NOT NULL DEFAULT '',
+    category VARCHAR(50) NOT NULL DEFAULT '',
+    name VARCHAR(100) NOT NULL,
+    rate NUMERIC(7,4) NOT NULL CHECK (rate >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (country, region, category)
+);
+
+ALTER TABLE products ADD COLUMN tax_category VARCHAR(50) NOT NULL DEFAULT 'standard';
+
+ALTER TABLE order_items ADD COLUMN tax_category VARCHAR(50);
+
+ALTER TABLE orders
+    ADD COLUMN tax_total_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN tax_total_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE orders SET tax_total_currency = total_currency;
+
+CREATE TABLE IF NOT EXISTS order_taxes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    country VARCHAR(2) NOT NULL,
+    region VARCHAR(50),
+    category VARCHAR(50),
+    rate NUMERIC(7,4) NOT NULL,
+    taxable_amount BIGINT NOT NULL,
+    taxable_currency VARCHAR(3) NOT NULL,
+    amount_amount BIGINT NOT NULL,
+    amount_currency VARCHAR(3) NOT NULL
+);
+
+CREATE INDEX idx_order_taxes_order_id ON order_taxes(order_id);
+
+-- Cart items kept in Postgres rather than MongoDB
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'cart_items') THEN
+        ALTER TABLE cart_items ADD COLUMN IF NOT EXISTS tax_category VARCHAR(50);
+    END IF;
+END $$;
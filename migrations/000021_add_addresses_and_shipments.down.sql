@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS shipment_events;
+DROP TABLE IF EXISTS shipment_items;
+DROP TABLE IF EXISTS shipments;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS shipping_country,
+    DROP COLUMN IF EXISTS shipping_postal_code,
+    DROP COLUMN IF EXISTS shipping_region,
+    DROP COLUMN IF EXISTS shipping_city,
+    DROP COLUMN IF EXISTS shipping_line2,
+    DROP COLUMN IF EXISTS shipping_line1,
+    DROP COLUMN IF EXISTS shipping_phone,
+    DROP COLUMN IF EXISTS shipping_recipient;
+
+DROP TABLE IF EXISTS addresses;
@@ -0,0 +1,65 @@
+-- Address books of users
+CREATE TABLE IF NOT EXISTS addresses (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    phone VARCHAR(50),
+    line1 VARCHAR(255) NOT NULL,
+    line2 VARCHAR(255),
+    city VARCHAR(100) NOT NULL,
+    region VARCHAR(50),
+    postal_code VARCHAR(20),
+    country VARCHAR(2) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_addresses_user_id ON addresses(user_id);
+CREATE UNIQUE INDEX idx_addresses_user_default ON addresses(user_id) WHERE is_default;
+
+-- The address an order is shipped to, copied when it is placed
+ALTER TABLE orders
+    ADD COLUMN shipping_recipient VARCHAR(255),
+    ADD COLUMN shipping_phone VARCHAR(50),
+    ADD COLUMN shipping_line1 VARCHAR(255),
+    ADD COLUMN shipping_line2 VARCHAR(255),
+    ADD COLUMN shipping_city VARCHAR(100),
+    ADD COLUMN shipping_region VARCHAR(50),
+    ADD COLUMN shipping_postal_code VARCHAR(20),
+    ADD COLUMN shipping_country VARCHAR(2);
+
+CREATE TABLE IF NOT EXISTS shipments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES orders(id),
+    carrier VARCHAR(100) NOT NULL,
+    tracking_number VARCHAR(100) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    shipped_at TIMESTAMP WITH TIME ZONE,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_shipments_order_id ON shipments(order_id);
+
+CREATE TABLE IF NOT EXISTS shipment_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    shipment_id UUID NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_items(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0)
+);
+
+CREATE INDEX idx_shipment_items_shipment_id ON shipment_items(shipment_id);
+
+CREATE TABLE IF NOT EXISTS shipment_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    shipment_id UUID NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    status VARCHAR(50) NOT NULL,
+    location VARCHAR(255),
+    description TEXT,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_shipment_events_shipment_id ON shipment_events(shipment_id);
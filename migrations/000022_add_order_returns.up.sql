@@ -0,0 +1,35 @@
+-- Units of an order item sent back and refunded
+ALTER TABLE order_items
+    ADD COLUMN returned_quantity INTEGER NOT NULL DEFAULT 0 CHECK (returned_quantity >= 0);
+
+CREATE TABLE IF NOT EXISTS order_returns (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES orders(id),
+    user_id UUID NOT NULL,
+    reason TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    refund_amount BIGINT NOT NULL,
+    refund_currency VARCHAR(3) NOT NULL,
+    note TEXT,
+    failure_reason TEXT,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    refunded_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_order_returns_order_id ON order_returns(order_id);
+CREATE INDEX idx_order_returns_user_id ON order_returns(user_id);
+
+CREATE TABLE IF NOT EXISTS order_return_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    return_id UUID NOT NULL REFERENCES order_returns(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_items(id),
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    refund_amount BIGINT NOT NULL,
+    refund_currency VARCHAR(3) NOT NULL
+);
+
+CREATE INDEX idx_order_return_items_return_id ON order_return_items(return_id);
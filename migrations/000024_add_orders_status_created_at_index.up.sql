@@ -0,0 +1,2 @@
+-- Unpaid orders are looked up by status and age to expire them
+CREATE INDEX IF NOT EXISTS idx_orders_status_created_at ON orders(status, created_at);
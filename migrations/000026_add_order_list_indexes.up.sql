@@ -0,0 +1,3 @@
+-- Orders are listed per user by creation time and filtered by product
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_created_at ON orders(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_order_items_product_id ON order_items(product_id);
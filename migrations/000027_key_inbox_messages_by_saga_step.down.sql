@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_sagas_claim_due;
+CREATE INDEX idx_sagas_claim ON sagas(updated_at)
+    WHERE executor = 'MESSAGING' AND status IN ('PENDING', 'PROCESSING');
+
+ALTER TABLE inbox_messages DROP CONSTRAINT inbox_messages_pkey;
+UPDATE inbox_messages SET step = regexp_replace(step, '^saga\.(compensation\.)?', '');
+ALTER TABLE inbox_messages ADD PRIMARY KEY (message_id, saga_id, step);
@@ -0,0 +1,18 @@
+-- Step commands are deduplicated per saga and command topic instead of per
+-- message, so that a step the orchestrator sends again is not run twice.
+-- Failures were recorded even when a retry could resolve them; they are
+-- dropped so that those steps run again. Compensations were recorded under
+-- the step name like the step itself, so only the latest row of a step is
+-- kept, as the row of the step command.
+DELETE FROM inbox_messages WHERE status = 'FAILED';
+DELETE FROM inbox_messages a USING inbox_messages b
+WHERE a.saga_id = b.saga_id AND a.step = b.step
+    AND (a.processed_at, a.message_id) < (b.processed_at, b.message_id);
+UPDATE inbox_messages SET step = 'saga.' || step;
+ALTER TABLE inbox_messages DROP CONSTRAINT inbox_messages_pkey;
+ALTER TABLE inbox_messages ADD PRIMARY KEY (saga_id, step);
+
+-- Sagas with a failed compensation due for retry are claimed by the sweeper too
+DROP INDEX IF EXISTS idx_sagas_claim;
+CREATE INDEX idx_sagas_claim_due ON sagas(updated_at)
+    WHERE executor = 'MESSAGING' AND status IN ('PENDING', 'PROCESSING', 'COMPENSATING');
@@ -0,0 +1,2 @@
+-- Users are customers unless promoted to admin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'customer';
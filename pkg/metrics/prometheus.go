@@ -91,6 +91,15 @@ var (
 		[]string{"status"},
 	)
 
+	// Saga metrics
+	SagaStepTimeouts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "saga_step_timeouts_total",
+			Help: "Total number of saga steps that did not finish within their deadline",
+		},
+		[]string{"step"},
+	)
+
 	// System metrics
 	GoroutinesCount = promauto.NewGauge(
 		prometheus.GaugeOpts{
@@ -22,6 +22,7 @@ import (
 	sagaHandler "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/delivery/http"
 	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/test/integration/testutil"
 )
 
@@ -32,7 +33,7 @@ func (m *MockProductService) GetProduct(ctx context.Context, id uuid.UUID) (*car
 	return &cartUsecase.Product{
 		ID:    id,
 		Name:  "Test Product",
-		Price: 10.0,
+		Price: money.New(1000, money.USD),
 		Stock: 100,
 	}, nil
 }
@@ -53,7 +54,7 @@ func setupTestApp(t *testing.T) (*fiber.App, *testutil.TestDB) {
 	api := app.Group("/api")
 
 	// Initialize cart usecase and handler
-	cartUsecase := cartUsecase.NewCartUsecase(cartRepository, &MockProductService{}, 24*time.Hour)
+	cartUsecase := cartUsecase.NewCartUsecase(cartRepository, &MockProductService{}, 24*time.Hour, nil, 0, nil, nil)
 	cartHandler := cartHttp.NewCartHandler(cartUsecase)
 	cartGroup := api.Group("/cart")
 	cartGroup.Get("/:user_id", cartHandler.GetCart)
@@ -61,7 +62,7 @@ func setupTestApp(t *testing.T) (*fiber.App, *testutil.TestDB) {
 	cartGroup.Delete("/:user_id", cartHandler.ClearCart)
 
 	// Initialize saga usecase and handler
-	sagaUsecase := usecase.NewSagaUsecase(sagaRepository, orderRepository, nil, nil, nil, nil)
+	sagaUsecase := usecase.NewSagaUsecase(sagaRepository, orderRepository, nil, nil, nil, nil, nil, nil)
 	sagaHandler := sagaHandler.NewSagaHandler(sagaUsecase)
 	sagaGroup := api.Group("/saga")
 	sagaGroup.Post("/order-payment", sagaHandler.StartOrderPaymentSaga)
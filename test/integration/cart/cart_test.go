@@ -10,6 +10,7 @@ import (
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/domain/entity"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/cart/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/test/integration/testutil"
 )
 
@@ -34,7 +35,7 @@ func TestCartRepository(t *testing.T) {
 				{
 					ProductID: uuid.New(),
 					Quantity:  2,
-					Price:     50.0,
+					Price:     money.New(5000, money.USD),
 				},
 			},
 		}
@@ -69,7 +70,7 @@ func TestCartRepository(t *testing.T) {
 			CartID:    cart.ID,
 			ProductID: uuid.New(),
 			Quantity:  1,
-			Price:     25.0,
+			Price:     money.New(2500, money.USD),
 		}
 		err = cartRepo.AddItem(context.Background(), &item)
 		require.NoError(t, err)
@@ -91,12 +92,12 @@ func TestCartRepository(t *testing.T) {
 				{
 					ProductID: uuid.New(),
 					Quantity:  2,
-					Price:     50.0,
+					Price:     money.New(5000, money.USD),
 				},
 				{
 					ProductID: uuid.New(),
 					Quantity:  1,
-					Price:     30.0,
+					Price:     money.New(3000, money.USD),
 				},
 			},
 		}
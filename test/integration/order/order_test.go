@@ -10,6 +10,7 @@ import (
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/test/integration/testutil"
 )
 
@@ -34,7 +35,7 @@ func TestOrderRepository(t *testing.T) {
 				{
 					ProductID: uuid.New(),
 					Quantity:  2,
-					Price:     50.0,
+					Price:     money.New(5000, money.USD),
 				},
 			},
 		}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/payment/domain/entity"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/payment/repository/postgres"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/test/integration/testutil"
 )
 
@@ -29,8 +30,7 @@ func TestPaymentRepository(t *testing.T) {
 		payment := &entity.Payment{
 			ID:       uuid.New(),
 			OrderID:  uuid.New(),
-			Amount:   100.0,
-			Currency: "USD",
+			Amount:   money.New(10000, money.USD),
 			Status:   entity.PaymentStatusPending,
 			Provider: entity.PaymentProviderStripe,
 		}
@@ -54,8 +54,7 @@ func TestPaymentRepository(t *testing.T) {
 		payment := &entity.Payment{
 			ID:       uuid.New(),
 			OrderID:  uuid.New(),
-			Amount:   100.0,
-			Currency: "USD",
+			Amount:   money.New(10000, money.USD),
 			Status:   entity.PaymentStatusPending,
 			Provider: entity.PaymentProviderStripe,
 		}
@@ -80,8 +79,7 @@ func TestPaymentRepository(t *testing.T) {
 		payment := &entity.Payment{
 			ID:       uuid.New(),
 			OrderID:  orderID,
-			Amount:   100.0,
-			Currency: "USD",
+			Amount:   money.New(10000, money.USD),
 			Status:   entity.PaymentStatusPending,
 			Provider: entity.PaymentProviderStripe,
 		}
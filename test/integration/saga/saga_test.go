@@ -10,14 +10,19 @@ import (
 	"github.com/stretchr/testify/require"
 
 	cartClient "github.com/diki-haryadi/ecommerce-saga/internal/features/cart/delivery/grpc/client"
+	inventoryRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/repository/postgres"
+	inventoryUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/inventory/usecase"
 	orderClient "github.com/diki-haryadi/ecommerce-saga/internal/features/order/delivery/grpc/client"
 	orderEntity "github.com/diki-haryadi/ecommerce-saga/internal/features/order/domain/entity"
 	orderRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/order/repository/postgres"
 	paymentClient "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/delivery/grpc/client"
 	paymentRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/payment/repository/postgres"
+	promotionRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/repository/postgres"
+	promotionUsecase "github.com/diki-haryadi/ecommerce-saga/internal/features/promotion/usecase"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/domain/entity"
 	sagaRepo "github.com/diki-haryadi/ecommerce-saga/internal/features/saga/repository/postgres"
 	"github.com/diki-haryadi/ecommerce-saga/internal/features/saga/usecase"
+	"github.com/diki-haryadi/ecommerce-saga/internal/pkg/money"
 	"github.com/diki-haryadi/ecommerce-saga/test/integration/testutil"
 )
 
@@ -27,12 +32,14 @@ func TestOrderPaymentSaga(t *testing.T) {
 	defer tdb.Cleanup()
 
 	// Clean up tables before test
-	require.NoError(t, tdb.TruncateTables("orders", "payments", "saga_transactions", "saga_steps"))
+	require.NoError(t, tdb.TruncateTables("orders", "payments", "sagas", "saga_steps", "saga_events", "inventory_reservations", "inventory_holds", "promotion_redemptions"))
 
 	// Initialize repositories
 	sagaRepository := sagaRepo.NewSagaRepository(tdb.DB)
 	orderRepository := orderRepo.NewOrderRepository(tdb.DB)
 	paymentRepository := paymentRepo.NewPaymentRepository(tdb.DB)
+	inventory := inventoryUsecase.NewInventoryUsecase(inventoryRepo.NewInventoryRepository(tdb.DB))
+	promotions := promotionUsecase.NewPromotionUsecase(promotionRepo.NewPromotionRepository(tdb.DB), money.Money{}, nil)
 
 	// Initialize mock gRPC clients
 	orderGrpcClient, err := orderClient.NewOrderClient("localhost:50051")
@@ -52,6 +59,8 @@ func TestOrderPaymentSaga(t *testing.T) {
 		sagaRepository,
 		orderRepository,
 		paymentRepository,
+		inventory,
+		promotions,
 		orderGrpcClient,
 		paymentGrpcClient,
 		cartGrpcClient,
@@ -64,7 +73,7 @@ func TestOrderPaymentSaga(t *testing.T) {
 		order := &orderEntity.Order{
 			ID:          orderID,
 			UserID:      uuid.New(),
-			TotalAmount: 100.0,
+			TotalAmount: money.New(10000, money.USD),
 			Status:      orderEntity.OrderStatusPending,
 		}
 		err := orderRepository.Create(context.Background(), order)
@@ -104,7 +113,7 @@ func TestOrderPaymentSaga(t *testing.T) {
 		order := &orderEntity.Order{
 			ID:          orderID,
 			UserID:      uuid.New(),
-			TotalAmount: -1.0, // Invalid amount to trigger failure
+			TotalAmount: money.New(-100, money.USD), // Invalid amount to trigger failure
 			Status:      orderEntity.OrderStatusPending,
 		}
 		err := orderRepository.Create(context.Background(), order)